@@ -1,6 +1,9 @@
 package config
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // Config represents the application configuration
 type Config struct {
@@ -11,10 +14,14 @@ type Config struct {
 	Database      DatabaseConfig      `mapstructure:"database"`
 	Strategies    StrategiesConfig    `mapstructure:"strategies"`
 	Statistics    StatisticsConfig    `mapstructure:"statistics"`
+	Retention     RetentionConfig     `mapstructure:"retention"`
+	PaperTrading  PaperTradingConfig  `mapstructure:"paper_trading"`
+	Scheduler     SchedulerConfig     `mapstructure:"scheduler"`
 	Notifications NotificationsConfig `mapstructure:"notifications"`
 	Logging       LoggingConfig       `mapstructure:"logging"`
 	Monitoring    MonitoringConfig    `mapstructure:"monitoring"`
 	Features      FeaturesConfig      `mapstructure:"features"`
+	SelfTest      SelfTestConfig      `mapstructure:"self_test"`
 }
 
 // AppConfig represents general application configuration
@@ -27,19 +34,117 @@ type AppConfig struct {
 
 // ServerConfig represents HTTP server configuration
 type ServerConfig struct {
-	Host         string        `mapstructure:"host"`
-	Port         int           `mapstructure:"port"`
-	ReadTimeout  time.Duration `mapstructure:"read_timeout"`
-	WriteTimeout time.Duration `mapstructure:"write_timeout"`
+	Host         string             `mapstructure:"host"`
+	Port         int                `mapstructure:"port"`
+	ReadTimeout  time.Duration      `mapstructure:"read_timeout"`
+	WriteTimeout time.Duration      `mapstructure:"write_timeout"`
+	Auth         APIAuthConfig      `mapstructure:"auth"`
+	RateLimit    APIRateLimitConfig `mapstructure:"rate_limit"`
+	Compression  CompressionConfig  `mapstructure:"compression"`
+
+	// TrustedProxies lists the IPs or CIDRs of reverse proxies whose X-Forwarded-For header
+	// is trusted for the client IP (empty = none, the connection address is used)
+	TrustedProxies []string `mapstructure:"trusted_proxies"`
+
+	// HandlerTimeout bounds the context of each request, answering 503 once exceeded (0 = disabled)
+	// Keep it below WriteTimeout so slow requests fail cleanly before the socket is cut
+	HandlerTimeout time.Duration `mapstructure:"handler_timeout"`
+}
+
+// APIAuthConfig represents API key authentication configuration
+type APIAuthConfig struct {
+	Enabled bool     `mapstructure:"enabled"`
+	APIKeys []string `mapstructure:"api_keys"` // Accepted values of the X-API-Key header
+}
+
+// APIRateLimitConfig represents per-IP API rate limiting configuration
+type APIRateLimitConfig struct {
+	Enabled           bool `mapstructure:"enabled"`
+	RequestsPerMinute int  `mapstructure:"requests_per_minute"`
+	Burst             int  `mapstructure:"burst"` // Maximum requests allowed in a single burst
+
+	// Stricter per-IP limit for endpoints that call Binance on demand (POST /analyze/:symbol)
+	// Applied even when the global limit is disabled
+	RefreshPerMinute int `mapstructure:"refresh_requests_per_minute"`
+	RefreshBurst     int `mapstructure:"refresh_burst"`
 }
 
+// CompressionConfig represents gzip response compression configuration
+type CompressionConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	MinSize int  `mapstructure:"min_size"` // Only responses of at least this many bytes are compressed
+}
+
+// Binance USDT-M futures REST endpoints
+const (
+	BinanceMainnetURL = "https://fapi.binance.com"
+	BinanceTestnetURL = "https://testnet.binancefuture.com"
+)
+
+// Binance COIN-M futures REST endpoints
+const (
+	BinanceCoinMMainnetURL = "https://dapi.binance.com"
+	BinanceCoinMTestnetURL = "https://testnet.binancefuture.com"
+)
+
+// Futures margin modes selecting which contracts are collected
+const (
+	MarginModeUSDT = "usdt" // USDT-margined perpetuals only
+	MarginModeCoin = "coin" // Coin-margined perpetuals only (e.g. BTCUSD_PERP)
+	MarginModeBoth = "both" // Both USDT-M and COIN-M perpetuals
+)
+
 // BinanceConfig represents Binance API configuration
 type BinanceConfig struct {
-	APIURL    string          `mapstructure:"api_url"`
-	APIKey    string          `mapstructure:"api_key"`
-	APISecret string          `mapstructure:"api_secret"`
-	RateLimit RateLimitConfig `mapstructure:"rate_limit"`
-	Timeout   time.Duration   `mapstructure:"timeout"`
+	APIURL      string          `mapstructure:"api_url"`        // Optional override, defaults to the selected network endpoint
+	CoinMAPIURL string          `mapstructure:"coin_m_api_url"` // Optional COIN-M override, defaults to the selected network endpoint
+	UseTestnet  bool            `mapstructure:"use_testnet"`    // Use the futures testnet instead of mainnet
+	APIKey      string          `mapstructure:"api_key"`
+	APISecret   string          `mapstructure:"api_secret"`
+	RateLimit   RateLimitConfig `mapstructure:"rate_limit"`
+	Timeout     time.Duration   `mapstructure:"timeout"`
+
+	CircuitBreaker CircuitBreakerConfig `mapstructure:"circuit_breaker"`
+	ClockSync      ClockSyncConfig      `mapstructure:"clock_sync"`
+}
+
+// ClockSyncConfig represents the periodic sync with the Binance server clock
+// The measured offset corrects signed request timestamps and market data timestamp validation
+type ClockSyncConfig struct {
+	Enabled  bool          `mapstructure:"enabled"`
+	Interval time.Duration `mapstructure:"interval"` // How often the offset is measured again
+	MaxSkew  time.Duration `mapstructure:"max_skew"` // Local clock skew above which a warning is logged
+}
+
+// CircuitBreakerConfig represents the circuit breaker guarding Binance requests
+type CircuitBreakerConfig struct {
+	Enabled          bool          `mapstructure:"enabled"`
+	FailureThreshold int           `mapstructure:"failure_threshold"` // Consecutive failed requests before the breaker opens
+	Cooldown         time.Duration `mapstructure:"cooldown"`          // How long requests fail fast before a probe is let through
+}
+
+// BaseURL returns the REST base URL for the selected network
+// An explicit APIURL takes precedence over the network default
+func (c BinanceConfig) BaseURL() string {
+	if c.APIURL != "" {
+		return strings.TrimRight(c.APIURL, "/")
+	}
+	if c.UseTestnet {
+		return BinanceTestnetURL
+	}
+	return BinanceMainnetURL
+}
+
+// CoinMBaseURL returns the COIN-M REST base URL for the selected network
+// An explicit CoinMAPIURL takes precedence over the network default
+func (c BinanceConfig) CoinMBaseURL() string {
+	if c.CoinMAPIURL != "" {
+		return strings.TrimRight(c.CoinMAPIURL, "/")
+	}
+	if c.UseTestnet {
+		return BinanceCoinMTestnetURL
+	}
+	return BinanceCoinMMainnetURL
 }
 
 // RateLimitConfig represents rate limiting configuration
@@ -54,14 +159,41 @@ type CollectionConfig struct {
 	Interval   string      `mapstructure:"interval"`
 	PairFilter PairFilter  `mapstructure:"pair_filter"`
 	Retry      RetryConfig `mapstructure:"retry"`
+
+	LiquidityTiers LiquidityTiersConfig `mapstructure:"liquidity_tiers"`
+
+	// Deactivate a pair after this many consecutive failed collections (0 = disabled)
+	MaxConsecutiveFailures int `mapstructure:"max_consecutive_failures"`
+
+	SuccessRateAlert SuccessRateAlertConfig `mapstructure:"success_rate_alert"`
+}
+
+// SuccessRateAlertConfig configures alerting on a sustained drop of the collection success rate
+// The per-run success rate is exponentially smoothed so a single bad run doesn't trigger an alert
+type SuccessRateAlertConfig struct {
+	Enabled         bool    `mapstructure:"enabled"`
+	SmoothingFactor float64 `mapstructure:"smoothing_factor"` // Weight of the latest run in the smoothed rate (0-1]
+	Threshold       float64 `mapstructure:"threshold"`        // Alert when the smoothed rate (%) is below this
+	ConsecutiveRuns int     `mapstructure:"consecutive_runs"` // ...for this many consecutive runs
 }
 
 // PairFilter represents trading pair filtering configuration
 type PairFilter struct {
 	QuoteAsset   string   `mapstructure:"quote_asset"`
+	MarginMode   string   `mapstructure:"margin_mode"` // usdt, coin or both
 	ExcludePairs []string `mapstructure:"exclude_pairs"`
 }
 
+// LiquidityTiersConfig represents trading pair liquidity classification thresholds
+// A pair qualifies for a tier when both its 24h volume and open interest (USDT) meet the minimums
+type LiquidityTiersConfig struct {
+	Enabled               bool    `mapstructure:"enabled"`
+	HighMinVolume24h      float64 `mapstructure:"high_min_volume_24h"`
+	HighMinOpenInterest   float64 `mapstructure:"high_min_open_interest"`
+	MediumMinVolume24h    float64 `mapstructure:"medium_min_volume_24h"`
+	MediumMinOpenInterest float64 `mapstructure:"medium_min_open_interest"`
+}
+
 // RetryConfig represents retry configuration
 type RetryConfig struct {
 	MaxAttempts       int           `mapstructure:"max_attempts"`
@@ -111,53 +243,178 @@ type StrategiesConfig struct {
 	Minority   MinorityStrategy   `mapstructure:"minority"`
 	Whale      WhaleStrategy      `mapstructure:"whale"`
 	SmartMoney SmartMoneyStrategy `mapstructure:"smart_money"`
+	OISpike    OISpikeStrategy    `mapstructure:"oi_spike"`
+	Consensus  ConsensusStrategy  `mapstructure:"consensus"`
 	Global     GlobalStrategy     `mapstructure:"global"`
 }
 
+// StrategyCommon represents the settings shared by every strategy: whether and how often it
+// generates signals, and how its signals are confirmed, tracked and closed
+// Strategy configs embed it with ",squash", so its keys sit directly under the strategy's key
+type StrategyCommon struct {
+	Enabled                  bool    `mapstructure:"enabled" desc:"Generate signals with this strategy"`
+	Name                     string  `mapstructure:"name"`
+	ConfirmationHours        int     `mapstructure:"confirmation_hours" desc:"Hours the signal must hold before it is confirmed (0 = confirm instantly)" min:"0"`
+	TrackingHours            int     `mapstructure:"tracking_hours" desc:"Hours a signal is tracked after generation (0 = no time limit)" min:"0"`
+	ProfitTargetPct          float64 `mapstructure:"profit_target_pct" desc:"Price move in the signal direction counted as hitting the target, in %" exclusive_min:"0"`
+	StopLossPct              float64 `mapstructure:"stop_loss_pct" desc:"Adverse price move counted as hitting the stop, in %" exclusive_min:"0"`
+	MaxSignalsPerDay         int     `mapstructure:"max_signals_per_day" desc:"Stop generating once this many signals were created since local midnight (0 = use strategies.global.max_signals_per_strategy_per_day)" min:"0"`
+	KlineFromConfirmation    bool    `mapstructure:"kline_from_confirmation" desc:"Start kline tracking at the end of the confirmation window instead of signal generation"`
+	KlineEntryAtConfirmation bool    `mapstructure:"kline_entry_at_confirmation" desc:"Measure kline changes from the price captured at confirmation instead of the signal price (implies kline_from_confirmation)"`
+}
+
+// MarketStrategyCommon represents the settings shared by the strategies that analyze market
+// data directly, as opposed to the consensus strategy that combines their signals
+type MarketStrategyCommon struct {
+	StrategyCommon `mapstructure:",squash"`
+
+	RequireConsecutivePoints int     `mapstructure:"require_consecutive_points" desc:"Condition must hold for N consecutive data points" min:"1"`
+	UseSmoothedRatios        bool    `mapstructure:"use_smoothed_ratios" desc:"Evaluate thresholds on recency-weighted average ratios instead of the latest data point"`
+	SmoothingHalfLifePoints  float64 `mapstructure:"smoothing_half_life_points" desc:"Age in data points at which a reading's weight halves when smoothing" min:"0"`
+	MinLiquidityTier         string  `mapstructure:"min_liquidity_tier" desc:"Only run on pairs at or above this tier: LOW, MEDIUM, HIGH (empty = all)" enum:",LOW,MEDIUM,HIGH"`
+	MinDataQualityScore      int     `mapstructure:"min_data_quality_score" desc:"Skip symbols whose latest data quality score is below this, e.g. 100 requires position ratio data (0 = disabled)" min:"0" max:"100"`
+	AvoidPreFundingMinutes   int     `mapstructure:"avoid_pre_funding_minutes" desc:"Skip symbols within this many minutes before a funding settlement (0 = disabled)" min:"0" max:"480"`
+	LookbackHours            int     `mapstructure:"lookback_hours" desc:"Hours of market data history the strategy analyzes (0 = use strategies.global.analysis_lookback_hours)" min:"0"`
+
+	// Volatility-based trade levels, replacing the percentage stop loss and profit target
+	ATRLevels ATRLevelsConfig `mapstructure:"atr_levels"`
+
+	// Symbols the strategy runs on; the analyzer skips it on other symbols
+	Symbols SymbolFilterConfig `mapstructure:"symbols"`
+}
+
 // MinorityStrategy represents minority follower strategy configuration
 type MinorityStrategy struct {
-	Enabled                         bool    `mapstructure:"enabled"`
-	Name                            string  `mapstructure:"name"`
-	MinRatioDifference              float64 `mapstructure:"min_ratio_difference"`
-	ConfirmationHours               int     `mapstructure:"confirmation_hours"`
-	GenerateLongWhenShortRatioAbove float64 `mapstructure:"generate_long_when_short_ratio_above"`
-	GenerateShortWhenLongRatioAbove float64 `mapstructure:"generate_short_when_long_ratio_above"`
-	TrackingHours                   int     `mapstructure:"tracking_hours"`
-	ProfitTargetPct                 float64 `mapstructure:"profit_target_pct"`
-	StopLossPct                     float64 `mapstructure:"stop_loss_pct"`
+	MarketStrategyCommon `mapstructure:",squash"`
+
+	MinRatioDifference              float64 `mapstructure:"min_ratio_difference" desc:"Minimum account ratio of the majority side, in % (60 = 60:40 or more extreme)" min:"50" max:"100"`
+	GenerateLongWhenShortRatioAbove float64 `mapstructure:"generate_long_when_short_ratio_above" desc:"Generate a LONG signal when the short account ratio is at or above this %" min:"50" max:"100"`
+	GenerateShortWhenLongRatioAbove float64 `mapstructure:"generate_short_when_long_ratio_above" desc:"Generate a SHORT signal when the long account ratio is at or above this %" min:"50" max:"100"`
 }
 
 // SmartMoneyStrategy represents smart money (liquidity grab) strategy configuration
 type SmartMoneyStrategy struct {
-	Enabled             bool    `mapstructure:"enabled"`
-	Name                string  `mapstructure:"name"`
-	MinLongAccountRatio float64 `mapstructure:"min_long_account_ratio"`
-	LookbackPeriod      int     `mapstructure:"lookback_period"`
-	KlineInterval       string  `mapstructure:"kline_interval"`
-	ConfirmationHours   int     `mapstructure:"confirmation_hours"`
-	TrackingHours       int     `mapstructure:"tracking_hours"`
-	ProfitTargetPct     float64 `mapstructure:"profit_target_pct"`
-	StopLossPct         float64 `mapstructure:"stop_loss_pct"`
+	MarketStrategyCommon `mapstructure:",squash"`
+
+	MinLongAccountRatio float64 `mapstructure:"min_long_account_ratio" desc:"Minimum long account ratio of the crowd being hunted, in %" min:"50" max:"100"`
+	LookbackPeriod      int     `mapstructure:"lookback_period" desc:"Number of candles searched for the swing high" min:"1"`
+	KlineInterval       string  `mapstructure:"kline_interval" desc:"Kline interval of the candles analyzed" enum:"1m,3m,5m,15m,30m,1h,2h,4h,6h,8h,12h,1d"`
+	IndecisionMode      string  `mapstructure:"indecision_mode" desc:"Doji / Inside Bar trigger candle: empty (ignore), confluence (require) or filter (skip)" enum:",confluence,filter"`
+	DojiMaxBodyPct      float64 `mapstructure:"doji_max_body_pct" desc:"Maximum body size as % of the candle range to count as a Doji" min:"0" max:"100"`
+	MinTakerFlowRatio   float64 `mapstructure:"min_taker_flow_ratio" desc:"Taker sell/buy volume ratio required to confirm the short (0 = disabled)" min:"0"`
 }
 
 // WhaleStrategy represents whale position analysis strategy configuration
 type WhaleStrategy struct {
-	Enabled                bool    `mapstructure:"enabled"`
-	Name                   string  `mapstructure:"name"`
-	MinRatioDifference     float64 `mapstructure:"min_ratio_difference"`
-	WhalePositionThreshold float64 `mapstructure:"whale_position_threshold"`
-	ConfirmationHours      int     `mapstructure:"confirmation_hours"`
-	MinDivergence          float64 `mapstructure:"min_divergence"`
-	TrackingHours          int     `mapstructure:"tracking_hours"`
-	ProfitTargetPct        float64 `mapstructure:"profit_target_pct"`
-	StopLossPct            float64 `mapstructure:"stop_loss_pct"`
+	MarketStrategyCommon `mapstructure:",squash"`
+
+	MinRatioDifference        float64 `mapstructure:"min_ratio_difference" desc:"Minimum account ratio of the whale side, in %" min:"50" max:"100"`
+	WhalePositionThreshold    float64 `mapstructure:"whale_position_threshold" desc:"Minimum position ratio held by the whale side, in %" min:"0" max:"100"`
+	MinDivergence             float64 `mapstructure:"min_divergence" desc:"Minimum divergence between the account and position ratios, in percentage points" min:"0" max:"100"`
+	MinTakerFlowRatio         float64 `mapstructure:"min_taker_flow_ratio" desc:"Taker buy/sell volume ratio required in the signal direction (0 = disabled)" min:"0"`
+	RequireWideningDivergence bool    `mapstructure:"require_widening_divergence" desc:"Require the account/position divergence to have widened over the last few data points"`
+}
+
+// OISpikeStrategy represents open interest spike fade strategy configuration
+type OISpikeStrategy struct {
+	MarketStrategyCommon `mapstructure:",squash"`
+
+	MinOIChangePct  float64 `mapstructure:"min_oi_change_pct" desc:"Minimum open interest increase over the lookback, in %" min:"0"`
+	LookbackPoints  int     `mapstructure:"lookback_points" desc:"Number of recent data points the open interest change is summed over" min:"1"`
+	MinAccountRatio float64 `mapstructure:"min_account_ratio" desc:"Minimum account ratio of the crowded side being faded" min:"50" max:"100"`
+}
+
+// ATRLevelsConfig represents ATR-based stop loss and profit target configuration
+// Levels are set at signal time as entry price +/- a multiple of the Average True Range
+type ATRLevelsConfig struct {
+	Enabled          bool    `mapstructure:"enabled" desc:"Set the stop loss and profit target from ATR multiples instead of fixed percentages"`
+	Interval         string  `mapstructure:"interval" desc:"Kline interval the ATR is computed on (e.g. 1h)" enum:"1m,3m,5m,15m,30m,1h,2h,4h,6h,8h,12h,1d"`
+	Period           int     `mapstructure:"period" desc:"Number of klines averaged (Wilder smoothing)" min:"1"`
+	StopLossMultiple float64 `mapstructure:"stop_loss_multiple" desc:"Stop loss distance in ATRs" min:"0"`
+	TargetMultiple   float64 `mapstructure:"target_multiple" desc:"Profit target distance in ATRs" min:"0"`
+}
+
+// SymbolFilterConfig restricts a strategy to a subset of symbols
+// Entries are symbols or glob patterns such as "*USDC"; deny wins over allow
+type SymbolFilterConfig struct {
+	Allow []string `mapstructure:"allow"` // Only run on these symbols (empty = all symbols)
+	Deny  []string `mapstructure:"deny"`  // Never run on these symbols
+}
+
+// ConsensusStrategy represents multi-strategy consensus configuration
+type ConsensusStrategy struct {
+	StrategyCommon `mapstructure:",squash"`
+
+	MinAgreeingStrategies int `mapstructure:"min_agreeing_strategies" desc:"Minimum strategies agreeing on direction" min:"2"`
 }
 
 // GlobalStrategy represents global strategy settings
 type GlobalStrategy struct {
-	MinVolume24h                float64 `mapstructure:"min_volume_24h"`
-	MaxConcurrentSignalsPerPair int     `mapstructure:"max_concurrent_signals_per_pair"`
-	SignalCooldownHours         int     `mapstructure:"signal_cooldown_hours"`
+	MinVolume24h                float64       `mapstructure:"min_volume_24h"`
+	MaxConcurrentSignalsPerPair int           `mapstructure:"max_concurrent_signals_per_pair"`
+	SignalCooldownHours         int           `mapstructure:"signal_cooldown_hours"`
+	MaxDataAge                  time.Duration `mapstructure:"max_data_age"`     // Skip symbols whose latest data is older than this (0 = disabled)
+	AccountRiskPct              float64       `mapstructure:"account_risk_pct"` // Account equity % risked per signal for position sizing (0 = disabled)
+
+	// ConfirmationMaxAdverseMovePct invalidates a pending signal if price moved more than
+	// this % against its direction during the confirmation window (0 = disabled)
+	ConfirmationMaxAdverseMovePct float64 `mapstructure:"confirmation_max_adverse_move_pct"`
+
+	// ConfirmationExpiryGrace invalidates a signal still pending this long after its
+	// confirmation window ended, e.g. when market data stopped arriving (0 = disabled)
+	ConfirmationExpiryGrace time.Duration `mapstructure:"confirmation_expiry_grace"`
+
+	// KlineTrackingInterval is the Binance kline interval signals are tracked on after generation
+	// It is recorded on each signal, so changing it only affects new signals
+	KlineTrackingInterval string `mapstructure:"kline_tracking_interval"`
+
+	// ReentryCooldownHours blocks a new signal for the same symbol, strategy and direction
+	// for this long after the previous one closed (0 = disabled)
+	// Unlike SignalCooldownHours it counts from the close, not from signal generation
+	ReentryCooldownHours int `mapstructure:"reentry_cooldown_hours"`
+
+	// MaxSignalsPerStrategyPerDay caps the signals each strategy may generate per day, so a
+	// threshold tripped market-wide can't flood the book (0 = unlimited)
+	// Days start at midnight in app.timezone; a strategy's max_signals_per_day overrides it
+	MaxSignalsPerStrategyPerDay int `mapstructure:"max_signals_per_strategy_per_day"`
+
+	// AnalysisLookbackHours is how many hours of market data history strategies analyze
+	// A strategy's lookback_hours overrides it, e.g. longer for trend filters
+	AnalysisLookbackHours int `mapstructure:"analysis_lookback_hours"`
+
+	// AnalysisWorkers is the number of trading pairs analyzed in parallel per analysis run
+	AnalysisWorkers int `mapstructure:"analysis_workers"`
+
+	// MinListingAgeDays skips symbols listed on the exchange more recently than this, since
+	// fresh listings have erratic ratios and little history (0 = disabled)
+	MinListingAgeDays int `mapstructure:"min_listing_age_days"`
+
+	// TrackingPriceSource is the price stops and targets of tracked signals are checked
+	// against: "last" (last traded price) or "mark" (mark price, which drives liquidations
+	// and is harder to spike with a single trade)
+	TrackingPriceSource string `mapstructure:"tracking_price_source"`
+
+	// TrackingHistory controls signal_tracking growth: "full" writes a row on every tracking
+	// run, "latest" refreshes the latest row in place and only adds one on a new peak or trough
+	TrackingHistory string `mapstructure:"tracking_history"`
+
+	// InitialTracking stores a tracking record at the signal price together with each new
+	// signal, in the same transaction, so its detail has data before the first tracking run
+	InitialTracking bool `mapstructure:"initial_tracking"`
+
+	// SignalDedup handles signals several strategies generate for the same symbol and
+	// direction in one analysis run: "" keeps them all, "merge" keeps one signal listing
+	// every contributing strategy and "priority" keeps only the highest-priority one
+	SignalDedup string `mapstructure:"signal_dedup"`
+
+	// SignalDedupPriority lists strategy keys from highest to lowest priority for SignalDedup
+	// Unlisted strategies rank below the listed ones, in their configured order
+	SignalDedupPriority []string `mapstructure:"signal_dedup_priority"`
+
+	// SignalIDScheme is how signal IDs are assigned: "random" (a UUID per signal) or
+	// "deterministic" (derived from symbol, strategy, direction and generation hour in UTC,
+	// so re-running analysis within the same hour stores a signal only once)
+	SignalIDScheme string `mapstructure:"signal_id_scheme"`
 }
 
 // StatisticsConfig represents statistics calculation configuration
@@ -165,6 +422,7 @@ type StatisticsConfig struct {
 	CalculationInterval string                     `mapstructure:"calculation_interval"`
 	Periods             []string                   `mapstructure:"periods"`
 	Percentiles         []int                      `mapstructure:"percentiles"`
+	HoldingHistogram    []float64                  `mapstructure:"holding_histogram"` // Upper bounds in hours of the holding time buckets (empty = no histogram)
 	Monitoring          StatisticsMonitoringConfig `mapstructure:"monitoring"`
 }
 
@@ -177,14 +435,124 @@ type StatisticsMonitoringConfig struct {
 	AvgLossChangeThreshold      float64 `mapstructure:"avg_loss_change_threshold"`
 	ProfitFactorChangeThreshold float64 `mapstructure:"profit_factor_change_threshold"`
 	SignalCountChangeThreshold  float64 `mapstructure:"signal_count_change_threshold"`
+
+	// Per-strategy threshold overrides keyed by strategy name (e.g. MinorityStrategy), matched case-insensitively
+	Strategies map[string]StatisticsMonitoringThresholds `mapstructure:"strategies"`
+}
+
+// StatisticsMonitoringThresholds overrides monitoring thresholds for one strategy
+// Unset thresholds fall back to the global values
+type StatisticsMonitoringThresholds struct {
+	WinRateChangeThreshold      *float64 `mapstructure:"win_rate_change_threshold"`
+	ProfitRatioChangeThreshold  *float64 `mapstructure:"profit_ratio_change_threshold"`
+	AvgProfitChangeThreshold    *float64 `mapstructure:"avg_profit_change_threshold"`
+	AvgLossChangeThreshold      *float64 `mapstructure:"avg_loss_change_threshold"`
+	ProfitFactorChangeThreshold *float64 `mapstructure:"profit_factor_change_threshold"`
+	SignalCountChangeThreshold  *float64 `mapstructure:"signal_count_change_threshold"`
+}
+
+// ForStrategy returns the monitoring config with the strategy's threshold overrides applied
+// Config keys are lowercased by the loader, so the strategy name is matched case-insensitively
+func (c StatisticsMonitoringConfig) ForStrategy(strategyName string) StatisticsMonitoringConfig {
+	resolved := c
+	resolved.Strategies = nil
+
+	override, ok := c.Strategies[strings.ToLower(strategyName)]
+	if !ok {
+		for name, thresholds := range c.Strategies {
+			if strings.EqualFold(name, strategyName) {
+				override, ok = thresholds, true
+				break
+			}
+		}
+	}
+	if !ok {
+		return resolved
+	}
+
+	if override.WinRateChangeThreshold != nil {
+		resolved.WinRateChangeThreshold = *override.WinRateChangeThreshold
+	}
+	if override.ProfitRatioChangeThreshold != nil {
+		resolved.ProfitRatioChangeThreshold = *override.ProfitRatioChangeThreshold
+	}
+	if override.AvgProfitChangeThreshold != nil {
+		resolved.AvgProfitChangeThreshold = *override.AvgProfitChangeThreshold
+	}
+	if override.AvgLossChangeThreshold != nil {
+		resolved.AvgLossChangeThreshold = *override.AvgLossChangeThreshold
+	}
+	if override.ProfitFactorChangeThreshold != nil {
+		resolved.ProfitFactorChangeThreshold = *override.ProfitFactorChangeThreshold
+	}
+	if override.SignalCountChangeThreshold != nil {
+		resolved.SignalCountChangeThreshold = *override.SignalCountChangeThreshold
+	}
+
+	return resolved
+}
+
+// RetentionConfig represents old-data cleanup configuration
+type RetentionConfig struct {
+	Enabled        bool   `mapstructure:"enabled"`
+	Schedule       string `mapstructure:"schedule"`
+	MarketDataDays int    `mapstructure:"market_data_days"` // Delete market data older than this (0 = keep forever)
+	StatisticsDays int    `mapstructure:"statistics_days"`  // Delete statistics not recalculated within this window (0 = keep forever)
+}
+
+// PaperTradingConfig represents the simulated account used to build equity curves
+type PaperTradingConfig struct {
+	Enabled            bool    `mapstructure:"enabled"`
+	Schedule           string  `mapstructure:"schedule"`
+	InitialBalance     float64 `mapstructure:"initial_balance"`       // Starting balance (USDT) of every equity curve
+	RiskPerTradePct    float64 `mapstructure:"risk_per_trade_pct"`    // % of balance lost when a trade hits its stop
+	DefaultStopLossPct float64 `mapstructure:"default_stop_loss_pct"` // Stop distance used when a signal has none recorded
+}
+
+// SchedulerConfig represents scheduled job configuration
+type SchedulerConfig struct {
+	JobTimeouts     JobTimeoutsConfig `mapstructure:"job_timeouts"`
+	ShutdownTimeout time.Duration     `mapstructure:"shutdown_timeout"` // Max time to wait for in-flight jobs on shutdown
+
+	// Redis lock so only one replica runs each job per tick
+	DistributedLock DistributedLockConfig `mapstructure:"distributed_lock"`
+}
+
+// DistributedLockConfig represents the Redis lock taken by scheduled jobs when running multiple replicas
+type DistributedLockConfig struct {
+	Enabled   bool          `mapstructure:"enabled"`    // Disabled for single-instance deployments
+	TTL       time.Duration `mapstructure:"ttl"`        // Lock expiry, renewed every TTL/3 while the job runs
+	KeyPrefix string        `mapstructure:"key_prefix"` // Redis key prefix, followed by the job name and tick
+}
+
+// JobTimeoutsConfig represents the maximum run time of each scheduled job
+// A job exceeding its timeout has its context cancelled
+type JobTimeoutsConfig struct {
+	Collection    time.Duration `mapstructure:"collection"`
+	Analysis      time.Duration `mapstructure:"analysis"`
+	Tracking      time.Duration `mapstructure:"tracking"`
+	KlineTracking time.Duration `mapstructure:"kline_tracking"`
+	Statistics    time.Duration `mapstructure:"statistics"`
+	Retention     time.Duration `mapstructure:"retention"`
+	PaperTrading  time.Duration `mapstructure:"paper_trading"`
 }
 
 // NotificationsConfig represents all notification configurations
 type NotificationsConfig struct {
-	Telegram TelegramConfig `mapstructure:"telegram"`
-	Email    EmailConfig    `mapstructure:"email"`
-	Webhook  WebhookConfig  `mapstructure:"webhook"`
-	Console  ConsoleConfig  `mapstructure:"console"`
+	Telegram  TelegramConfig  `mapstructure:"telegram"`
+	Email     EmailConfig     `mapstructure:"email"`
+	Webhook   WebhookConfig   `mapstructure:"webhook"`
+	Console   ConsoleConfig   `mapstructure:"console"`
+	WebSocket WebSocketConfig `mapstructure:"websocket"`
+
+	// Throttle drops repeated notifications of one event type for the same symbol
+	Throttle NotificationThrottleConfig `mapstructure:"throttle"`
+}
+
+// NotificationThrottleConfig represents notification throttling configuration
+type NotificationThrottleConfig struct {
+	Enabled bool          `mapstructure:"enabled"`
+	Window  time.Duration `mapstructure:"window"` // Repeats within this long after a sent notification are dropped
 }
 
 // TelegramConfig represents Telegram notification configuration
@@ -224,6 +592,16 @@ type ConsoleConfig struct {
 	Events  []string `mapstructure:"events"`
 }
 
+// WebSocketConfig represents websocket push configuration
+type WebSocketConfig struct {
+	Enabled          bool          `mapstructure:"enabled"`
+	Events           []string      `mapstructure:"events"`
+	ClientBufferSize int           `mapstructure:"client_buffer_size"` // Pending messages per client before dropping
+	PingInterval     time.Duration `mapstructure:"ping_interval"`
+	PongTimeout      time.Duration `mapstructure:"pong_timeout"`
+	AllowedOrigins   []string      `mapstructure:"allowed_origins"` // Empty = allow all origins
+}
+
 // LoggingConfig represents logging configuration
 type LoggingConfig struct {
 	Level  string        `mapstructure:"level"`
@@ -261,6 +639,15 @@ type HealthCheckConfig struct {
 	Path    string `mapstructure:"path"`
 }
 
+// SelfTestConfig represents the startup self-test, which fetches one symbol and evaluates
+// the enabled strategies against it before the first scheduled run
+type SelfTestConfig struct {
+	Enabled  bool          `mapstructure:"enabled"`
+	Symbol   string        `mapstructure:"symbol"`    // Well-known symbol to fetch, e.g. BTCUSDT
+	FailFast bool          `mapstructure:"fail_fast"` // Exit on failure instead of logging a warning
+	Timeout  time.Duration `mapstructure:"timeout"`
+}
+
 // FeaturesConfig represents feature flags
 type FeaturesConfig struct {
 	BacktestMode bool `mapstructure:"backtest_mode"`
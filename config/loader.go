@@ -2,8 +2,15 @@ package config
 
 import (
 	"fmt"
+	"net"
 	"os"
+	"path"
+	"reflect"
 	"strings"
+	"time"
+
+	"ContractAnalysis/internal/domain/entity"
+	"ContractAnalysis/pkg/utils"
 
 	"github.com/spf13/viper"
 )
@@ -32,6 +39,12 @@ func Load(configPath string) (*Config, error) {
 	// Set defaults
 	setDefaults(v)
 
+	// Bind every config key to its environment variable, so keys without a default
+	// (credentials, strategy toggles) can be set from the environment without a config file
+	if err := bindEnvs(v, reflect.TypeOf(Config{}), ""); err != nil {
+		return nil, fmt.Errorf("failed to bind environment variables: %w", err)
+	}
+
 	// Read config file
 	if err := v.ReadInConfig(); err != nil {
 		if _, ok := err.(viper.ConfigFileNotFoundError); ok {
@@ -56,6 +69,60 @@ func Load(configPath string) (*Config, error) {
 	return &config, nil
 }
 
+// EnvVarName returns the environment variable that overrides a config key,
+// e.g. "database.mysql.password" is set by CA_DATABASE_MYSQL_PASSWORD
+func EnvVarName(key string) string {
+	return "CA_" + strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+}
+
+// bindEnvs binds the environment variable of every leaf key under the struct type t
+// AutomaticEnv only resolves keys viper already knows from defaults or the config file,
+// so without explicit binding an env-only deployment would silently ignore the rest
+// Map-valued settings have no fixed keys and are left to the config file
+func bindEnvs(v *viper.Viper, t reflect.Type, prefix string) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag, opts, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+
+		// Squashed embedded structs contribute their keys to the parent
+		if field.Anonymous && opts == "squash" {
+			if err := bindEnvs(v, field.Type, prefix); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if tag == "" || tag == "-" {
+			continue
+		}
+
+		key := tag
+		if prefix != "" {
+			key = prefix + "." + tag
+		}
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		switch {
+		case fieldType.Kind() == reflect.Map:
+			continue
+		case fieldType.Kind() == reflect.Struct && fieldType != reflect.TypeOf(time.Time{}):
+			if err := bindEnvs(v, fieldType, key); err != nil {
+				return err
+			}
+		default:
+			if err := v.BindEnv(key, EnvVarName(key)); err != nil {
+				return fmt.Errorf("failed to bind %s: %w", key, err)
+			}
+		}
+	}
+
+	return nil
+}
+
 // setDefaults sets default configuration values
 func setDefaults(v *viper.Viper) {
 	// App defaults
@@ -69,20 +136,48 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("server.port", 8080)
 	v.SetDefault("server.read_timeout", "30s")
 	v.SetDefault("server.write_timeout", "30s")
+	v.SetDefault("server.handler_timeout", "25s")
+	v.SetDefault("server.trusted_proxies", []string{})
+	v.SetDefault("server.auth.enabled", false)
+	v.SetDefault("server.rate_limit.enabled", false)
+	v.SetDefault("server.rate_limit.requests_per_minute", 120)
+	v.SetDefault("server.rate_limit.burst", 20)
+	v.SetDefault("server.rate_limit.refresh_requests_per_minute", 6)
+	v.SetDefault("server.rate_limit.refresh_burst", 2)
+	v.SetDefault("server.compression.enabled", true)
+	v.SetDefault("server.compression.min_size", 1024)
 
 	// Binance defaults
-	v.SetDefault("binance.api_url", "https://fapi.binance.com")
+	v.SetDefault("binance.api_url", "")
+	v.SetDefault("binance.use_testnet", false)
 	v.SetDefault("binance.rate_limit.requests_per_minute", 1200)
 	v.SetDefault("binance.rate_limit.weight_per_minute", 2400)
 	v.SetDefault("binance.timeout", "10s")
+	v.SetDefault("binance.circuit_breaker.enabled", true)
+	v.SetDefault("binance.circuit_breaker.failure_threshold", 10)
+	v.SetDefault("binance.circuit_breaker.cooldown", "1m")
+	v.SetDefault("binance.clock_sync.enabled", true)
+	v.SetDefault("binance.clock_sync.interval", "30m")
+	v.SetDefault("binance.clock_sync.max_skew", "1s")
 
 	// Collection defaults
 	v.SetDefault("collection.enabled", true)
 	v.SetDefault("collection.interval", "0 * * * *")
 	v.SetDefault("collection.pair_filter.quote_asset", "USDT")
+	v.SetDefault("collection.pair_filter.margin_mode", MarginModeUSDT)
 	v.SetDefault("collection.retry.max_attempts", 3)
 	v.SetDefault("collection.retry.delay", "5s")
 	v.SetDefault("collection.retry.backoff_multiplier", 2.0)
+	v.SetDefault("collection.max_consecutive_failures", 5)
+	v.SetDefault("collection.success_rate_alert.enabled", true)
+	v.SetDefault("collection.success_rate_alert.smoothing_factor", 0.3)
+	v.SetDefault("collection.success_rate_alert.threshold", 90.0)
+	v.SetDefault("collection.success_rate_alert.consecutive_runs", 3)
+	v.SetDefault("collection.liquidity_tiers.enabled", true)
+	v.SetDefault("collection.liquidity_tiers.high_min_volume_24h", 500000000)
+	v.SetDefault("collection.liquidity_tiers.high_min_open_interest", 100000000)
+	v.SetDefault("collection.liquidity_tiers.medium_min_volume_24h", 50000000)
+	v.SetDefault("collection.liquidity_tiers.medium_min_open_interest", 10000000)
 
 	// Database defaults
 	v.SetDefault("database.type", "mysql")
@@ -117,6 +212,19 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("strategies.minority.tracking_hours", 24)
 	v.SetDefault("strategies.minority.profit_target_pct", 5.0)
 	v.SetDefault("strategies.minority.stop_loss_pct", 2.0)
+	v.SetDefault("strategies.minority.require_consecutive_points", 1)
+	v.SetDefault("strategies.minority.use_smoothed_ratios", false)
+	v.SetDefault("strategies.minority.smoothing_half_life_points", 3.0)
+	v.SetDefault("strategies.minority.min_data_quality_score", 0)
+	v.SetDefault("strategies.minority.avoid_pre_funding_minutes", 0)
+	v.SetDefault("strategies.minority.max_signals_per_day", 0)
+	v.SetDefault("strategies.minority.kline_from_confirmation", false)
+	v.SetDefault("strategies.minority.kline_entry_at_confirmation", false)
+	v.SetDefault("strategies.minority.atr_levels.enabled", false)
+	v.SetDefault("strategies.minority.atr_levels.interval", "1h")
+	v.SetDefault("strategies.minority.atr_levels.period", 14)
+	v.SetDefault("strategies.minority.atr_levels.stop_loss_multiple", 1.5)
+	v.SetDefault("strategies.minority.atr_levels.target_multiple", 3.0)
 
 	v.SetDefault("strategies.whale.enabled", true)
 	v.SetDefault("strategies.whale.name", "Whale Position Analysis")
@@ -127,19 +235,144 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("strategies.whale.tracking_hours", 24)
 	v.SetDefault("strategies.whale.profit_target_pct", 5.0)
 	v.SetDefault("strategies.whale.stop_loss_pct", 2.0)
+	v.SetDefault("strategies.whale.require_consecutive_points", 1)
+	v.SetDefault("strategies.whale.use_smoothed_ratios", false)
+	v.SetDefault("strategies.whale.smoothing_half_life_points", 3.0)
+	v.SetDefault("strategies.whale.min_data_quality_score", 100)
+	v.SetDefault("strategies.whale.avoid_pre_funding_minutes", 0)
+	v.SetDefault("strategies.whale.max_signals_per_day", 0)
+	v.SetDefault("strategies.whale.min_taker_flow_ratio", 0.0)
+	v.SetDefault("strategies.whale.require_widening_divergence", false)
+	v.SetDefault("strategies.whale.kline_from_confirmation", false)
+	v.SetDefault("strategies.whale.kline_entry_at_confirmation", false)
+	v.SetDefault("strategies.whale.atr_levels.enabled", false)
+	v.SetDefault("strategies.whale.atr_levels.interval", "1h")
+	v.SetDefault("strategies.whale.atr_levels.period", 14)
+	v.SetDefault("strategies.whale.atr_levels.stop_loss_multiple", 1.5)
+	v.SetDefault("strategies.whale.atr_levels.target_multiple", 3.0)
+
+	v.SetDefault("strategies.smart_money.enabled", false)
+	v.SetDefault("strategies.smart_money.name", "Smart Money (Liquidity Grab)")
+	v.SetDefault("strategies.smart_money.min_long_account_ratio", 62.0)
+	v.SetDefault("strategies.smart_money.lookback_period", 24)
+	v.SetDefault("strategies.smart_money.kline_interval", "1h")
+	v.SetDefault("strategies.smart_money.confirmation_hours", 1)
+	v.SetDefault("strategies.smart_money.tracking_hours", 24)
+	v.SetDefault("strategies.smart_money.profit_target_pct", 6.0)
+	v.SetDefault("strategies.smart_money.stop_loss_pct", 1.5)
+	v.SetDefault("strategies.smart_money.require_consecutive_points", 1)
+	v.SetDefault("strategies.smart_money.use_smoothed_ratios", false)
+	v.SetDefault("strategies.smart_money.smoothing_half_life_points", 3.0)
+	v.SetDefault("strategies.smart_money.min_data_quality_score", 100)
+	v.SetDefault("strategies.smart_money.avoid_pre_funding_minutes", 0)
+	v.SetDefault("strategies.smart_money.max_signals_per_day", 0)
+	v.SetDefault("strategies.smart_money.indecision_mode", "")
+	v.SetDefault("strategies.smart_money.doji_max_body_pct", 10.0)
+	v.SetDefault("strategies.smart_money.min_taker_flow_ratio", 0.0)
+	v.SetDefault("strategies.smart_money.kline_from_confirmation", false)
+	v.SetDefault("strategies.smart_money.kline_entry_at_confirmation", false)
+	v.SetDefault("strategies.smart_money.atr_levels.enabled", false)
+	v.SetDefault("strategies.smart_money.atr_levels.interval", "1h")
+	v.SetDefault("strategies.smart_money.atr_levels.period", 14)
+	v.SetDefault("strategies.smart_money.atr_levels.stop_loss_multiple", 1.5)
+	v.SetDefault("strategies.smart_money.atr_levels.target_multiple", 3.0)
+
+	v.SetDefault("strategies.oi_spike.enabled", false)
+	v.SetDefault("strategies.oi_spike.name", "OI Spike Fade")
+	v.SetDefault("strategies.oi_spike.min_oi_change_pct", 5.0)
+	v.SetDefault("strategies.oi_spike.lookback_points", 1)
+	v.SetDefault("strategies.oi_spike.min_account_ratio", 70.0)
+	v.SetDefault("strategies.oi_spike.confirmation_hours", 1)
+	v.SetDefault("strategies.oi_spike.tracking_hours", 24)
+	v.SetDefault("strategies.oi_spike.profit_target_pct", 4.0)
+	v.SetDefault("strategies.oi_spike.stop_loss_pct", 2.0)
+	v.SetDefault("strategies.oi_spike.require_consecutive_points", 1)
+	v.SetDefault("strategies.oi_spike.use_smoothed_ratios", false)
+	v.SetDefault("strategies.oi_spike.smoothing_half_life_points", 3.0)
+	v.SetDefault("strategies.oi_spike.min_data_quality_score", 0)
+	v.SetDefault("strategies.oi_spike.avoid_pre_funding_minutes", 0)
+	v.SetDefault("strategies.oi_spike.max_signals_per_day", 0)
+	v.SetDefault("strategies.oi_spike.kline_from_confirmation", false)
+	v.SetDefault("strategies.oi_spike.kline_entry_at_confirmation", false)
+	v.SetDefault("strategies.oi_spike.atr_levels.enabled", false)
+	v.SetDefault("strategies.oi_spike.atr_levels.interval", "1h")
+	v.SetDefault("strategies.oi_spike.atr_levels.period", 14)
+	v.SetDefault("strategies.oi_spike.atr_levels.stop_loss_multiple", 1.5)
+	v.SetDefault("strategies.oi_spike.atr_levels.target_multiple", 3.0)
+
+	v.SetDefault("strategies.consensus.enabled", false)
+	v.SetDefault("strategies.consensus.name", "Consensus")
+	v.SetDefault("strategies.consensus.min_agreeing_strategies", 2)
+	v.SetDefault("strategies.consensus.max_signals_per_day", 0)
+	v.SetDefault("strategies.consensus.confirmation_hours", 2)
+	v.SetDefault("strategies.consensus.tracking_hours", 24)
+	v.SetDefault("strategies.consensus.profit_target_pct", 5.0)
+	v.SetDefault("strategies.consensus.stop_loss_pct", 2.0)
+	v.SetDefault("strategies.consensus.kline_from_confirmation", false)
+	v.SetDefault("strategies.consensus.kline_entry_at_confirmation", false)
 
 	v.SetDefault("strategies.global.min_volume_24h", 1000000)
 	v.SetDefault("strategies.global.max_concurrent_signals_per_pair", 3)
 	v.SetDefault("strategies.global.signal_cooldown_hours", 6)
+	v.SetDefault("strategies.global.max_data_age", "15m")
+	v.SetDefault("strategies.global.analysis_lookback_hours", 24)
+	v.SetDefault("strategies.global.account_risk_pct", 1.0)
+	v.SetDefault("strategies.global.confirmation_max_adverse_move_pct", 0.0)
+	v.SetDefault("strategies.global.confirmation_expiry_grace", "1h")
+	v.SetDefault("strategies.global.kline_tracking_interval", "1h")
+	v.SetDefault("strategies.global.reentry_cooldown_hours", 0)
+	v.SetDefault("strategies.global.max_signals_per_strategy_per_day", 0)
+	v.SetDefault("strategies.global.analysis_workers", 4)
+	v.SetDefault("strategies.global.min_listing_age_days", 3)
+	v.SetDefault("strategies.global.tracking_price_source", "last")
+	v.SetDefault("strategies.global.tracking_history", "full")
+	v.SetDefault("strategies.global.initial_tracking", false)
+	v.SetDefault("strategies.global.signal_dedup", "")
+	v.SetDefault("strategies.global.signal_dedup_priority", []string{})
+	v.SetDefault("strategies.global.signal_id_scheme", "random")
 
 	// Statistics defaults
 	v.SetDefault("statistics.calculation_interval", "0 */6 * * *")
 	v.SetDefault("statistics.periods", []string{"24h", "7d", "30d", "all"})
 	v.SetDefault("statistics.percentiles", []int{25, 50, 75, 90, 95})
+	v.SetDefault("statistics.holding_histogram", []float64{1, 4, 12, 24, 48})
+
+	// Retention defaults
+	v.SetDefault("retention.enabled", true)
+	v.SetDefault("retention.schedule", "0 30 3 * * *")
+	v.SetDefault("retention.market_data_days", 90)
+	v.SetDefault("retention.statistics_days", 180)
+
+	// Paper trading defaults
+	v.SetDefault("paper_trading.enabled", true)
+	v.SetDefault("paper_trading.schedule", "0 20 * * * *")
+	v.SetDefault("paper_trading.initial_balance", 10000.0)
+	v.SetDefault("paper_trading.risk_per_trade_pct", 1.0)
+	v.SetDefault("paper_trading.default_stop_loss_pct", 2.0)
+
+	// Scheduler defaults
+	v.SetDefault("scheduler.shutdown_timeout", "30s")
+	v.SetDefault("scheduler.distributed_lock.enabled", false)
+	v.SetDefault("scheduler.distributed_lock.ttl", "1m")
+	v.SetDefault("scheduler.distributed_lock.key_prefix", "contract_analysis:job_lock:")
+	v.SetDefault("scheduler.job_timeouts.collection", "30m")
+	v.SetDefault("scheduler.job_timeouts.analysis", "15m")
+	v.SetDefault("scheduler.job_timeouts.tracking", "10m")
+	v.SetDefault("scheduler.job_timeouts.kline_tracking", "30m")
+	v.SetDefault("scheduler.job_timeouts.statistics", "30m")
+	v.SetDefault("scheduler.job_timeouts.retention", "1h")
+	v.SetDefault("scheduler.job_timeouts.paper_trading", "10m")
 
 	// Notification defaults
 	v.SetDefault("notifications.console.enabled", true)
 	v.SetDefault("notifications.console.events", []string{"signal_generated", "signal_confirmed", "signal_invalidated", "signal_outcome"})
+	v.SetDefault("notifications.websocket.enabled", true)
+	v.SetDefault("notifications.websocket.events", []string{"signal_generated", "signal_outcome", "statistics_change"})
+	v.SetDefault("notifications.websocket.client_buffer_size", 64)
+	v.SetDefault("notifications.websocket.ping_interval", "30s")
+	v.SetDefault("notifications.websocket.pong_timeout", "60s")
+	v.SetDefault("notifications.throttle.enabled", false)
+	v.SetDefault("notifications.throttle.window", "30m")
 
 	// Logging defaults
 	v.SetDefault("logging.level", "info")
@@ -163,6 +396,12 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("features.backtest_mode", false)
 	v.SetDefault("features.dry_run", false)
 	v.SetDefault("features.debug_signals", false)
+
+	// Self-test defaults
+	v.SetDefault("self_test.enabled", false)
+	v.SetDefault("self_test.symbol", "BTCUSDT")
+	v.SetDefault("self_test.fail_fast", false)
+	v.SetDefault("self_test.timeout", "30s")
 }
 
 // validate validates the configuration
@@ -172,10 +411,96 @@ func validate(config *Config) error {
 		return fmt.Errorf("app.name is required")
 	}
 
-	// Validate Binance config if collection is enabled
-	if config.Collection.Enabled {
-		if config.Binance.APIURL == "" {
-			return fmt.Errorf("binance.api_url is required when collection is enabled")
+	// Validate API server protection
+	if config.Server.Auth.Enabled && len(config.Server.Auth.APIKeys) == 0 {
+		return fmt.Errorf("server.auth.api_keys must not be empty when server.auth is enabled")
+	}
+	if config.Server.RateLimit.Enabled {
+		if config.Server.RateLimit.RequestsPerMinute <= 0 {
+			return fmt.Errorf("server.rate_limit.requests_per_minute must be greater than 0")
+		}
+		if config.Server.RateLimit.Burst <= 0 {
+			return fmt.Errorf("server.rate_limit.burst must be greater than 0")
+		}
+	}
+	if config.Server.RateLimit.RefreshPerMinute <= 0 {
+		return fmt.Errorf("server.rate_limit.refresh_requests_per_minute must be greater than 0")
+	}
+	if config.Server.RateLimit.RefreshBurst <= 0 {
+		return fmt.Errorf("server.rate_limit.refresh_burst must be greater than 0")
+	}
+	if config.Server.HandlerTimeout < 0 {
+		return fmt.Errorf("server.handler_timeout must not be negative")
+	}
+	if config.Server.HandlerTimeout > 0 && config.Server.WriteTimeout > 0 &&
+		config.Server.HandlerTimeout >= config.Server.WriteTimeout {
+		return fmt.Errorf("server.handler_timeout must be less than server.write_timeout")
+	}
+	if config.Server.Compression.MinSize < 0 {
+		return fmt.Errorf("server.compression.min_size must not be negative")
+	}
+	for _, proxy := range config.Server.TrustedProxies {
+		if net.ParseIP(proxy) == nil {
+			if _, _, err := net.ParseCIDR(proxy); err != nil {
+				return fmt.Errorf("server.trusted_proxies: %q is not an IP or CIDR", proxy)
+			}
+		}
+	}
+
+	// An API key is useless without its secret, and vice versa
+	if (config.Binance.APIKey == "") != (config.Binance.APISecret == "") {
+		return fmt.Errorf("binance.api_key and binance.api_secret must be set together")
+	}
+
+	// Validate Binance network selection
+	baseURL := config.Binance.BaseURL()
+	if config.Binance.UseTestnet && baseURL == BinanceMainnetURL {
+		return fmt.Errorf("binance.api_url points to mainnet while binance.use_testnet is enabled")
+	}
+	if !config.Binance.UseTestnet && baseURL == BinanceTestnetURL {
+		return fmt.Errorf("binance.api_url points to testnet while binance.use_testnet is disabled")
+	}
+	if config.Binance.UseTestnet && config.Binance.CoinMBaseURL() == BinanceCoinMMainnetURL {
+		return fmt.Errorf("binance.coin_m_api_url points to mainnet while binance.use_testnet is enabled")
+	}
+
+	// Validate Binance circuit breaker
+	if config.Binance.CircuitBreaker.Enabled {
+		if config.Binance.CircuitBreaker.FailureThreshold < 1 {
+			return fmt.Errorf("binance.circuit_breaker.failure_threshold must be at least 1, got: %d", config.Binance.CircuitBreaker.FailureThreshold)
+		}
+		if config.Binance.CircuitBreaker.Cooldown <= 0 {
+			return fmt.Errorf("binance.circuit_breaker.cooldown must be positive")
+		}
+	}
+
+	if config.Binance.ClockSync.Enabled {
+		if config.Binance.ClockSync.Interval < time.Minute {
+			return fmt.Errorf("binance.clock_sync.interval must be at least 1m, got: %s", config.Binance.ClockSync.Interval)
+		}
+		if config.Binance.ClockSync.MaxSkew <= 0 {
+			return fmt.Errorf("binance.clock_sync.max_skew must be positive")
+		}
+	}
+
+	// Validate collection
+	switch config.Collection.PairFilter.MarginMode {
+	case MarginModeUSDT, MarginModeCoin, MarginModeBoth:
+	default:
+		return fmt.Errorf("collection.pair_filter.margin_mode must be one of: usdt, coin, both")
+	}
+	if config.Collection.MaxConsecutiveFailures < 0 {
+		return fmt.Errorf("collection.max_consecutive_failures must be >= 0")
+	}
+	if alert := config.Collection.SuccessRateAlert; alert.Enabled {
+		if alert.SmoothingFactor <= 0 || alert.SmoothingFactor > 1 {
+			return fmt.Errorf("collection.success_rate_alert.smoothing_factor must be greater than 0 and at most 1")
+		}
+		if alert.Threshold <= 0 || alert.Threshold > 100 {
+			return fmt.Errorf("collection.success_rate_alert.threshold must be greater than 0 and at most 100")
+		}
+		if alert.ConsecutiveRuns < 1 {
+			return fmt.Errorf("collection.success_rate_alert.consecutive_runs must be at least 1")
 		}
 	}
 
@@ -206,6 +531,394 @@ func validate(config *Config) error {
 		}
 	}
 
+	if config.Strategies.SmartMoney.Enabled {
+		switch config.Strategies.SmartMoney.IndecisionMode {
+		case "", "confluence", "filter":
+		default:
+			return fmt.Errorf("strategies.smart_money.indecision_mode must be one of: confluence, filter (empty = disabled)")
+		}
+		if config.Strategies.SmartMoney.DojiMaxBodyPct <= 0 || config.Strategies.SmartMoney.DojiMaxBodyPct > 100 {
+			return fmt.Errorf("strategies.smart_money.doji_max_body_pct must be between 0 and 100")
+		}
+	}
+
+	if config.Strategies.OISpike.Enabled {
+		if config.Strategies.OISpike.MinOIChangePct <= 0 {
+			return fmt.Errorf("strategies.oi_spike.min_oi_change_pct must be greater than 0")
+		}
+		if config.Strategies.OISpike.MinAccountRatio < 50 || config.Strategies.OISpike.MinAccountRatio > 100 {
+			return fmt.Errorf("strategies.oi_spike.min_account_ratio must be between 50 and 100")
+		}
+	}
+
+	if config.Strategies.Consensus.Enabled {
+		if config.Strategies.Consensus.MinAgreeingStrategies < 2 {
+			return fmt.Errorf("strategies.consensus.min_agreeing_strategies must be at least 2")
+		}
+	}
+
+	if _, err := time.LoadLocation(config.App.Timezone); err != nil {
+		return fmt.Errorf("app.timezone %q is not a valid IANA timezone name: %w", config.App.Timezone, err)
+	}
+
+	// Validate the kline tracking interval against the Binance intervals with a fixed length
+	if _, ok := entity.KlineIntervalDuration(config.Strategies.Global.KlineTrackingInterval); !ok {
+		return fmt.Errorf("strategies.global.kline_tracking_interval must be one of: %s",
+			strings.Join(entity.SupportedKlineIntervals(), ", "))
+	}
+
+	if config.Strategies.Global.ReentryCooldownHours < 0 {
+		return fmt.Errorf("strategies.global.reentry_cooldown_hours must not be negative")
+	}
+
+	if config.Strategies.Global.MaxSignalsPerStrategyPerDay < 0 {
+		return fmt.Errorf("strategies.global.max_signals_per_strategy_per_day must not be negative")
+	}
+	if config.Strategies.Global.AnalysisWorkers < 1 {
+		return fmt.Errorf("strategies.global.analysis_workers must be at least 1")
+	}
+
+	if config.Strategies.Global.AnalysisLookbackHours < 1 {
+		return fmt.Errorf("strategies.global.analysis_lookback_hours must be at least 1")
+	}
+	lookbackHours := map[string]int{
+		"minority":    config.Strategies.Minority.LookbackHours,
+		"whale":       config.Strategies.Whale.LookbackHours,
+		"smart_money": config.Strategies.SmartMoney.LookbackHours,
+		"oi_spike":    config.Strategies.OISpike.LookbackHours,
+	}
+	for strategy, hours := range lookbackHours {
+		if hours < 0 {
+			return fmt.Errorf("strategies.%s.lookback_hours must not be negative", strategy)
+		}
+	}
+	if config.Strategies.Global.MinListingAgeDays < 0 {
+		return fmt.Errorf("strategies.global.min_listing_age_days must not be negative")
+	}
+	switch config.Strategies.Global.TrackingPriceSource {
+	case "last", "mark":
+	default:
+		return fmt.Errorf("strategies.global.tracking_price_source must be one of: last, mark")
+	}
+	switch config.Strategies.Global.TrackingHistory {
+	case "full", "latest":
+	default:
+		return fmt.Errorf("strategies.global.tracking_history must be one of: full, latest")
+	}
+	switch config.Strategies.Global.SignalDedup {
+	case "", "merge", "priority":
+	default:
+		return fmt.Errorf("strategies.global.signal_dedup must be one of: merge, priority (empty = disabled)")
+	}
+	switch config.Strategies.Global.SignalIDScheme {
+	case "random", "deterministic":
+	default:
+		return fmt.Errorf("strategies.global.signal_id_scheme must be one of: random, deterministic")
+	}
+
+	// Validate profit target, stop loss and tracking window of enabled strategies
+	// A zero stop loss would keep the tracker's percentage stop from ever triggering
+	strategyExits := map[string]struct {
+		enabled           bool
+		confirmationHours int
+		trackingHours     int
+		profitTargetPct   float64
+		stopLossPct       float64
+	}{
+		"minority": {
+			config.Strategies.Minority.Enabled,
+			config.Strategies.Minority.ConfirmationHours,
+			config.Strategies.Minority.TrackingHours,
+			config.Strategies.Minority.ProfitTargetPct,
+			config.Strategies.Minority.StopLossPct,
+		},
+		"whale": {
+			config.Strategies.Whale.Enabled,
+			config.Strategies.Whale.ConfirmationHours,
+			config.Strategies.Whale.TrackingHours,
+			config.Strategies.Whale.ProfitTargetPct,
+			config.Strategies.Whale.StopLossPct,
+		},
+		"smart_money": {
+			config.Strategies.SmartMoney.Enabled,
+			config.Strategies.SmartMoney.ConfirmationHours,
+			config.Strategies.SmartMoney.TrackingHours,
+			config.Strategies.SmartMoney.ProfitTargetPct,
+			config.Strategies.SmartMoney.StopLossPct,
+		},
+		"oi_spike": {
+			config.Strategies.OISpike.Enabled,
+			config.Strategies.OISpike.ConfirmationHours,
+			config.Strategies.OISpike.TrackingHours,
+			config.Strategies.OISpike.ProfitTargetPct,
+			config.Strategies.OISpike.StopLossPct,
+		},
+		"consensus": {
+			config.Strategies.Consensus.Enabled,
+			config.Strategies.Consensus.ConfirmationHours,
+			config.Strategies.Consensus.TrackingHours,
+			config.Strategies.Consensus.ProfitTargetPct,
+			config.Strategies.Consensus.StopLossPct,
+		},
+	}
+	for strategy, exit := range strategyExits {
+		if !exit.enabled {
+			continue
+		}
+		if exit.profitTargetPct <= 0 {
+			return fmt.Errorf("strategies.%s.profit_target_pct must be greater than 0, got: %g", strategy, exit.profitTargetPct)
+		}
+		if exit.stopLossPct <= 0 {
+			return fmt.Errorf("strategies.%s.stop_loss_pct must be greater than 0, got: %g", strategy, exit.stopLossPct)
+		}
+		// 0 is a deliberate instant-confirmation mode, only negative values are misconfigured
+		if exit.confirmationHours < 0 {
+			return fmt.Errorf("strategies.%s.confirmation_hours must not be negative (use 0 for instant confirmation), got: %d", strategy, exit.confirmationHours)
+		}
+		// 0 disables the time limit, leaving the close to the stop loss and profit target
+		if exit.trackingHours < 0 {
+			return fmt.Errorf("strategies.%s.tracking_hours must not be negative (use 0 for no time limit), got: %d", strategy, exit.trackingHours)
+		}
+		if exit.trackingHours > 0 && exit.trackingHours <= exit.confirmationHours {
+			return fmt.Errorf("strategies.%s.tracking_hours (%d) must be greater than confirmation_hours (%d)",
+				strategy, exit.trackingHours, exit.confirmationHours)
+		}
+	}
+
+	// Validate strategy minimum data quality scores
+	minDataQualityScores := map[string]int{
+		"minority":    config.Strategies.Minority.MinDataQualityScore,
+		"whale":       config.Strategies.Whale.MinDataQualityScore,
+		"smart_money": config.Strategies.SmartMoney.MinDataQualityScore,
+		"oi_spike":    config.Strategies.OISpike.MinDataQualityScore,
+	}
+	for strategy, score := range minDataQualityScores {
+		if score < 0 || score > 100 {
+			return fmt.Errorf("strategies.%s.min_data_quality_score must be between 0 and 100", strategy)
+		}
+	}
+
+	// Validate pre-funding windows, which must be shorter than the 8h funding interval
+	avoidPreFundingMinutes := map[string]int{
+		"minority":    config.Strategies.Minority.AvoidPreFundingMinutes,
+		"whale":       config.Strategies.Whale.AvoidPreFundingMinutes,
+		"smart_money": config.Strategies.SmartMoney.AvoidPreFundingMinutes,
+		"oi_spike":    config.Strategies.OISpike.AvoidPreFundingMinutes,
+	}
+	for strategy, minutes := range avoidPreFundingMinutes {
+		if minutes < 0 || minutes >= 480 {
+			return fmt.Errorf("strategies.%s.avoid_pre_funding_minutes must be between 0 and 479", strategy)
+		}
+	}
+
+	// Validate per-strategy daily signal caps
+	maxSignalsPerDay := map[string]int{
+		"minority":    config.Strategies.Minority.MaxSignalsPerDay,
+		"whale":       config.Strategies.Whale.MaxSignalsPerDay,
+		"smart_money": config.Strategies.SmartMoney.MaxSignalsPerDay,
+		"oi_spike":    config.Strategies.OISpike.MaxSignalsPerDay,
+		"consensus":   config.Strategies.Consensus.MaxSignalsPerDay,
+	}
+	for strategy, limit := range maxSignalsPerDay {
+		if limit < 0 {
+			return fmt.Errorf("strategies.%s.max_signals_per_day must not be negative", strategy)
+		}
+	}
+
+	// Validate ratio smoothing half-lives
+	smoothingHalfLives := map[string]float64{
+		"minority":    config.Strategies.Minority.SmoothingHalfLifePoints,
+		"whale":       config.Strategies.Whale.SmoothingHalfLifePoints,
+		"smart_money": config.Strategies.SmartMoney.SmoothingHalfLifePoints,
+		"oi_spike":    config.Strategies.OISpike.SmoothingHalfLifePoints,
+	}
+	for strategy, halfLife := range smoothingHalfLives {
+		if halfLife <= 0 {
+			return fmt.Errorf("strategies.%s.smoothing_half_life_points must be greater than 0", strategy)
+		}
+	}
+
+	// Validate strategy liquidity tiers
+	minLiquidityTiers := map[string]string{
+		"minority":    config.Strategies.Minority.MinLiquidityTier,
+		"whale":       config.Strategies.Whale.MinLiquidityTier,
+		"smart_money": config.Strategies.SmartMoney.MinLiquidityTier,
+		"oi_spike":    config.Strategies.OISpike.MinLiquidityTier,
+	}
+	for strategy, tier := range minLiquidityTiers {
+		if tier != "" && tier != "LOW" && tier != "MEDIUM" && tier != "HIGH" {
+			return fmt.Errorf("strategies.%s.min_liquidity_tier must be one of: LOW, MEDIUM, HIGH", strategy)
+		}
+	}
+
+	// Validate taker flow filters
+	minTakerFlowRatios := map[string]float64{
+		"whale":       config.Strategies.Whale.MinTakerFlowRatio,
+		"smart_money": config.Strategies.SmartMoney.MinTakerFlowRatio,
+	}
+	for strategy, ratio := range minTakerFlowRatios {
+		if ratio < 0 {
+			return fmt.Errorf("strategies.%s.min_taker_flow_ratio must not be negative", strategy)
+		}
+	}
+
+	// Validate ATR-based trade levels
+	atrLevels := map[string]ATRLevelsConfig{
+		"minority":    config.Strategies.Minority.ATRLevels,
+		"whale":       config.Strategies.Whale.ATRLevels,
+		"smart_money": config.Strategies.SmartMoney.ATRLevels,
+		"oi_spike":    config.Strategies.OISpike.ATRLevels,
+	}
+	for strategy, atr := range atrLevels {
+		if !atr.Enabled {
+			continue
+		}
+		if atr.Interval == "" {
+			return fmt.Errorf("strategies.%s.atr_levels.interval is required", strategy)
+		}
+		if atr.Period < 1 {
+			return fmt.Errorf("strategies.%s.atr_levels.period must be at least 1", strategy)
+		}
+		if atr.StopLossMultiple <= 0 || atr.TargetMultiple <= 0 {
+			return fmt.Errorf("strategies.%s.atr_levels multiples must be greater than 0", strategy)
+		}
+	}
+
+	// Validate strategy symbol filters
+	symbolFilters := map[string]SymbolFilterConfig{
+		"minority":    config.Strategies.Minority.Symbols,
+		"whale":       config.Strategies.Whale.Symbols,
+		"smart_money": config.Strategies.SmartMoney.Symbols,
+		"oi_spike":    config.Strategies.OISpike.Symbols,
+	}
+	for strategy, filter := range symbolFilters {
+		lists := map[string][]string{"allow": filter.Allow, "deny": filter.Deny}
+		for list, patterns := range lists {
+			for _, pattern := range patterns {
+				if _, err := path.Match(pattern, ""); pattern == "" || err != nil {
+					return fmt.Errorf("strategies.%s.symbols.%s has an invalid symbol pattern %q", strategy, list, pattern)
+				}
+			}
+		}
+	}
+
+	// Validate scheduler
+	jobTimeouts := map[string]time.Duration{
+		"collection":     config.Scheduler.JobTimeouts.Collection,
+		"analysis":       config.Scheduler.JobTimeouts.Analysis,
+		"tracking":       config.Scheduler.JobTimeouts.Tracking,
+		"kline_tracking": config.Scheduler.JobTimeouts.KlineTracking,
+		"statistics":     config.Scheduler.JobTimeouts.Statistics,
+		"retention":      config.Scheduler.JobTimeouts.Retention,
+		"paper_trading":  config.Scheduler.JobTimeouts.PaperTrading,
+	}
+	for job, timeout := range jobTimeouts {
+		if timeout <= 0 {
+			return fmt.Errorf("scheduler.job_timeouts.%s must be greater than 0", job)
+		}
+	}
+	if config.Scheduler.ShutdownTimeout <= 0 {
+		return fmt.Errorf("scheduler.shutdown_timeout must be greater than 0")
+	}
+	if config.Scheduler.DistributedLock.Enabled {
+		if config.Scheduler.DistributedLock.TTL < 3*time.Second {
+			return fmt.Errorf("scheduler.distributed_lock.ttl must be at least 3s")
+		}
+		if config.Scheduler.DistributedLock.KeyPrefix == "" {
+			return fmt.Errorf("scheduler.distributed_lock.key_prefix is required when the distributed lock is enabled")
+		}
+	}
+
+	// Validate statistics periods
+	if len(config.Statistics.Periods) == 0 {
+		return fmt.Errorf("statistics.periods must contain at least one period")
+	}
+	seenPeriods := make(map[string]bool, len(config.Statistics.Periods))
+	for _, period := range config.Statistics.Periods {
+		if _, err := utils.ParsePeriodLabel(period); err != nil {
+			return fmt.Errorf("statistics.periods: %w", err)
+		}
+		if seenPeriods[period] {
+			return fmt.Errorf("statistics.periods contains duplicate period %q", period)
+		}
+		seenPeriods[period] = true
+	}
+
+	// Validate statistics percentiles
+	for _, p := range config.Statistics.Percentiles {
+		if p < 0 || p > 100 {
+			return fmt.Errorf("statistics.percentiles must be between 0 and 100, got %d", p)
+		}
+	}
+
+	// Validate holding time histogram bounds
+	for i, bound := range config.Statistics.HoldingHistogram {
+		if bound <= 0 {
+			return fmt.Errorf("statistics.holding_histogram bounds must be positive, got %v", bound)
+		}
+		if i > 0 && bound <= config.Statistics.HoldingHistogram[i-1] {
+			return fmt.Errorf("statistics.holding_histogram bounds must be strictly increasing, got %v after %v", bound, config.Statistics.HoldingHistogram[i-1])
+		}
+	}
+
+	// Validate per-strategy statistics monitoring thresholds
+	for strategy, thresholds := range config.Statistics.Monitoring.Strategies {
+		overrides := map[string]*float64{
+			"win_rate_change_threshold":      thresholds.WinRateChangeThreshold,
+			"profit_ratio_change_threshold":  thresholds.ProfitRatioChangeThreshold,
+			"avg_profit_change_threshold":    thresholds.AvgProfitChangeThreshold,
+			"avg_loss_change_threshold":      thresholds.AvgLossChangeThreshold,
+			"profit_factor_change_threshold": thresholds.ProfitFactorChangeThreshold,
+			"signal_count_change_threshold":  thresholds.SignalCountChangeThreshold,
+		}
+		for name, value := range overrides {
+			if value != nil && *value <= 0 {
+				return fmt.Errorf("statistics.monitoring.strategies.%s.%s must be greater than 0", strategy, name)
+			}
+		}
+	}
+
+	// Validate self-test
+	if config.SelfTest.Enabled {
+		if config.SelfTest.Symbol == "" {
+			return fmt.Errorf("self_test.symbol is required when the self-test is enabled")
+		}
+		if config.SelfTest.Timeout <= 0 {
+			return fmt.Errorf("self_test.timeout must be greater than 0")
+		}
+	}
+
+	// Validate paper trading
+	if config.PaperTrading.Enabled {
+		if config.PaperTrading.InitialBalance <= 0 {
+			return fmt.Errorf("paper_trading.initial_balance must be greater than 0")
+		}
+		if config.PaperTrading.RiskPerTradePct <= 0 || config.PaperTrading.RiskPerTradePct > 100 {
+			return fmt.Errorf("paper_trading.risk_per_trade_pct must be between 0 and 100")
+		}
+		if config.PaperTrading.DefaultStopLossPct <= 0 {
+			return fmt.Errorf("paper_trading.default_stop_loss_pct must be greater than 0")
+		}
+	}
+
+	// Validate websocket push
+	if config.Notifications.WebSocket.Enabled {
+		ws := config.Notifications.WebSocket
+		if ws.ClientBufferSize <= 0 {
+			return fmt.Errorf("notifications.websocket.client_buffer_size must be greater than 0")
+		}
+		if ws.PingInterval <= 0 {
+			return fmt.Errorf("notifications.websocket.ping_interval must be greater than 0")
+		}
+		if ws.PongTimeout <= ws.PingInterval {
+			return fmt.Errorf("notifications.websocket.pong_timeout must be greater than ping_interval")
+		}
+	}
+
+	if config.Notifications.Throttle.Enabled && config.Notifications.Throttle.Window <= 0 {
+		return fmt.Errorf("notifications.throttle.window must be positive")
+	}
+
 	// Validate logging
 	validLogLevels := map[string]bool{"debug": true, "info": true, "warn": true, "error": true}
 	if !validLogLevels[config.Logging.Level] {
@@ -0,0 +1,205 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+// loadWithoutFile loads the configuration from defaults and the environment alone
+func loadWithoutFile(t *testing.T) *Config {
+	t.Helper()
+
+	cfg, err := tryLoadWithoutFile(t)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	return cfg
+}
+
+// tryLoadWithoutFile is loadWithoutFile for tests expecting Load to fail
+func tryLoadWithoutFile(t *testing.T) (*Config, error) {
+	t.Helper()
+
+	// Keep Load from finding a config file in the working directory or home
+	dir := t.TempDir()
+	t.Chdir(dir)
+	t.Setenv("HOME", dir)
+
+	return Load("")
+}
+
+func TestLoadEnvWithoutConfigFile(t *testing.T) {
+	t.Setenv("CA_DATABASE_MYSQL_PASSWORD", "s3cret")
+	t.Setenv("CA_STRATEGIES_SMART_MONEY_ENABLED", "true")
+
+	cfg := loadWithoutFile(t)
+
+	if got := cfg.Database.MySQL.Password; got != "s3cret" {
+		t.Errorf("Database.MySQL.Password = %q, want %q", got, "s3cret")
+	}
+	if !cfg.Strategies.SmartMoney.Enabled {
+		t.Error("Strategies.SmartMoney.Enabled = false, want true")
+	}
+}
+
+func TestEnvVarName(t *testing.T) {
+	if got := EnvVarName("database.mysql.password"); got != "CA_DATABASE_MYSQL_PASSWORD" {
+		t.Errorf("EnvVarName = %q, want CA_DATABASE_MYSQL_PASSWORD", got)
+	}
+}
+
+func TestBinanceBaseURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       BinanceConfig
+		wantURL   string
+		wantCoinM string
+	}{
+		{"mainnet", BinanceConfig{}, BinanceMainnetURL, BinanceCoinMMainnetURL},
+		{"testnet", BinanceConfig{UseTestnet: true}, BinanceTestnetURL, BinanceCoinMTestnetURL},
+		{
+			"explicit URLs override the network",
+			BinanceConfig{UseTestnet: true, APIURL: "https://proxy.example.com/", CoinMAPIURL: "https://coin.example.com//"},
+			"https://proxy.example.com", "https://coin.example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cfg.BaseURL(); got != tt.wantURL {
+				t.Errorf("BaseURL = %q, want %q", got, tt.wantURL)
+			}
+			if got := tt.cfg.CoinMBaseURL(); got != tt.wantCoinM {
+				t.Errorf("CoinMBaseURL = %q, want %q", got, tt.wantCoinM)
+			}
+		})
+	}
+}
+
+func TestLoadBinanceNetwork(t *testing.T) {
+	tests := []struct {
+		name      string
+		env       map[string]string
+		wantURL   string
+		wantError string
+	}{
+		{"mainnet by default", nil, BinanceMainnetURL, ""},
+		{"testnet", map[string]string{"CA_BINANCE_USE_TESTNET": "true"}, BinanceTestnetURL, ""},
+		{
+			"testnet with explicit testnet URL",
+			map[string]string{"CA_BINANCE_USE_TESTNET": "true", "CA_BINANCE_API_URL": BinanceTestnetURL + "/"},
+			BinanceTestnetURL, "",
+		},
+		{
+			"testnet with mainnet URL",
+			map[string]string{"CA_BINANCE_USE_TESTNET": "true", "CA_BINANCE_API_URL": BinanceMainnetURL},
+			"", "binance.api_url points to mainnet",
+		},
+		{
+			"mainnet with testnet URL",
+			map[string]string{"CA_BINANCE_API_URL": BinanceTestnetURL},
+			"", "binance.api_url points to testnet",
+		},
+		{
+			"testnet with COIN-M mainnet URL",
+			map[string]string{"CA_BINANCE_USE_TESTNET": "true", "CA_BINANCE_COIN_M_API_URL": BinanceCoinMMainnetURL},
+			"", "binance.coin_m_api_url points to mainnet",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			cfg, err := tryLoadWithoutFile(t)
+			if tt.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+					t.Fatalf("Load error = %v, want %q", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Load: %v", err)
+			}
+			if got := cfg.Binance.BaseURL(); got != tt.wantURL {
+				t.Errorf("BaseURL = %q, want %q", got, tt.wantURL)
+			}
+		})
+	}
+}
+
+func TestLoadOptInDefaults(t *testing.T) {
+	cfg := loadWithoutFile(t)
+
+	if cfg.Strategies.Consensus.Enabled {
+		t.Error("Consensus.Enabled = true, want the consensus strategy off by default")
+	}
+	if cfg.Strategies.Global.InitialTracking {
+		t.Error("Global.InitialTracking = true, want no initial tracking record by default")
+	}
+}
+
+func TestLoadSquashedStrategyFields(t *testing.T) {
+	t.Setenv("CA_STRATEGIES_WHALE_STOP_LOSS_PCT", "3.5")
+	t.Setenv("CA_STRATEGIES_MINORITY_ATR_LEVELS_PERIOD", "21")
+	// Has no default, so only the explicit binding of squashed fields picks it up
+	t.Setenv("CA_STRATEGIES_OI_SPIKE_MIN_LIQUIDITY_TIER", "HIGH")
+
+	cfg := loadWithoutFile(t)
+
+	if got := cfg.Strategies.Minority.ProfitTargetPct; got != 5 {
+		t.Errorf("Minority.ProfitTargetPct = %g, want the default 5", got)
+	}
+	if got := cfg.Strategies.Consensus.StopLossPct; got != 2 {
+		t.Errorf("Consensus.StopLossPct = %g, want the default 2", got)
+	}
+	if got := cfg.Strategies.Whale.StopLossPct; got != 3.5 {
+		t.Errorf("Whale.StopLossPct = %g, want 3.5 from the environment", got)
+	}
+	if got := cfg.Strategies.Minority.ATRLevels.Period; got != 21 {
+		t.Errorf("Minority.ATRLevels.Period = %d, want 21 from the environment", got)
+	}
+	if got := cfg.Strategies.OISpike.MinLiquidityTier; got != "HIGH" {
+		t.Errorf("OISpike.MinLiquidityTier = %q, want HIGH from the environment", got)
+	}
+}
+
+func TestLoadRejectsZeroProfitTarget(t *testing.T) {
+	t.Setenv("CA_STRATEGIES_MINORITY_PROFIT_TARGET_PCT", "0")
+
+	_, err := tryLoadWithoutFile(t)
+	if err == nil || !strings.Contains(err.Error(), "strategies.minority.profit_target_pct must be greater than 0") {
+		t.Errorf("Load error = %v, want the profit target to be rejected", err)
+	}
+}
+
+func TestLoadKlineTrackingInterval(t *testing.T) {
+	tests := []struct {
+		interval string
+		wantErr  bool
+	}{
+		{"1m", false},
+		{"4h", false},
+		{"1d", false},
+		{"1w", true},
+		{"90m", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.interval, func(t *testing.T) {
+			t.Setenv("CA_STRATEGIES_GLOBAL_KLINE_TRACKING_INTERVAL", tt.interval)
+
+			_, err := tryLoadWithoutFile(t)
+			if tt.wantErr {
+				want := "must be one of: 1m, 3m, 5m, 15m, 30m, 1h, 2h, 4h, 6h, 8h, 12h, 1d"
+				if err == nil || !strings.Contains(err.Error(), want) {
+					t.Errorf("Load error = %v, want %q", err, want)
+				}
+			} else if err != nil {
+				t.Errorf("Load: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package config
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ParameterSchema describes a tunable configuration parameter, for rendering it in a UI
+type ParameterSchema struct {
+	Name         string      // Key relative to the config struct, nested keys joined with a dot (e.g. "atr_levels.period")
+	Type         string      // bool, int, float or string
+	Value        interface{} // Current value
+	Min          *float64    // Inclusive lower bound, nil if unbounded
+	ExclusiveMin *float64    // Exclusive lower bound, nil if unbounded
+	Max          *float64    // Inclusive upper bound, nil if unbounded
+	Enum         []string    // Allowed values of a string parameter, "" meaning unset
+	Description  string
+}
+
+// ParameterSchemas describes the tunable parameters of a config struct
+// A field is tunable when it has a desc tag; min, exclusive_min and max tags give its bounds
+// and a comma-separated enum tag its allowed values (a leading comma allows the empty string)
+// Nested structs are walked with their mapstructure key as prefix; embedded structs squashed
+// into their parent are walked without one
+func ParameterSchemas(cfg interface{}) []ParameterSchema {
+	return parameterSchemas(reflect.Indirect(reflect.ValueOf(cfg)), "")
+}
+
+func parameterSchemas(v reflect.Value, prefix string) []ParameterSchema {
+	var schemas []ParameterSchema
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			schemas = append(schemas, parameterSchemas(v.Field(i), prefix)...)
+			continue
+		}
+
+		name := prefix + field.Tag.Get("mapstructure")
+
+		if field.Type.Kind() == reflect.Struct {
+			schemas = append(schemas, parameterSchemas(v.Field(i), name+".")...)
+			continue
+		}
+
+		desc, ok := field.Tag.Lookup("desc")
+		if !ok {
+			continue
+		}
+
+		schema := ParameterSchema{
+			Name:         name,
+			Type:         parameterType(field.Type.Kind()),
+			Value:        v.Field(i).Interface(),
+			Min:          parseBound(field.Tag.Get("min")),
+			ExclusiveMin: parseBound(field.Tag.Get("exclusive_min")),
+			Max:          parseBound(field.Tag.Get("max")),
+			Description:  desc,
+		}
+		if enum, ok := field.Tag.Lookup("enum"); ok {
+			schema.Enum = strings.Split(enum, ",")
+		}
+
+		schemas = append(schemas, schema)
+	}
+
+	return schemas
+}
+
+// parameterType maps a field kind to the type name exposed in a parameter schema
+func parameterType(kind reflect.Kind) string {
+	switch kind {
+	case reflect.Bool:
+		return "bool"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "int"
+	case reflect.Float32, reflect.Float64:
+		return "float"
+	default:
+		return "string"
+	}
+}
+
+// parseBound parses a min, exclusive_min or max tag, returning nil if it is not set
+func parseBound(tag string) *float64 {
+	if tag == "" {
+		return nil
+	}
+	bound, err := strconv.ParseFloat(tag, 64)
+	if err != nil {
+		return nil
+	}
+	return &bound
+}
+
+// ByName returns the config of the strategy with the given display name
+func (c StrategiesConfig) ByName(name string) (interface{}, bool) {
+	switch name {
+	case c.Minority.Name:
+		return c.Minority, true
+	case c.Whale.Name:
+		return c.Whale, true
+	case c.SmartMoney.Name:
+		return c.SmartMoney, true
+	case c.OISpike.Name:
+		return c.OISpike, true
+	case c.Consensus.Name:
+		return c.Consensus, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,75 @@
+package config
+
+import (
+	"testing"
+)
+
+func schemasByName(schemas []ParameterSchema) map[string]ParameterSchema {
+	byName := make(map[string]ParameterSchema, len(schemas))
+	for _, schema := range schemas {
+		byName[schema.Name] = schema
+	}
+	return byName
+}
+
+func TestParameterSchemasEmbeddedStructs(t *testing.T) {
+	cfg := MinorityStrategy{MinRatioDifference: 65}
+	cfg.ProfitTargetPct = 5
+	cfg.ATRLevels.Period = 14
+
+	schemas := ParameterSchemas(cfg)
+	byName := schemasByName(schemas)
+	if len(byName) != len(schemas) {
+		t.Errorf("got %d schemas for %d distinct names", len(schemas), len(byName))
+	}
+
+	tests := []struct {
+		name  string
+		value interface{}
+	}{
+		{"enabled", false},
+		{"profit_target_pct", 5.0},
+		{"require_consecutive_points", 0},
+		{"min_ratio_difference", 65.0},
+		{"atr_levels.period", 14},
+	}
+	for _, tt := range tests {
+		schema, ok := byName[tt.name]
+		if !ok {
+			t.Errorf("no schema named %q", tt.name)
+			continue
+		}
+		if schema.Value != tt.value {
+			t.Errorf("%s value = %v, want %v", tt.name, schema.Value, tt.value)
+		}
+	}
+
+	// Untagged fields and squashed structs contribute no schema of their own
+	for _, name := range []string{"name", "", "market_strategy_common", "strategy_common"} {
+		if _, ok := byName[name]; ok {
+			t.Errorf("unexpected schema named %q", name)
+		}
+	}
+}
+
+func TestParameterSchemasBounds(t *testing.T) {
+	for _, cfg := range []interface{}{MinorityStrategy{}, WhaleStrategy{}, SmartMoneyStrategy{}, OISpikeStrategy{}, ConsensusStrategy{}} {
+		byName := schemasByName(ParameterSchemas(cfg))
+
+		// validate rejects a zero profit target or stop loss, so the bound is exclusive
+		for _, name := range []string{"profit_target_pct", "stop_loss_pct"} {
+			schema := byName[name]
+			if schema.Min != nil {
+				t.Errorf("%T %s: Min = %v, want nil", cfg, name, *schema.Min)
+			}
+			if schema.ExclusiveMin == nil || *schema.ExclusiveMin != 0 {
+				t.Errorf("%T %s: ExclusiveMin = %v, want 0", cfg, name, schema.ExclusiveMin)
+			}
+		}
+
+		schema := byName["confirmation_hours"]
+		if schema.Min == nil || *schema.Min != 0 || schema.ExclusiveMin != nil {
+			t.Errorf("%T confirmation_hours: Min = %v, ExclusiveMin = %v, want inclusive 0", cfg, schema.Min, schema.ExclusiveMin)
+		}
+	}
+}
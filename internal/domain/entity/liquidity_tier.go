@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// LiquidityTier classifies a trading pair by market depth
+type LiquidityTier string
+
+const (
+	LiquidityTierUnknown LiquidityTier = ""
+	LiquidityTierLow     LiquidityTier = "LOW"
+	LiquidityTierMedium  LiquidityTier = "MEDIUM"
+	LiquidityTierHigh    LiquidityTier = "HIGH"
+)
+
+// Rank returns the ordering of the tier (higher is deeper)
+// Unknown tiers rank below every classified tier
+func (t LiquidityTier) Rank() int {
+	switch t {
+	case LiquidityTierHigh:
+		return 3
+	case LiquidityTierMedium:
+		return 2
+	case LiquidityTierLow:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// IsValid checks if the tier is a known value (unknown is valid)
+func (t LiquidityTier) IsValid() bool {
+	switch t {
+	case LiquidityTierUnknown, LiquidityTierLow, LiquidityTierMedium, LiquidityTierHigh:
+		return true
+	default:
+		return false
+	}
+}
+
+// MeetsMinimum checks if the tier is at least the given minimum
+// An unknown minimum means no restriction
+func (t LiquidityTier) MeetsMinimum(min LiquidityTier) bool {
+	if min == LiquidityTierUnknown {
+		return true
+	}
+	return t.Rank() >= min.Rank()
+}
+
+// LiquidityThresholds defines the minimum 24h volume and open interest (USDT) per tier
+type LiquidityThresholds struct {
+	HighMinVolume24h      decimal.Decimal
+	HighMinOpenInterest   decimal.Decimal
+	MediumMinVolume24h    decimal.Decimal
+	MediumMinOpenInterest decimal.Decimal
+}
+
+// ClassifyLiquidityTier derives the liquidity tier from 24h volume and open interest
+// Both values must meet a tier's thresholds for the pair to qualify
+func ClassifyLiquidityTier(volume24h, openInterest decimal.Decimal, thresholds LiquidityThresholds) LiquidityTier {
+	if volume24h.GreaterThanOrEqual(thresholds.HighMinVolume24h) &&
+		openInterest.GreaterThanOrEqual(thresholds.HighMinOpenInterest) {
+		return LiquidityTierHigh
+	}
+
+	if volume24h.GreaterThanOrEqual(thresholds.MediumMinVolume24h) &&
+		openInterest.GreaterThanOrEqual(thresholds.MediumMinOpenInterest) {
+		return LiquidityTierMedium
+	}
+
+	return LiquidityTierLow
+}
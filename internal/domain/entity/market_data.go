@@ -2,8 +2,11 @@ package entity
 
 import (
 	"fmt"
+	"math"
 	"time"
 
+	"ContractAnalysis/pkg/utils"
+
 	"github.com/shopspring/decimal"
 )
 
@@ -31,9 +34,37 @@ type MarketData struct {
 	OpenInterest decimal.Decimal // Open Interest in USDT
 	FundingRate  decimal.Decimal // Current Funding Rate
 
+	// Taker buy volume / taker sell volume (aggressive order flow), zero if unavailable
+	TakerBuySellRatio decimal.Decimal
+
+	// Open interest change % since the previous data point of the symbol, nil for the first one
+	OpenInterestChangePct *decimal.Decimal
+
+	// Next funding settlement time, nil if unknown
+	NextFundingTime *time.Time
+
 	CreatedAt time.Time
 }
 
+// SetOpenInterestChange computes the open interest change % against the symbol's previous data point
+// The change is left nil when there is no earlier data point or either open interest is unknown
+func (m *MarketData) SetOpenInterestChange(previous *MarketData) {
+	m.OpenInterestChangePct = nil
+
+	if previous == nil || !previous.Timestamp.Before(m.Timestamp) {
+		return
+	}
+	if !previous.OpenInterest.IsPositive() || !m.OpenInterest.IsPositive() {
+		return
+	}
+
+	change := m.OpenInterest.Sub(previous.OpenInterest).
+		Div(previous.OpenInterest).
+		Mul(decimal.NewFromInt(100)).
+		Round(4)
+	m.OpenInterestChangePct = &change
+}
+
 // Validate validates the market data
 func (m *MarketData) Validate() error {
 	if m.Symbol == "" {
@@ -82,10 +113,11 @@ func (m *MarketData) Validate() error {
 		return fmt.Errorf("open interest must be non-negative")
 	}
 
-	// Validate timestamp freshness
-	now := time.Now()
+	// Validate timestamp freshness against the exchange clock, so a drifting host clock
+	// doesn't reject (or accept) data it shouldn't
+	now := utils.ServerNow()
 
-	// Don't allow future timestamps (with 5-minute clock skew tolerance)
+	// Don't allow future timestamps (with 5-minute tolerance for residual skew)
 	if m.Timestamp.After(now.Add(5 * time.Minute)) {
 		return fmt.Errorf("future timestamp detected: %v (now: %v)", m.Timestamp, now)
 	}
@@ -147,6 +179,73 @@ func (m *MarketData) CalculateDivergence() decimal.Decimal {
 	return accountDiff.Sub(positionDiff).Abs()
 }
 
+// DivergenceSlope returns the least-squares slope of the account/position divergence across
+// data (ordered newest first), in percentage points per collected point
+// A positive slope means the divergence has been widening. Returns zero for fewer than 2 points
+func DivergenceSlope(data []*MarketData) decimal.Decimal {
+	n := len(data)
+	if n < 2 {
+		return decimal.Zero
+	}
+
+	// x runs oldest to newest so that a growing divergence yields a positive slope
+	meanX := decimal.NewFromFloat(float64(n-1) / 2)
+	meanY := decimal.Zero
+	for _, d := range data {
+		meanY = meanY.Add(d.CalculateDivergence())
+	}
+	meanY = meanY.Div(decimal.NewFromInt(int64(n)))
+
+	numerator := decimal.Zero
+	denominator := decimal.Zero
+	for i, d := range data {
+		dx := decimal.NewFromInt(int64(n - 1 - i)).Sub(meanX)
+		numerator = numerator.Add(dx.Mul(d.CalculateDivergence().Sub(meanY)))
+		denominator = denominator.Add(dx.Mul(dx))
+	}
+
+	return numerator.Div(denominator)
+}
+
+// SmoothedRatios returns a copy of the newest data point whose account and position ratios are
+// replaced by their recency-weighted averages across data (ordered newest first)
+// A point halfLife collected points older than the newest one carries half its weight.
+// Position ratios are averaged over the points that have them. Returns nil for empty data
+func SmoothedRatios(data []*MarketData, halfLife float64) *MarketData {
+	if len(data) == 0 {
+		return nil
+	}
+	if halfLife <= 0 {
+		halfLife = 1
+	}
+
+	var longAccount, shortAccount, accountWeight float64
+	var longPosition, shortPosition, positionWeight float64
+	for i, d := range data {
+		weight := math.Pow(0.5, float64(i)/halfLife)
+
+		longAccount += d.LongAccountRatio.InexactFloat64() * weight
+		shortAccount += d.ShortAccountRatio.InexactFloat64() * weight
+		accountWeight += weight
+
+		if d.PositionRatioAvailable {
+			longPosition += d.LongPositionRatio.InexactFloat64() * weight
+			shortPosition += d.ShortPositionRatio.InexactFloat64() * weight
+			positionWeight += weight
+		}
+	}
+
+	smoothed := *data[0]
+	smoothed.LongAccountRatio = decimal.NewFromFloat(longAccount / accountWeight).Round(4)
+	smoothed.ShortAccountRatio = decimal.NewFromFloat(shortAccount / accountWeight).Round(4)
+	if positionWeight > 0 {
+		smoothed.LongPositionRatio = decimal.NewFromFloat(longPosition / positionWeight).Round(4)
+		smoothed.ShortPositionRatio = decimal.NewFromFloat(shortPosition / positionWeight).Round(4)
+	}
+
+	return &smoothed
+}
+
 // IsAccountRatioExtreme checks if the account ratio is extreme (one side dominates)
 func (m *MarketData) IsAccountRatioExtreme(threshold decimal.Decimal) bool {
 	return m.GetDominantRatio().GreaterThanOrEqual(threshold)
@@ -169,6 +268,34 @@ func (m *MarketData) GetWhaleDirection() string {
 	return "SHORT"
 }
 
+// TakerFlowConfirms checks if aggressive (taker) order flow supports the signal direction
+// LONG requires buy/sell volume >= minRatio and SHORT requires sell/buy volume >= minRatio
+// Data without a taker ratio is not filtered
+func (m *MarketData) TakerFlowConfirms(signalType SignalType, minRatio decimal.Decimal) bool {
+	if m.TakerBuySellRatio.LessThanOrEqual(decimal.Zero) {
+		return true
+	}
+
+	if signalType == SignalTypeLong {
+		return m.TakerBuySellRatio.GreaterThanOrEqual(minRatio)
+	}
+
+	sellBuyRatio := decimal.NewFromInt(1).Div(m.TakerBuySellRatio)
+	return sellBuyRatio.GreaterThanOrEqual(minRatio)
+}
+
+// IsWithinPreFundingWindow reports whether at falls within window before the next funding
+// settlement, when price often reverts as positions are closed to avoid paying funding
+// Data without a next funding time, or whose settlement has already passed, is never within it
+func (m *MarketData) IsWithinPreFundingWindow(at time.Time, window time.Duration) bool {
+	if m.NextFundingTime == nil || window <= 0 {
+		return false
+	}
+
+	untilFunding := m.NextFundingTime.Sub(at)
+	return untilFunding >= 0 && untilFunding <= window
+}
+
 // IsValid is a convenience method that calls Validate and returns a bool
 func (m *MarketData) IsValid() bool {
 	return m.Validate() == nil
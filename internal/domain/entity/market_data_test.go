@@ -0,0 +1,94 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ratioSeries returns data points (newest first) with the given long account ratios (%)
+func ratioSeries(longRatios ...float64) []*MarketData {
+	now := time.Now()
+	data := make([]*MarketData, len(longRatios))
+	for i, long := range longRatios {
+		data[i] = &MarketData{
+			Symbol:            "BTCUSDT",
+			Timestamp:         now.Add(-time.Duration(i) * 5 * time.Minute),
+			LongAccountRatio:  decimal.NewFromFloat(long),
+			ShortAccountRatio: decimal.NewFromFloat(100 - long),
+			Price:             decimal.NewFromInt(100),
+		}
+	}
+	return data
+}
+
+func TestSmoothedRatiosDampensNoisyReading(t *testing.T) {
+	threshold := decimal.NewFromInt(70)
+
+	// One spike to 75% after a steady 55%
+	data := ratioSeries(75, 55, 55, 55, 55)
+
+	if !data[0].LongAccountRatio.GreaterThanOrEqual(threshold) {
+		t.Fatalf("raw latest reading %s doesn't cross %s", data[0].LongAccountRatio, threshold)
+	}
+
+	// Weights 1, 1/2, 1/4, 1/8, 1/16: (75 + 55 * 15/16) / (31/16)
+	smoothed := SmoothedRatios(data, 1)
+	if want := decimal.RequireFromString("65.3226"); !smoothed.LongAccountRatio.Equal(want) {
+		t.Errorf("smoothed long ratio = %s, want %s", smoothed.LongAccountRatio, want)
+	}
+	if smoothed.LongAccountRatio.GreaterThanOrEqual(threshold) {
+		t.Errorf("smoothed long ratio %s crosses %s", smoothed.LongAccountRatio, threshold)
+	}
+	if sum := smoothed.LongAccountRatio.Add(smoothed.ShortAccountRatio); !sum.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("smoothed ratios sum to %s, want 100", sum)
+	}
+
+	// A sustained move does cross once it outweighs the history
+	sustained := SmoothedRatios(ratioSeries(75, 75, 75, 55, 55), 1)
+	if !sustained.LongAccountRatio.GreaterThanOrEqual(threshold) {
+		t.Errorf("sustained smoothed long ratio %s doesn't cross %s", sustained.LongAccountRatio, threshold)
+	}
+}
+
+func TestSmoothedRatios(t *testing.T) {
+	data := ratioSeries(80, 60)
+	data[0].PositionRatioAvailable = true
+	data[0].LongPositionRatio = decimal.NewFromInt(40)
+	data[0].ShortPositionRatio = decimal.NewFromInt(60)
+
+	t.Run("half-life", func(t *testing.T) {
+		// A longer half-life weighs older points more: (80 + 60 * 0.5^(1/2)) / (1 + 0.5^(1/2))
+		if got, want := SmoothedRatios(data, 2).LongAccountRatio, decimal.RequireFromString("71.7157"); !got.Equal(want) {
+			t.Errorf("half-life 2: long ratio = %s, want %s", got, want)
+		}
+		// Non-positive half-lives fall back to 1
+		if got, want := SmoothedRatios(data, 0).LongAccountRatio, SmoothedRatios(data, 1).LongAccountRatio; !got.Equal(want) {
+			t.Errorf("half-life 0: long ratio = %s, want %s", got, want)
+		}
+	})
+
+	t.Run("position ratios only from points that have them", func(t *testing.T) {
+		smoothed := SmoothedRatios(data, 1)
+		if !smoothed.LongPositionRatio.Equal(decimal.NewFromInt(40)) {
+			t.Errorf("long position ratio = %s, want 40", smoothed.LongPositionRatio)
+		}
+	})
+
+	t.Run("copy of the newest point", func(t *testing.T) {
+		smoothed := SmoothedRatios(data, 1)
+		if smoothed == data[0] || !smoothed.Timestamp.Equal(data[0].Timestamp) {
+			t.Error("want a copy of the newest data point")
+		}
+		if !data[0].LongAccountRatio.Equal(decimal.NewFromInt(80)) {
+			t.Errorf("input was modified: long ratio = %s", data[0].LongAccountRatio)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		if smoothed := SmoothedRatios(nil, 1); smoothed != nil {
+			t.Errorf("SmoothedRatios(nil) = %v, want nil", smoothed)
+		}
+	})
+}
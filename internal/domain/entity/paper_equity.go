@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// PaperPortfolioScope is the strategy name of the combined equity curve across all strategies
+const PaperPortfolioScope = "ALL"
+
+// PaperEquitySnapshot records the simulated account balance after a closed signal is applied
+type PaperEquitySnapshot struct {
+	ID           int64
+	StrategyName string // Strategy the curve belongs to, or PaperPortfolioScope
+	SignalID     string
+	Symbol       string
+	SignalType   SignalType
+
+	// Trade result
+	ReturnPct       decimal.Decimal // Price return of the trade at its real exit
+	PositionSizePct decimal.Decimal // Position notional as % of the balance before the trade
+	PnL             decimal.Decimal // Realized profit/loss applied to the balance
+
+	// Running balance after the trade
+	Balance decimal.Decimal
+
+	ClosedAt  time.Time
+	CreatedAt time.Time
+}
+
+// RealizedReturnPct returns the signal's price return at its real exit price
+// Falls back to the outcome's final price change when no exit price was recorded
+func (s *Signal) RealizedReturnPct(outcome *SignalOutcome) decimal.Decimal {
+	if !s.ExitPrice.IsZero() {
+		return s.CalculatePriceChange(s.ExitPrice)
+	}
+	if outcome != nil {
+		return outcome.FinalPriceChangePct
+	}
+	return decimal.Zero
+}
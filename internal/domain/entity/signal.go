@@ -33,6 +33,7 @@ const (
 	StrategyMinority   = "MinorityStrategy"
 	StrategyWhale      = "WhaleStrategy"
 	StrategySmartMoney = "SmartMoneyStrategy"
+	StrategyConsensus  = "consensus"
 )
 
 // Signal represents a trading signal generated by a strategy
@@ -52,16 +53,24 @@ type Signal struct {
 	ShortAccountRatio  decimal.Decimal
 	LongPositionRatio  decimal.Decimal
 	ShortPositionRatio decimal.Decimal
+	OpenInterest       decimal.Decimal // Open interest (USDT) at signal time
+	FundingRate        decimal.Decimal // Funding rate at signal time
+	Volume24h          decimal.Decimal // 24h quote volume (USDT) at signal time, zero if it wasn't recorded
 
 	// Confirmation tracking
 	ConfirmationStart time.Time
 	ConfirmationEnd   time.Time
 	IsConfirmed       bool
 	ConfirmedAt       *time.Time
+	EntryPrice        decimal.Decimal // Price when the signal was confirmed, zero until then
 
 	// Signal status
 	Status SignalStatus
 
+	// Invalidation (set when the signal is dropped before or during tracking)
+	InvalidatedAt      *time.Time
+	InvalidationReason string
+
 	// Metadata
 	Reason         string
 	ConfigSnapshot map[string]interface{}
@@ -72,6 +81,11 @@ type Signal struct {
 	TargetPrice2  decimal.Decimal // Take Profit 2
 	ExitPrice     decimal.Decimal // Final Exit Price
 	ExitReason    string          // Reason for exit (TP1, TP2, SL, Time, etc.)
+	TickSize      decimal.Decimal // Exchange price tick size used to round trade levels (zero = no rounding)
+
+	// Risk sizing
+	RiskRewardRatio decimal.Decimal // Reward distance / stop distance
+	PositionSizePct decimal.Decimal // Suggested position notional as % of account equity
 
 	// Trailing Stop Loss
 	TrailingStopEnabled       bool            // Whether trailing stop is enabled
@@ -85,12 +99,31 @@ type Signal struct {
 	UpdatedAt time.Time
 }
 
+// signalIDNamespace scopes the name-based UUIDs generated by DeterministicSignalID
+var signalIDNamespace = uuid.NewSHA1(uuid.NameSpaceOID, []byte("ContractAnalysis.signal"))
+
+// DeterministicSignalID derives a signal ID from the symbol, strategy, direction and the UTC
+// hour the signal was generated in, so regenerating a signal within the same hour yields
+// the same ID. The result is a name-based (SHA-1) UUID, formatted like a random one
+func DeterministicSignalID(symbol, strategyName string, signalType SignalType, generatedAt time.Time) string {
+	hour := generatedAt.UTC().Truncate(time.Hour)
+	name := fmt.Sprintf("%s|%s|%s|%s", symbol, strategyName, signalType, hour.Format(time.RFC3339))
+	return uuid.NewSHA1(signalIDNamespace, []byte(name)).String()
+}
+
+// UseDeterministicID replaces the signal's random ID with its DeterministicSignalID
+func (s *Signal) UseDeterministicID() {
+	s.SignalID = DeterministicSignalID(s.Symbol, s.StrategyName, s.Type, s.GeneratedAt)
+}
+
 // NewSignal creates a new signal
+// A confirmationHours of 0 selects instant confirmation: the signal skips PENDING and is
+// created CONFIRMED at the signal price, so tracking starts on the next tracker run
 func NewSignal(symbol string, signalType SignalType, strategyName string, marketData *MarketData, confirmationHours int, reason string, config map[string]interface{}) *Signal {
 	now := time.Now()
 	confirmationEnd := now.Add(time.Duration(confirmationHours) * time.Hour)
 
-	return &Signal{
+	signal := &Signal{
 		SignalID:           uuid.New().String(),
 		Symbol:             symbol,
 		Type:               signalType,
@@ -101,6 +134,9 @@ func NewSignal(symbol string, signalType SignalType, strategyName string, market
 		ShortAccountRatio:  marketData.ShortAccountRatio,
 		LongPositionRatio:  marketData.LongPositionRatio,
 		ShortPositionRatio: marketData.ShortPositionRatio,
+		OpenInterest:       marketData.OpenInterest,
+		FundingRate:        marketData.FundingRate,
+		Volume24h:          marketData.Volume24h,
 		ConfirmationStart:  now,
 		ConfirmationEnd:    confirmationEnd,
 		IsConfirmed:        false,
@@ -121,6 +157,15 @@ func NewSignal(symbol string, signalType SignalType, strategyName string, market
 		CreatedAt:                 now,
 		UpdatedAt:                 now,
 	}
+
+	if confirmationHours <= 0 {
+		signal.IsConfirmed = true
+		signal.ConfirmedAt = &now
+		signal.EntryPrice = marketData.Price
+		signal.Status = SignalStatusConfirmed
+	}
+
+	return signal
 }
 
 // Validate validates the signal
@@ -148,8 +193,8 @@ func (s *Signal) Validate() error {
 	return nil
 }
 
-// Confirm confirms the signal
-func (s *Signal) Confirm() error {
+// Confirm confirms the signal, recording the current price as its entry price
+func (s *Signal) Confirm(entryPrice decimal.Decimal) error {
 	if s.Status != SignalStatusPending {
 		return fmt.Errorf("cannot confirm signal with status: %s", s.Status)
 	}
@@ -157,29 +202,108 @@ func (s *Signal) Confirm() error {
 	now := time.Now()
 	s.IsConfirmed = true
 	s.ConfirmedAt = &now
+	s.EntryPrice = entryPrice
 	s.Status = SignalStatusConfirmed
 	s.UpdatedAt = now
 
 	return nil
 }
 
-// Invalidate invalidates the signal
-func (s *Signal) Invalidate() error {
+// Invalidate invalidates the signal, recording when and why
+func (s *Signal) Invalidate(reason string) error {
 	if s.Status != SignalStatusPending && s.Status != SignalStatusConfirmed {
 		return fmt.Errorf("cannot invalidate signal with status: %s", s.Status)
 	}
 
+	now := time.Now()
 	s.Status = SignalStatusInvalidated
-	s.UpdatedAt = time.Now()
+	s.InvalidatedAt = &now
+	s.InvalidationReason = reason
+	s.UpdatedAt = now
 
 	return nil
 }
 
 // SetTradeLevels sets the trade levels (SL, TP1, TP2)
+// Levels are rounded to the signal's tick size when one is set
 func (s *Signal) SetTradeLevels(sl, tp1, tp2 decimal.Decimal) {
-	s.StopLossPrice = sl
-	s.TargetPrice1 = tp1
-	s.TargetPrice2 = tp2
+	s.StopLossPrice = RoundToTick(sl, s.TickSize)
+	s.TargetPrice1 = RoundToTick(tp1, s.TickSize)
+	s.TargetPrice2 = RoundToTick(tp2, s.TickSize)
+}
+
+// RoundToTick rounds a price to the nearest multiple of tickSize
+// Returns the price unchanged if tickSize is not positive
+func RoundToTick(price, tickSize decimal.Decimal) decimal.Decimal {
+	if tickSize.LessThanOrEqual(decimal.Zero) {
+		return price
+	}
+	return price.Div(tickSize).Round(0).Mul(tickSize)
+}
+
+// CalculateRiskSizing computes the risk/reward ratio and suggested position size.
+// Stop and target distances are taken from the absolute trade levels when set,
+// otherwise from stopLossPct and profitTargetPct. accountRiskPct is the share of
+// account equity to lose if the stop is hit; 0 leaves the position size unset
+func (s *Signal) CalculateRiskSizing(accountRiskPct, stopLossPct, profitTargetPct float64) {
+	if s.PriceAtSignal.LessThanOrEqual(decimal.Zero) {
+		return
+	}
+
+	hundred := decimal.NewFromInt(100)
+
+	stopDistancePct := decimal.NewFromFloat(stopLossPct)
+	if !s.StopLossPrice.IsZero() {
+		stopDistancePct = s.StopLossPrice.Sub(s.PriceAtSignal).Abs().Div(s.PriceAtSignal).Mul(hundred)
+	}
+
+	rewardDistancePct := decimal.NewFromFloat(profitTargetPct)
+	if !s.TargetPrice1.IsZero() {
+		rewardDistancePct = s.TargetPrice1.Sub(s.PriceAtSignal).Abs().Div(s.PriceAtSignal).Mul(hundred)
+	}
+
+	if stopDistancePct.LessThanOrEqual(decimal.Zero) {
+		return
+	}
+
+	if rewardDistancePct.GreaterThan(decimal.Zero) {
+		s.RiskRewardRatio = rewardDistancePct.Div(stopDistancePct).Round(4)
+	}
+
+	if accountRiskPct > 0 {
+		s.PositionSizePct = decimal.NewFromFloat(accountRiskPct).Div(stopDistancePct).Mul(hundred).Round(4)
+	}
+}
+
+// KlineTrackingStart returns when kline tracking begins for the signal
+// Tracking starts at generation unless the strategy opted to start at the end
+// of the confirmation window, where a trade would actually be entered
+func (s *Signal) KlineTrackingStart() time.Time {
+	if fromConfirmation, ok := s.ConfigSnapshot["kline_from_confirmation"].(bool); ok && fromConfirmation {
+		return s.ConfirmationEnd
+	}
+	if s.KlineEntryAtConfirmation() {
+		return s.ConfirmationEnd
+	}
+	return s.GeneratedAt
+}
+
+// KlineEntryAtConfirmation reports whether the strategy opted to measure kline changes
+// from the price captured at confirmation rather than the signal price
+func (s *Signal) KlineEntryAtConfirmation() bool {
+	atConfirmation, ok := s.ConfigSnapshot["kline_entry_at_confirmation"].(bool)
+	return ok && atConfirmation
+}
+
+// KlineTrackingInterval returns the kline interval the signal is tracked on
+// Signals without a valid interval in their config snapshot use DefaultKlineTrackingInterval
+func (s *Signal) KlineTrackingInterval() string {
+	if interval, ok := s.ConfigSnapshot["kline_tracking_interval"].(string); ok {
+		if _, supported := KlineIntervalDuration(interval); supported {
+			return interval
+		}
+	}
+	return DefaultKlineTrackingInterval
 }
 
 // StartTracking starts tracking the signal
@@ -227,7 +351,18 @@ func (s *Signal) ShouldTrack(maxTrackingHours int) bool {
 		return false
 	}
 
-	return s.HoursElapsed() < float64(maxTrackingHours)
+	return !s.TrackingPeriodElapsed(maxTrackingHours)
+}
+
+// TrackingPeriodElapsed reports whether the signal has been tracked for trackingHours
+// A trackingHours of 0 means no time limit, leaving the close to the stop loss,
+// profit target and trailing stop
+func (s *Signal) TrackingPeriodElapsed(trackingHours int) bool {
+	if trackingHours <= 0 {
+		return false
+	}
+
+	return s.HoursElapsed() >= float64(trackingHours)
 }
 
 // CalculatePriceChange calculates the price change from signal price
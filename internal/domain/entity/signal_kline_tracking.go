@@ -2,6 +2,7 @@ package entity
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -45,6 +46,44 @@ type SignalKlineTracking struct {
 	CreatedAt time.Time
 }
 
+// DefaultKlineTrackingInterval is the kline interval used for signals that don't record one
+const DefaultKlineTrackingInterval = "1h"
+
+// klineIntervalDurations maps the Binance kline intervals usable for tracking to their length
+// Weekly and monthly klines are excluded since they don't have a fixed length
+var klineIntervalDurations = map[string]time.Duration{
+	"1m":  time.Minute,
+	"3m":  3 * time.Minute,
+	"5m":  5 * time.Minute,
+	"15m": 15 * time.Minute,
+	"30m": 30 * time.Minute,
+	"1h":  time.Hour,
+	"2h":  2 * time.Hour,
+	"4h":  4 * time.Hour,
+	"6h":  6 * time.Hour,
+	"8h":  8 * time.Hour,
+	"12h": 12 * time.Hour,
+	"1d":  24 * time.Hour,
+}
+
+// KlineIntervalDuration returns the length of a Binance kline interval, false if unsupported
+func KlineIntervalDuration(interval string) (time.Duration, bool) {
+	d, ok := klineIntervalDurations[interval]
+	return d, ok
+}
+
+// SupportedKlineIntervals returns the Binance kline intervals usable for tracking, shortest first
+func SupportedKlineIntervals() []string {
+	intervals := make([]string, 0, len(klineIntervalDurations))
+	for interval := range klineIntervalDurations {
+		intervals = append(intervals, interval)
+	}
+	sort.Slice(intervals, func(i, j int) bool {
+		return klineIntervalDurations[intervals[i]] < klineIntervalDurations[intervals[j]]
+	})
+	return intervals
+}
+
 // Kline represents a kline (candlestick) data point
 type Kline struct {
 	OpenTime    time.Time
@@ -58,14 +97,20 @@ type Kline struct {
 }
 
 // NewSignalKlineTracking creates a new signal kline tracking record
-func NewSignalKlineTracking(signalID string, signal *Signal, kline *Kline) *SignalKlineTracking {
+// Price changes are measured from entryPrice and elapsed hours from entryTime,
+// which is the signal price/time unless tracking starts after confirmation
+func NewSignalKlineTracking(signalID string, signal *Signal, kline *Kline, entryPrice decimal.Decimal, entryTime time.Time) *SignalKlineTracking {
 	now := time.Now()
 
-	// Calculate price changes relative to signal price (considering LONG/SHORT direction)
-	openChangePct := calculatePriceChange(signal, kline.Open)
-	highChangePct := calculatePriceChange(signal, kline.High)
-	lowChangePct := calculatePriceChange(signal, kline.Low)
-	closeChangePct := calculatePriceChange(signal, kline.Close)
+	// Calculate price changes relative to entry price (considering LONG/SHORT direction)
+	openChangePct := calculatePriceChange(signal, entryPrice, kline.Open)
+	highChangePct := calculatePriceChange(signal, entryPrice, kline.High)
+	lowChangePct := calculatePriceChange(signal, entryPrice, kline.Low)
+	closeChangePct := calculatePriceChange(signal, entryPrice, kline.Close)
+
+	// For SHORT signals the low is the favorable extreme and the high the adverse one
+	favorableChangePct := decimal.Max(highChangePct, lowChangePct)
+	adverseChangePct := decimal.Min(highChangePct, lowChangePct)
 
 	// Calculate hourly return: (close - open) / open * 100
 	hourlyReturn := decimal.Zero
@@ -75,8 +120,8 @@ func NewSignalKlineTracking(signalID string, signal *Signal, kline *Kline) *Sign
 			Mul(decimal.NewFromInt(100))
 	}
 
-	// Calculate hours since signal generated
-	hoursSince := decimal.NewFromFloat(kline.OpenTime.Sub(signal.GeneratedAt).Hours())
+	// Calculate hours since entry
+	hoursSince := decimal.NewFromFloat(kline.OpenTime.Sub(entryTime).Hours())
 
 	return &SignalKlineTracking{
 		SignalID:              signalID,
@@ -94,23 +139,34 @@ func NewSignalKlineTracking(signalID string, signal *Signal, kline *Kline) *Sign
 		LowChangePct:          lowChangePct,
 		CloseChangePct:        closeChangePct,
 		HourlyReturnPct:       hourlyReturn,
-		MaxPotentialProfitPct: highChangePct,
-		MaxPotentialLossPct:   lowChangePct,
-		IsProfitableAtHigh:    highChangePct.GreaterThan(decimal.Zero),
+		MaxPotentialProfitPct: favorableChangePct,
+		MaxPotentialLossPct:   adverseChangePct,
+		IsProfitableAtHigh:    favorableChangePct.GreaterThan(decimal.Zero),
 		IsProfitableAtClose:   closeChangePct.GreaterThan(decimal.Zero),
 		CreatedAt:             now,
 	}
 }
 
-// calculatePriceChange calculates price change percentage considering signal direction
-func calculatePriceChange(signal *Signal, currentPrice decimal.Decimal) decimal.Decimal {
-	if signal.PriceAtSignal.IsZero() {
+// FavorableChangePct returns the most favorable move within the kline for the signal direction
+// (the high for LONG signals, the low for SHORT signals)
+func (kt *SignalKlineTracking) FavorableChangePct() decimal.Decimal {
+	return decimal.Max(kt.HighChangePct, kt.LowChangePct)
+}
+
+// AdverseChangePct returns the most adverse move within the kline for the signal direction
+func (kt *SignalKlineTracking) AdverseChangePct() decimal.Decimal {
+	return decimal.Min(kt.HighChangePct, kt.LowChangePct)
+}
+
+// calculatePriceChange calculates price change percentage from entryPrice considering signal direction
+func calculatePriceChange(signal *Signal, entryPrice, currentPrice decimal.Decimal) decimal.Decimal {
+	if entryPrice.IsZero() {
 		return decimal.Zero
 	}
 
-	// Calculate percentage change: (current - signal) / signal * 100
-	change := currentPrice.Sub(signal.PriceAtSignal).
-		Div(signal.PriceAtSignal).
+	// Calculate percentage change: (current - entry) / entry * 100
+	change := currentPrice.Sub(entryPrice).
+		Div(entryPrice).
 		Mul(decimal.NewFromInt(100))
 
 	// For SHORT signals, invert the change (price decrease becomes profit)
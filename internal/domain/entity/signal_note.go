@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// MaxSignalNoteLength is the maximum number of characters in a signal note
+	MaxSignalNoteLength = 2000
+
+	// MaxSignalNoteAuthorLength is the maximum number of characters in a note author
+	MaxSignalNoteAuthorLength = 50
+
+	// DefaultSignalNoteAuthor is recorded for notes submitted without an author
+	DefaultSignalNoteAuthor = "anonymous"
+)
+
+// SignalNote represents a free-text review note attached to a signal
+type SignalNote struct {
+	ID        int64
+	SignalID  string
+	Author    string
+	Text      string
+	CreatedAt time.Time
+}
+
+// NewSignalNote creates a note for a signal, trimming surrounding whitespace
+// An empty author is recorded as DefaultSignalNoteAuthor
+func NewSignalNote(signalID, author, text string) *SignalNote {
+	author = strings.TrimSpace(author)
+	if author == "" {
+		author = DefaultSignalNoteAuthor
+	}
+
+	return &SignalNote{
+		SignalID:  signalID,
+		Author:    author,
+		Text:      strings.TrimSpace(text),
+		CreatedAt: time.Now(),
+	}
+}
+
+// Validate validates the signal note
+func (n *SignalNote) Validate() error {
+	if n.SignalID == "" {
+		return fmt.Errorf("signal ID is required")
+	}
+
+	if n.Text == "" {
+		return fmt.Errorf("note text is required")
+	}
+
+	if length := utf8.RuneCountInString(n.Text); length > MaxSignalNoteLength {
+		return fmt.Errorf("note text must be at most %d characters, got %d", MaxSignalNoteLength, length)
+	}
+
+	if length := utf8.RuneCountInString(n.Author); length > MaxSignalNoteAuthorLength {
+		return fmt.Errorf("note author must be at most %d characters, got %d", MaxSignalNoteAuthorLength, length)
+	}
+
+	return nil
+}
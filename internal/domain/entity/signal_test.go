@@ -0,0 +1,142 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestDeterministicSignalID(t *testing.T) {
+	at := time.Date(2024, 3, 10, 14, 20, 0, 0, time.UTC)
+	base := DeterministicSignalID("BTCUSDT", StrategyMinority, SignalTypeLong, at)
+
+	t.Run("distinct inputs", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			symbol       string
+			strategyName string
+			signalType   SignalType
+			generatedAt  time.Time
+		}{
+			{"symbol", "ETHUSDT", StrategyMinority, SignalTypeLong, at},
+			{"strategy", "BTCUSDT", StrategyWhale, SignalTypeLong, at},
+			{"direction", "BTCUSDT", StrategyMinority, SignalTypeShort, at},
+			{"next hour", "BTCUSDT", StrategyMinority, SignalTypeLong, at.Add(time.Hour)},
+			{"previous hour", "BTCUSDT", StrategyMinority, SignalTypeLong, at.Add(-time.Hour)},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if id := DeterministicSignalID(tt.symbol, tt.strategyName, tt.signalType, tt.generatedAt); id == base {
+					t.Errorf("ID %s collides with the base ID", id)
+				}
+			})
+		}
+	})
+
+	t.Run("stable within the hour", func(t *testing.T) {
+		for _, generatedAt := range []time.Time{
+			time.Date(2024, 3, 10, 14, 0, 0, 0, time.UTC),
+			time.Date(2024, 3, 10, 14, 59, 59, 999_999_999, time.UTC),
+		} {
+			if id := DeterministicSignalID("BTCUSDT", StrategyMinority, SignalTypeLong, generatedAt); id != base {
+				t.Errorf("ID at %s = %s, want %s", generatedAt, id, base)
+			}
+		}
+	})
+
+	t.Run("independent of time zone", func(t *testing.T) {
+		// Half-hour offset, so truncating in local time would pick a different hour
+		kolkata := time.FixedZone("IST", 5*3600+30*60)
+		if id := DeterministicSignalID("BTCUSDT", StrategyMinority, SignalTypeLong, at.In(kolkata)); id != base {
+			t.Errorf("ID in %s = %s, want %s", kolkata, id, base)
+		}
+	})
+
+	t.Run("signal uses it", func(t *testing.T) {
+		signal := &Signal{Symbol: "BTCUSDT", StrategyName: StrategyMinority, Type: SignalTypeLong, GeneratedAt: at}
+		signal.UseDeterministicID()
+		if signal.SignalID != base {
+			t.Errorf("SignalID = %s, want %s", signal.SignalID, base)
+		}
+	})
+}
+
+func TestNewSignalConfirmation(t *testing.T) {
+	data := &MarketData{
+		Symbol:            "BTCUSDT",
+		Timestamp:         time.Now(),
+		LongAccountRatio:  decimal.NewFromInt(30),
+		ShortAccountRatio: decimal.NewFromInt(70),
+		Price:             decimal.NewFromFloat(64250.5),
+	}
+
+	t.Run("instant confirmation", func(t *testing.T) {
+		signal := NewSignal("BTCUSDT", SignalTypeLong, StrategyMinority, data, 0, "test", nil)
+
+		if signal.Status != SignalStatusConfirmed {
+			t.Errorf("Status = %s, want %s", signal.Status, SignalStatusConfirmed)
+		}
+		if !signal.IsConfirmed {
+			t.Error("IsConfirmed = false, want true")
+		}
+		if signal.ConfirmedAt == nil || !signal.ConfirmedAt.Equal(signal.GeneratedAt) {
+			t.Errorf("ConfirmedAt = %v, want the generation time %s", signal.ConfirmedAt, signal.GeneratedAt)
+		}
+		if !signal.EntryPrice.Equal(data.Price) {
+			t.Errorf("EntryPrice = %s, want %s", signal.EntryPrice, data.Price)
+		}
+		if !signal.ConfirmationEnd.Equal(signal.GeneratedAt) {
+			t.Errorf("ConfirmationEnd = %s, want the generation time %s", signal.ConfirmationEnd, signal.GeneratedAt)
+		}
+	})
+
+	t.Run("confirmation period", func(t *testing.T) {
+		signal := NewSignal("BTCUSDT", SignalTypeLong, StrategyMinority, data, 1, "test", nil)
+
+		if signal.Status != SignalStatusPending {
+			t.Errorf("Status = %s, want %s", signal.Status, SignalStatusPending)
+		}
+		if signal.IsConfirmed || signal.ConfirmedAt != nil {
+			t.Errorf("IsConfirmed = %v, ConfirmedAt = %v, want unconfirmed", signal.IsConfirmed, signal.ConfirmedAt)
+		}
+		if !signal.EntryPrice.IsZero() {
+			t.Errorf("EntryPrice = %s, want 0 until confirmation", signal.EntryPrice)
+		}
+		if want := signal.GeneratedAt.Add(time.Hour); !signal.ConfirmationEnd.Equal(want) {
+			t.Errorf("ConfirmationEnd = %s, want %s", signal.ConfirmationEnd, want)
+		}
+		if !signal.IsInConfirmationPeriod() {
+			t.Error("IsInConfirmationPeriod = false, want true")
+		}
+	})
+}
+
+func TestTrackingPeriodElapsed(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name          string
+		trackingHours int
+		age           time.Duration
+		want          bool
+	}{
+		{"no limit, fresh", 0, 0, false},
+		{"no limit, a year old", 0, 365 * 24 * time.Hour, false},
+		{"negative treated as no limit", -1, 48 * time.Hour, false},
+		{"before the boundary", 24, 24*time.Hour - time.Minute, false},
+		{"at the boundary", 24, 24 * time.Hour, true},
+		{"past the boundary", 24, 25 * time.Hour, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := &Signal{GeneratedAt: now.Add(-tt.age), Status: SignalStatusTracking}
+
+			if got := signal.TrackingPeriodElapsed(tt.trackingHours); got != tt.want {
+				t.Errorf("TrackingPeriodElapsed(%d) = %v, want %v", tt.trackingHours, got, tt.want)
+			}
+			if got := signal.ShouldTrack(tt.trackingHours); got == tt.want {
+				t.Errorf("ShouldTrack(%d) = %v, want %v", tt.trackingHours, got, !tt.want)
+			}
+		})
+	}
+}
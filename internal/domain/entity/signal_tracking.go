@@ -2,6 +2,7 @@ package entity
 
 import (
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -19,7 +20,9 @@ type SignalTracking struct {
 	CurrentPrice    decimal.Decimal
 	PriceChangePct  decimal.Decimal
 
-	// Peak/trough tracking
+	// Peak/trough tracking on the direction-adjusted price change (see Signal.CalculatePriceChange)
+	// The peak is the most favorable move and the trough the most adverse one, so for SHORT
+	// signals HighestPrice is the lowest market price seen and LowestPrice the highest
 	HighestPrice    decimal.Decimal
 	HighestPricePct decimal.Decimal
 	HighestPriceAt  time.Time
@@ -53,15 +56,18 @@ func NewSignalTracking(signalID string, signal *Signal, currentPrice decimal.Dec
 	}
 }
 
-// UpdatePeakTrough updates the peak and trough prices
-func (st *SignalTracking) UpdatePeakTrough(currentPrice, priceChangePct decimal.Decimal) {
+// UpdatePeakTrough updates the peak and trough prices and reports whether either changed
+// priceChangePct must be direction-adjusted, as returned by Signal.CalculatePriceChange
+func (st *SignalTracking) UpdatePeakTrough(currentPrice, priceChangePct decimal.Decimal) bool {
 	now := time.Now()
+	updated := false
 
 	// Update highest if current is higher
 	if priceChangePct.GreaterThan(st.HighestPricePct) {
 		st.HighestPrice = currentPrice
 		st.HighestPricePct = priceChangePct
 		st.HighestPriceAt = now
+		updated = true
 	}
 
 	// Update lowest if current is lower
@@ -69,7 +75,10 @@ func (st *SignalTracking) UpdatePeakTrough(currentPrice, priceChangePct decimal.
 		st.LowestPrice = currentPrice
 		st.LowestPricePct = priceChangePct
 		st.LowestPriceAt = now
+		updated = true
 	}
+
+	return updated
 }
 
 // Validate validates the signal tracking
@@ -93,7 +102,7 @@ type SignalOutcome struct {
 	// Outcome details
 	Outcome string // PROFIT, LOSS, NEUTRAL, TIMEOUT
 
-	// Performance metrics
+	// Performance metrics, direction-adjusted so that positive means in the signal's favor
 	MaxFavorableMovePct decimal.Decimal
 	MaxAdverseMovePct   decimal.Decimal
 	FinalPriceChangePct decimal.Decimal
@@ -103,6 +112,15 @@ type SignalOutcome struct {
 	HoursToTrough  *int
 	TotalTrackingHours int
 
+	// Hours until the profit target / stop loss was first reached (nil if never reached)
+	HoursToProfitTarget *int
+	HoursToStopLoss     *int
+
+	// Hours until the kline where exiting would have captured the largest favorable move,
+	// and that move (nil without kline tracking)
+	HoursToOptimalExit   *int
+	OptimalExitChangePct *decimal.Decimal
+
 	// Additional metrics
 	ProfitTargetHit bool
 	StopLossHit     bool
@@ -144,6 +162,8 @@ func NewSignalOutcome(
 		hoursToTrough = &hours
 	}
 
+	// The tracking peak/trough are direction-adjusted already, so they map to the favorable
+	// and adverse moves for both LONG and SHORT signals
 	return &SignalOutcome{
 		SignalID:            signalID,
 		Outcome:             string(outcome),
@@ -160,6 +180,65 @@ func NewSignalOutcome(
 	}
 }
 
+// SetTargetTimings records when the profit target and stop loss were first reached
+// klines must be ordered by open time. A level counts as reached in the first kline
+// whose most favorable (or adverse) move crosses it, timed at that kline's close
+func (so *SignalOutcome) SetTargetTimings(signal *Signal, klines []*SignalKlineTracking, profitTargetPct, stopLossPct decimal.Decimal) {
+	for _, kline := range klines {
+		hours := klineHoursSinceStart(signal, kline)
+
+		if so.HoursToProfitTarget == nil && kline.FavorableChangePct().GreaterThanOrEqual(profitTargetPct) {
+			h := hours
+			so.HoursToProfitTarget = &h
+		}
+
+		if so.HoursToStopLoss == nil && kline.AdverseChangePct().LessThanOrEqual(stopLossPct.Neg()) {
+			h := hours
+			so.HoursToStopLoss = &h
+		}
+
+		if so.HoursToProfitTarget != nil && so.HoursToStopLoss != nil {
+			return
+		}
+	}
+}
+
+// SetOptimalExit records when exiting would have maximized profit, see OptimalExit
+func (so *SignalOutcome) SetOptimalExit(signal *Signal, klines []*SignalKlineTracking) {
+	hours, changePct, ok := OptimalExit(signal, klines)
+	if !ok {
+		return
+	}
+
+	so.HoursToOptimalExit = &hours
+	so.OptimalExitChangePct = &changePct
+}
+
+// OptimalExit finds the kline whose most favorable move was the largest, i.e. where closing
+// the position would have captured the most profit, and returns the hours from tracking
+// start to that kline's close along with the move. The earliest kline wins ties
+// klines must be ordered by open time; ok is false when there are none
+func OptimalExit(signal *Signal, klines []*SignalKlineTracking) (hours int, changePct decimal.Decimal, ok bool) {
+	var best *SignalKlineTracking
+	for _, kline := range klines {
+		if best == nil || kline.FavorableChangePct().GreaterThan(best.FavorableChangePct()) {
+			best = kline
+		}
+	}
+
+	if best == nil {
+		return 0, decimal.Zero, false
+	}
+
+	return klineHoursSinceStart(signal, best), best.FavorableChangePct(), true
+}
+
+// klineHoursSinceStart returns the whole hours from the signal's kline tracking start
+// to the close of a kline, rounded up
+func klineHoursSinceStart(signal *Signal, kline *SignalKlineTracking) int {
+	return int(math.Ceil(kline.KlineCloseTime.Sub(signal.KlineTrackingStart()).Hours()))
+}
+
 // determineOutcome determines the outcome based on price change
 func determineOutcome(priceChangePct, profitTargetPct, stopLossPct decimal.Decimal) OutcomeType {
 	if priceChangePct.GreaterThanOrEqual(profitTargetPct) {
@@ -0,0 +1,116 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestNewSignalOutcomeMoves(t *testing.T) {
+	// The market rallies to 104, drops to 95 and closes at 101
+	prices := []float64{104, 95, 101}
+
+	tests := []struct {
+		signalType      SignalType
+		wantFavorable   string
+		wantAdverse     string
+		wantFinal       string
+		wantOutcome     OutcomeType
+		wantTargetHit   bool
+		wantStopHit     bool
+		wantPeakPrice   string
+		wantTroughPrice string
+	}{
+		{SignalTypeLong, "4", "-5", "1", OutcomeProfit, true, false, "104", "95"},
+		{SignalTypeShort, "5", "-4", "-1", OutcomeLoss, true, false, "95", "104"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.signalType), func(t *testing.T) {
+			signal := &Signal{
+				SignalID:      "test",
+				Type:          tt.signalType,
+				GeneratedAt:   time.Now().Add(-3 * time.Hour),
+				PriceAtSignal: decimal.NewFromInt(100),
+			}
+			tracking := NewSignalTracking(signal.SignalID, signal, signal.PriceAtSignal)
+			for _, p := range prices {
+				price := decimal.NewFromFloat(p)
+				change := signal.CalculatePriceChange(price)
+				tracking.UpdatePeakTrough(price, change)
+				tracking.CurrentPrice = price
+				tracking.PriceChangePct = change
+			}
+
+			outcome := NewSignalOutcome(signal.SignalID, signal, tracking, decimal.NewFromInt(3), decimal.NewFromInt(6))
+
+			if want := decimal.RequireFromString(tt.wantFavorable); !outcome.MaxFavorableMovePct.Equal(want) {
+				t.Errorf("MaxFavorableMovePct = %s, want %s", outcome.MaxFavorableMovePct, want)
+			}
+			if want := decimal.RequireFromString(tt.wantAdverse); !outcome.MaxAdverseMovePct.Equal(want) {
+				t.Errorf("MaxAdverseMovePct = %s, want %s", outcome.MaxAdverseMovePct, want)
+			}
+			if want := decimal.RequireFromString(tt.wantFinal); !outcome.FinalPriceChangePct.Equal(want) {
+				t.Errorf("FinalPriceChangePct = %s, want %s", outcome.FinalPriceChangePct, want)
+			}
+			if outcome.Outcome != string(tt.wantOutcome) {
+				t.Errorf("Outcome = %s, want %s", outcome.Outcome, tt.wantOutcome)
+			}
+			if outcome.ProfitTargetHit != tt.wantTargetHit || outcome.StopLossHit != tt.wantStopHit {
+				t.Errorf("ProfitTargetHit, StopLossHit = %v, %v, want %v, %v",
+					outcome.ProfitTargetHit, outcome.StopLossHit, tt.wantTargetHit, tt.wantStopHit)
+			}
+			if want := decimal.RequireFromString(tt.wantPeakPrice); !tracking.HighestPrice.Equal(want) {
+				t.Errorf("peak price = %s, want %s", tracking.HighestPrice, want)
+			}
+			if want := decimal.RequireFromString(tt.wantTroughPrice); !tracking.LowestPrice.Equal(want) {
+				t.Errorf("trough price = %s, want %s", tracking.LowestPrice, want)
+			}
+			if outcome.TotalTrackingHours != 3 {
+				t.Errorf("TotalTrackingHours = %d, want 3", outcome.TotalTrackingHours)
+			}
+		})
+	}
+}
+
+func TestNewSignalKlineTrackingMoves(t *testing.T) {
+	// A candle trading between 95 and 104 after an entry at 100, closing at 101
+	kline := &Kline{
+		OpenTime:  time.Now().Add(-time.Hour),
+		CloseTime: time.Now(),
+		Open:      decimal.NewFromInt(100),
+		High:      decimal.NewFromInt(104),
+		Low:       decimal.NewFromInt(95),
+		Close:     decimal.NewFromInt(101),
+	}
+
+	tests := []struct {
+		signalType          SignalType
+		wantMaxProfit       string
+		wantMaxLoss         string
+		wantProfitableAtTop bool
+		wantProfitableClose bool
+	}{
+		{SignalTypeLong, "4", "-5", true, true},
+		{SignalTypeShort, "5", "-4", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.signalType), func(t *testing.T) {
+			signal := &Signal{SignalID: "test", Type: tt.signalType, PriceAtSignal: decimal.NewFromInt(100)}
+			kt := NewSignalKlineTracking(signal.SignalID, signal, kline, signal.PriceAtSignal, kline.OpenTime)
+
+			if want := decimal.RequireFromString(tt.wantMaxProfit); !kt.MaxPotentialProfitPct.Equal(want) || !kt.FavorableChangePct().Equal(want) {
+				t.Errorf("MaxPotentialProfitPct = %s, FavorableChangePct = %s, want %s", kt.MaxPotentialProfitPct, kt.FavorableChangePct(), want)
+			}
+			if want := decimal.RequireFromString(tt.wantMaxLoss); !kt.MaxPotentialLossPct.Equal(want) || !kt.AdverseChangePct().Equal(want) {
+				t.Errorf("MaxPotentialLossPct = %s, AdverseChangePct = %s, want %s", kt.MaxPotentialLossPct, kt.AdverseChangePct(), want)
+			}
+			if kt.IsProfitableAtHigh != tt.wantProfitableAtTop || kt.IsProfitableAtClose != tt.wantProfitableClose {
+				t.Errorf("IsProfitableAtHigh, IsProfitableAtClose = %v, %v, want %v, %v",
+					kt.IsProfitableAtHigh, kt.IsProfitableAtClose, tt.wantProfitableAtTop, tt.wantProfitableClose)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// AnalysisPause represents the operator switch that stops new signals from being generated
+// Tracking, confirmation and kline tracking of existing signals keep running while paused
+type AnalysisPause struct {
+	Paused    bool      `json:"paused"`
+	Reason    string    `json:"reason,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// AnalysisPauseRepository defines the interface for storing the analysis pause
+// The state is shared by all replicas and survives restarts
+type AnalysisPauseRepository interface {
+	// Get retrieves the current pause state (nil if it has never been set)
+	Get(ctx context.Context) (*AnalysisPause, error)
+
+	// Set stores the pause state
+	Set(ctx context.Context, pause *AnalysisPause) error
+}
@@ -5,9 +5,13 @@ import (
 	"time"
 
 	"ContractAnalysis/internal/domain/entity"
+
+	"github.com/shopspring/decimal"
 )
 
 // KlineRepository defines the interface for accessing kline data
+// Implementations are shared by strategies analyzing symbols in parallel and must be
+// safe for concurrent use; returned slices must not be shared between calls
 type KlineRepository interface {
 	// GetKlines retrieves kline data for a symbol
 	GetKlines(ctx context.Context, symbol string, interval string, limit int) ([]*entity.Kline, error)
@@ -15,3 +19,10 @@ type KlineRepository interface {
 	// GetKlinesSince retrieves kline data since a specific time
 	GetKlinesSince(ctx context.Context, symbol string, interval string, startTime time.Time) ([]*entity.Kline, error)
 }
+
+// SymbolPrecisionProvider defines the interface for exchange price precision lookups
+// Like KlineRepository, implementations must be safe for concurrent use
+type SymbolPrecisionProvider interface {
+	// GetTickSize retrieves the minimum price increment for a symbol
+	GetTickSize(ctx context.Context, symbol string) (decimal.Decimal, error)
+}
@@ -21,14 +21,17 @@ type MarketDataRepository interface {
 	// GetLatestBySymbol retrieves the latest market data for a symbol
 	GetLatestBySymbol(ctx context.Context, symbol string) (*entity.MarketData, error)
 
+	// GetEarliestBySymbol retrieves the oldest stored market data for a symbol
+	GetEarliestBySymbol(ctx context.Context, symbol string) (*entity.MarketData, error)
+
 	// GetLatestForAllSymbols retrieves the latest market data for all symbols
 	GetLatestForAllSymbols(ctx context.Context) ([]*entity.MarketData, error)
 
 	// GetRecentBySymbol retrieves the most recent N records for a symbol
 	GetRecentBySymbol(ctx context.Context, symbol string, limit int) ([]*entity.MarketData, error)
 
-	// Delete deletes market data older than the specified time
-	DeleteOlderThan(ctx context.Context, before time.Time) error
+	// DeleteOlderThan deletes market data older than the specified time and returns the number of deleted rows
+	DeleteOlderThan(ctx context.Context, before time.Time) (int64, error)
 
 	// Count returns the total number of market data records
 	Count(ctx context.Context) (int64, error)
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"ContractAnalysis/internal/domain/entity"
+)
+
+// PaperEquityRepository defines the interface for paper trading equity storage
+type PaperEquityRepository interface {
+	// CreateBatch creates multiple equity snapshots
+	CreateBatch(ctx context.Context, snapshots []*entity.PaperEquitySnapshot) error
+
+	// GetLatest retrieves the most recent snapshot of a curve (nil if the curve is empty)
+	GetLatest(ctx context.Context, strategyName string) (*entity.PaperEquitySnapshot, error)
+
+	// GetCurve retrieves the snapshots of a curve ordered by close time, optionally within a time range
+	GetCurve(ctx context.Context, strategyName string, start, end *time.Time) ([]*entity.PaperEquitySnapshot, error)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+)
+
+// PriceSource defines the interface for current price lookups used to evaluate open signals
+type PriceSource interface {
+	// GetPrice retrieves the current price for a symbol
+	GetPrice(ctx context.Context, symbol string) (float64, error)
+
+	// Name returns the configured name of the price source, e.g. "last" or "mark"
+	Name() string
+}
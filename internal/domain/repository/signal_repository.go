@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"ContractAnalysis/internal/domain/entity"
+
+	"github.com/shopspring/decimal"
 )
 
 // SignalFilterParams encapsulates parameters for filtering signals
@@ -13,6 +15,7 @@ type SignalFilterParams struct {
 	Symbol       string
 	StrategyName string
 	Type         string
+	Outcome      string // Filters on signal_outcomes.outcome (PROFIT, LOSS, NEUTRAL, TIMEOUT)
 	StartTime    *time.Time
 	EndTime      *time.Time
 }
@@ -23,8 +26,36 @@ type SignalWithOutcome struct {
 	Outcome *entity.SignalOutcome
 }
 
+// SignalDetail represents a signal together with every row recorded about it
+type SignalDetail struct {
+	Signal        *entity.Signal
+	Tracking      []*entity.SignalTracking      // Oldest first
+	KlineTracking []*entity.SignalKlineTracking // Oldest first
+	Outcome       *entity.SignalOutcome         // Nil until the signal is closed
+	Notes         []*entity.SignalNote          // Oldest first
+}
+
+// Outcome timeseries bucket sizes
+const (
+	OutcomeBucketDaily  = "daily"
+	OutcomeBucketWeekly = "weekly" // Weeks start on Monday
+)
+
+// OutcomeBucket aggregates the outcomes of signals closed within one time bucket
+type OutcomeBucket struct {
+	BucketStart       time.Time
+	ClosedSignals     int
+	ProfitableSignals int
+	LosingSignals     int
+	NetReturnPct      decimal.Decimal // Sum of final price changes of the closed signals
+}
+
 // SignalRepository defines the interface for signal storage
 type SignalRepository interface {
+	// WithTransaction runs fn with a repository whose operations share a single transaction
+	// The transaction is committed if fn returns nil and rolled back otherwise
+	WithTransaction(ctx context.Context, fn func(txRepo SignalRepository) error) error
+
 	// Create creates a new signal
 	Create(ctx context.Context, signal *entity.Signal) error
 
@@ -34,6 +65,13 @@ type SignalRepository interface {
 	// GetByID retrieves a signal by its UUID
 	GetByID(ctx context.Context, signalID string) (*entity.Signal, error)
 
+	// GetSignalDetail retrieves a signal with its tracking, kline tracking, outcome and notes
+	// Returns nil if the signal does not exist
+	GetSignalDetail(ctx context.Context, signalID string) (*SignalDetail, error)
+
+	// GetByIDs retrieves multiple signals by their UUIDs, ignoring unknown IDs
+	GetByIDs(ctx context.Context, signalIDs []string) ([]*entity.Signal, error)
+
 	// GetSignalsWithFilters retrieves signals based on provided filters and applies pagination
 	GetSignalsWithFilters(ctx context.Context, filters SignalFilterParams, offset, limit int) ([]*entity.Signal, int, error)
 
@@ -67,6 +105,19 @@ type SignalRepository interface {
 	// CountActiveSignalsBySymbol counts active signals for a symbol
 	CountActiveSignalsBySymbol(ctx context.Context, symbol string) (int, error)
 
+	// CountActiveSignalsBySymbolStrategyType counts active signals for a symbol, strategy and direction
+	CountActiveSignalsBySymbolStrategyType(ctx context.Context, symbol, strategyName string, signalType entity.SignalType) (int, error)
+
+	// CountSignalsByStrategySince counts the signals a strategy generated at or after since, in any status
+	CountSignalsByStrategySince(ctx context.Context, strategyName string, since time.Time) (int, error)
+
+	// GetActiveSignalBySymbolStrategyType retrieves the most recent active signal for a symbol, strategy and direction
+	GetActiveSignalBySymbolStrategyType(ctx context.Context, symbol, strategyName string, signalType entity.SignalType) (*entity.Signal, error)
+
+	// GetLatestClosedSignal retrieves the most recently closed signal for a symbol, strategy and direction
+	// together with its outcome, whose ClosedAt is the close time
+	GetLatestClosedSignal(ctx context.Context, symbol, strategyName string, signalType entity.SignalType) (*SignalWithOutcome, error)
+
 	// GetSignalsInTimeRange retrieves signals generated within a time range
 	GetSignalsInTimeRange(ctx context.Context, start, end time.Time) ([]*entity.Signal, error)
 
@@ -76,6 +127,9 @@ type SignalRepository interface {
 	// CreateTracking creates a new signal tracking record
 	CreateTracking(ctx context.Context, tracking *entity.SignalTracking) error
 
+	// UpdateTracking overwrites an existing signal tracking record
+	UpdateTracking(ctx context.Context, tracking *entity.SignalTracking) error
+
 	// GetLatestTracking retrieves the latest tracking record for a signal
 	GetLatestTracking(ctx context.Context, signalID string) (*entity.SignalTracking, error)
 
@@ -85,6 +139,9 @@ type SignalRepository interface {
 	// CreateOutcome creates a new signal outcome
 	CreateOutcome(ctx context.Context, outcome *entity.SignalOutcome) error
 
+	// CreateOutcomesBatch creates multiple signal outcomes in a batch
+	CreateOutcomesBatch(ctx context.Context, outcomes []*entity.SignalOutcome) error
+
 	// GetOutcome retrieves the outcome for a signal
 	GetOutcome(ctx context.Context, signalID string) (*entity.SignalOutcome, error)
 
@@ -97,11 +154,18 @@ type SignalRepository interface {
 	// GetOutcomesByStrategy retrieves outcomes for a specific strategy
 	GetOutcomesByStrategy(ctx context.Context, strategyName string, start, end time.Time) ([]*entity.SignalOutcome, error)
 
+	// GetOutcomeTimeseries aggregates outcomes into daily or weekly buckets by close time, oldest first
+	// Supports optional filtering by strategy and time range
+	GetOutcomeTimeseries(ctx context.Context, bucket string, strategyName *string, start, end *time.Time) ([]*OutcomeBucket, error)
+
 	// Kline tracking methods
 
 	// CreateKlineTracking creates a new kline tracking record
 	CreateKlineTracking(ctx context.Context, tracking *entity.SignalKlineTracking) error
 
+	// CreateKlineTrackingBatch creates multiple kline tracking records in a batch
+	CreateKlineTrackingBatch(ctx context.Context, trackings []*entity.SignalKlineTracking) error
+
 	// GetKlineTrackingBySignal retrieves all kline tracking records for a signal
 	GetKlineTrackingBySignal(ctx context.Context, signalID string) ([]*entity.SignalKlineTracking, error)
 
@@ -110,4 +174,12 @@ type SignalRepository interface {
 
 	// GetKlineTrackingInTimeRange retrieves kline tracking records within a time range
 	GetKlineTrackingInTimeRange(ctx context.Context, start, end time.Time) ([]*entity.SignalKlineTracking, error)
+
+	// Note methods
+
+	// AddNote attaches a review note to a signal
+	AddNote(ctx context.Context, note *entity.SignalNote) error
+
+	// GetNotes retrieves all notes for a signal, oldest first
+	GetNotes(ctx context.Context, signalID string) ([]*entity.SignalNote, error)
 }
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// StatisticsAlert records a significant change of a statistics metric between two calculations
+type StatisticsAlert struct {
+	ID                   int64
+	StrategyName         string
+	Symbol               *string // nil for overall stats
+	PeriodLabel          string
+	MetricName           string
+	PreviousValue        string
+	CurrentValue         string
+	Change               decimal.Decimal
+	ChangeType           string // "percentage" or "percentage_points"
+	PreviousCalculatedAt time.Time
+	CurrentCalculatedAt  time.Time
+	CreatedAt            time.Time
+}
+
+// StatisticsAlertRepository defines the interface for statistics alert storage
+type StatisticsAlertRepository interface {
+	// CreateBatch creates multiple statistics alerts
+	CreateBatch(ctx context.Context, alerts []*StatisticsAlert) error
+
+	// GetRecent retrieves alerts newest first with optional filtering by strategy and period,
+	// and returns the total number of matching alerts
+	GetRecent(ctx context.Context, strategyName, periodLabel *string, offset, limit int) ([]*StatisticsAlert, int, error)
+}
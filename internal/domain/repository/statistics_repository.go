@@ -14,7 +14,7 @@ type StrategyStatistics struct {
 	Symbol       *string // nil for overall stats
 	PeriodStart  time.Time
 	PeriodEnd    time.Time
-	PeriodLabel  string // "24h", "7d", "30d", "all"
+	PeriodLabel  string // Configured period, e.g. "24h", "7d", "30d", "all"
 
 	// Signal counts
 	TotalSignals       int
@@ -31,6 +31,14 @@ type StrategyStatistics struct {
 	AvgProfitPct    *decimal.Decimal
 	AvgLossPct      *decimal.Decimal
 	AvgHoldingHours *decimal.Decimal
+	MinHoldingHours *decimal.Decimal
+	MaxHoldingHours *decimal.Decimal
+	AvgReturnPct    *decimal.Decimal // Mean final return of closed signals
+
+	// Performance weighted by each signal's 24h quote volume at signal time, so thin
+	// markets count less than majors; signals without recorded volume are left out
+	VolumeWeightedWinRate      *decimal.Decimal
+	VolumeWeightedAvgReturnPct *decimal.Decimal
 
 	// Best/Worst
 	BestSignalPct  *decimal.Decimal
@@ -55,9 +63,38 @@ type StrategyStatistics struct {
 	AvgMaxPotentialProfitPct *decimal.Decimal // Average max potential profit at high
 	AvgMaxPotentialLossPct   *decimal.Decimal // Average max drawdown at low
 
+	// Average hours until the profit target was first reached (signals that reached it)
+	AvgHoursToTarget *decimal.Decimal
+
+	// Average hours from tracking start to the optimal exit of closed signals with kline
+	// tracking; compared to tracking_hours it shows whether the window is too long or short
+	AvgOptimalExitHours *decimal.Decimal
+
+	// Return distribution of closed signals (nil when there are no outcomes)
+	Percentiles *ReturnPercentiles
+
+	// Holding time distribution of closed signals (nil when disabled or there are no outcomes)
+	HoldingTimeHistogram []HoldingTimeBucket
+
 	CalculatedAt time.Time
 }
 
+// ReturnPercentiles holds percentile distributions of closed-signal moves
+// Each map is keyed by percentile (e.g. 50 is the median)
+type ReturnPercentiles struct {
+	FinalPriceChangePct map[int]decimal.Decimal `json:"final_price_change_pct"`
+	MaxFavorableMovePct map[int]decimal.Decimal `json:"max_favorable_move_pct"`
+	MaxAdverseMovePct   map[int]decimal.Decimal `json:"max_adverse_move_pct"`
+}
+
+// HoldingTimeBucket counts closed signals held for at least MinHours and less than MaxHours
+// The last bucket has no upper bound
+type HoldingTimeBucket struct {
+	MinHours float64  `json:"min_hours"`
+	MaxHours *float64 `json:"max_hours,omitempty"`
+	Count    int      `json:"count"`
+}
+
 // StatisticsRepository defines the interface for statistics storage
 type StatisticsRepository interface {
 	// Create creates a new statistics record
@@ -89,6 +126,6 @@ type StatisticsRepository interface {
 	// Supports optional filtering by strategy and symbol
 	GetByTimeRange(ctx context.Context, startTime, endTime time.Time, strategyName, symbol *string) ([]*StrategyStatistics, error)
 
-	// DeleteOlderThan deletes statistics older than the specified time
-	DeleteOlderThan(ctx context.Context, before time.Time) error
+	// DeleteOlderThan deletes statistics older than the specified time and returns the number of deleted rows
+	DeleteOlderThan(ctx context.Context, before time.Time) (int64, error)
 }
@@ -2,13 +2,24 @@ package repository
 
 import (
 	"context"
+	"time"
+
+	"ContractAnalysis/internal/domain/entity"
+
+	"github.com/shopspring/decimal"
 )
 
 // TradingPair represents a trading pair entity
 type TradingPair struct {
-	ID       int64
-	Symbol   string
-	IsActive bool
+	ID             int64
+	Symbol         string
+	IsActive       bool
+	LiquidityTier  entity.LiquidityTier
+	TierUpdatedAt  *time.Time
+	TickSize       decimal.Decimal // Minimum price increment (zero = unknown)
+	PricePrecision int
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
 }
 
 // TradingPairRepository defines the interface for trading pair storage
@@ -34,6 +45,15 @@ type TradingPairRepository interface {
 	// SetActive sets the active status of a trading pair
 	SetActive(ctx context.Context, symbol string, isActive bool) error
 
+	// GetByTier retrieves all active trading pairs in the given liquidity tier
+	GetByTier(ctx context.Context, tier entity.LiquidityTier) ([]*TradingPair, error)
+
+	// SetLiquidityTier sets the liquidity tier of a trading pair
+	SetLiquidityTier(ctx context.Context, symbol string, tier entity.LiquidityTier) error
+
+	// SetPrecision sets the exchange price precision rules of a trading pair
+	SetPrecision(ctx context.Context, symbol string, tickSize decimal.Decimal, pricePrecision int) error
+
 	// Exists checks if a trading pair exists
 	Exists(ctx context.Context, symbol string) (bool, error)
 }
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"ContractAnalysis/internal/domain/entity"
+	"ContractAnalysis/internal/domain/repository"
+
+	"github.com/shopspring/decimal"
+)
+
+// CalculateATR computes the Average True Range of klines (ordered oldest first)
+// The first period true ranges seed a simple average, later ones are Wilder-smoothed
+// Returns zero if fewer than period+1 klines are available
+func CalculateATR(klines []*entity.Kline, period int) decimal.Decimal {
+	if period < 1 || len(klines) < period+1 {
+		return decimal.Zero
+	}
+
+	periodDec := decimal.NewFromInt(int64(period))
+	atr := decimal.Zero
+
+	for i := 1; i < len(klines); i++ {
+		tr := trueRange(klines[i], klines[i-1].Close)
+
+		switch {
+		case i < period:
+			atr = atr.Add(tr)
+		case i == period:
+			atr = atr.Add(tr).Div(periodDec)
+		default:
+			// ATR = (prevATR * (period - 1) + TR) / period
+			atr = atr.Mul(periodDec.Sub(decimal.NewFromInt(1))).Add(tr).Div(periodDec)
+		}
+	}
+
+	return atr
+}
+
+// trueRange returns the largest of the candle range and the gaps from the previous close
+func trueRange(k *entity.Kline, prevClose decimal.Decimal) decimal.Decimal {
+	tr := k.High.Sub(k.Low)
+	tr = decimal.Max(tr, k.High.Sub(prevClose).Abs())
+	return decimal.Max(tr, k.Low.Sub(prevClose).Abs())
+}
+
+// ApplyATRLevels sets the signal's stop loss and profit target to entry +/- the configured
+// ATR multiples and records the ATR used in the config snapshot
+// The levels are rounded to the symbol's tick size, resolved from precisionProvider unless
+// the signal already carries one; a nil provider or a failed lookup leaves them unrounded
+// Does nothing when ATR levels are disabled or there is not enough kline history,
+// leaving the tracker on the percentage stop loss and profit target
+func (s *BaseStrategy) ApplyATRLevels(ctx context.Context, klineRepo repository.KlineRepository, precisionProvider repository.SymbolPrecisionProvider, signal *entity.Signal) error {
+	cfg := s.config.ATRLevels
+	if !cfg.Enabled || klineRepo == nil {
+		return nil
+	}
+
+	// Fetch enough history to smooth the ATR, plus the still-forming candle
+	klines, err := klineRepo.GetKlines(ctx, signal.Symbol, cfg.Interval, cfg.Period*2+2)
+	if err != nil {
+		return fmt.Errorf("failed to get klines for ATR: %w", err)
+	}
+	if len(klines) > 0 {
+		klines = klines[:len(klines)-1]
+	}
+
+	atr := CalculateATR(klines, cfg.Period)
+	if atr.LessThanOrEqual(decimal.Zero) {
+		return nil
+	}
+
+	entry := signal.PriceAtSignal
+	stopDistance := atr.Mul(decimal.NewFromFloat(cfg.StopLossMultiple))
+	targetDistance := atr.Mul(decimal.NewFromFloat(cfg.TargetMultiple))
+
+	stopLoss := entry.Sub(stopDistance)
+	target := entry.Add(targetDistance)
+	if signal.Type == entity.SignalTypeShort {
+		stopLoss = entry.Add(stopDistance)
+		target = entry.Sub(targetDistance)
+	}
+
+	// A stop or target at or below zero means the ATR dwarfs the price, keep the percentage levels
+	if stopLoss.LessThanOrEqual(decimal.Zero) || target.LessThanOrEqual(decimal.Zero) {
+		return nil
+	}
+
+	if signal.TickSize.IsZero() && precisionProvider != nil {
+		if tickSize, err := precisionProvider.GetTickSize(ctx, signal.Symbol); err == nil {
+			signal.TickSize = tickSize
+		}
+	}
+
+	// The tracker closes on TP2, so both targets share the ATR level
+	signal.SetTradeLevels(stopLoss, target, target)
+
+	signal.ConfigSnapshot["atr"] = atr.InexactFloat64()
+	signal.ConfigSnapshot["atr_interval"] = cfg.Interval
+	signal.ConfigSnapshot["atr_period"] = cfg.Period
+	signal.ConfigSnapshot["atr_stop_loss_multiple"] = cfg.StopLossMultiple
+	signal.ConfigSnapshot["atr_target_multiple"] = cfg.TargetMultiple
+
+	return nil
+}
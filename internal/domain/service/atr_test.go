@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ContractAnalysis/internal/domain/entity"
+	"ContractAnalysis/internal/domain/repository"
+
+	"github.com/shopspring/decimal"
+)
+
+// flatKlineRepo serves candles with a constant true range
+type flatKlineRepo struct {
+	trueRange decimal.Decimal
+}
+
+func (r flatKlineRepo) GetKlines(ctx context.Context, symbol string, interval string, limit int) ([]*entity.Kline, error) {
+	klines := make([]*entity.Kline, limit)
+	for i := range klines {
+		klines[i] = &entity.Kline{
+			Open:  decimal.NewFromInt(100),
+			High:  decimal.NewFromInt(100).Add(r.trueRange),
+			Low:   decimal.NewFromInt(100),
+			Close: decimal.NewFromInt(100),
+		}
+	}
+	return klines, nil
+}
+
+func (r flatKlineRepo) GetKlinesSince(ctx context.Context, symbol string, interval string, startTime time.Time) ([]*entity.Kline, error) {
+	return r.GetKlines(ctx, symbol, interval, 10)
+}
+
+func TestApplyATRLevelsRoundsToTickSize(t *testing.T) {
+	strategy := NewBaseStrategy(StrategyConfig{
+		Name: "ATR",
+		ATRLevels: ATRLevelsConfig{
+			Enabled:          true,
+			Interval:         "1h",
+			Period:           3,
+			StopLossMultiple: 1,
+			TargetMultiple:   2,
+		},
+	})
+	klines := flatKlineRepo{trueRange: decimal.RequireFromString("1.2345")}
+
+	tests := []struct {
+		name              string
+		precisionProvider repository.SymbolPrecisionProvider
+		signalTick        string // Tick size already set on the signal
+		wantStop          string
+		wantTarget        string
+	}{
+		{"no provider leaves the levels unrounded", nil, "0", "98.7655", "102.469"},
+		{"provider tick size", stubPrecisionProvider{}, "0", "98.77", "102.47"},
+		{"tick size already on the signal", stubPrecisionProvider{}, "0.1", "98.8", "102.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := entity.NewSignal("BTCUSDT", entity.SignalTypeLong, "ATR", &entity.MarketData{
+				Symbol:    "BTCUSDT",
+				Timestamp: time.Now(),
+				Price:     decimal.NewFromInt(100),
+			}, 1, "test", map[string]interface{}{})
+			signal.TickSize = decimal.RequireFromString(tt.signalTick)
+
+			if err := strategy.ApplyATRLevels(context.Background(), klines, tt.precisionProvider, signal); err != nil {
+				t.Fatalf("ApplyATRLevels: %v", err)
+			}
+
+			if !signal.StopLossPrice.Equal(decimal.RequireFromString(tt.wantStop)) {
+				t.Errorf("stop loss = %s, want %s", signal.StopLossPrice, tt.wantStop)
+			}
+			if !signal.TargetPrice2.Equal(decimal.RequireFromString(tt.wantTarget)) {
+				t.Errorf("target = %s, want %s", signal.TargetPrice2, tt.wantTarget)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"ContractAnalysis/internal/domain/entity"
+)
+
+// ConsensusStrategyConfig represents the configuration for consensus strategy
+type ConsensusStrategyConfig struct {
+	BaseConfig            StrategyConfig
+	MinAgreeingStrategies int // Minimum number of distinct strategies agreeing on direction
+}
+
+// ConsensusStrategy emits a higher-confidence signal when multiple independent
+// strategies agree on direction for the same symbol within one analysis cycle
+type ConsensusStrategy struct {
+	*BaseStrategy
+	config ConsensusStrategyConfig
+}
+
+// NewConsensusStrategy creates a new consensus strategy
+func NewConsensusStrategy(config ConsensusStrategyConfig) *ConsensusStrategy {
+	return &ConsensusStrategy{
+		BaseStrategy: NewBaseStrategy(config.BaseConfig),
+		config:       config,
+	}
+}
+
+// Key returns the fixed consensus strategy key
+func (s *ConsensusStrategy) Key() string {
+	return entity.StrategyConsensus
+}
+
+// Description returns a human-readable explanation of the strategy
+func (s *ConsensusStrategy) Description() string {
+	return "Emits a higher-confidence signal when multiple independent strategies agree on direction for the same symbol in one analysis cycle."
+}
+
+// Parameters returns the strategy's live configuration values
+func (s *ConsensusStrategy) Parameters() map[string]interface{} {
+	params := s.BaseStrategy.Parameters()
+	params["min_agreeing_strategies"] = s.config.MinAgreeingStrategies
+	return params
+}
+
+// Analyze does not generate signals from market data directly
+// Consensus signals are produced by Aggregate after the other strategies have run
+func (s *ConsensusStrategy) Analyze(ctx context.Context, recentData []*entity.MarketData) ([]*entity.Signal, error) {
+	return nil, nil
+}
+
+// ShouldGenerateSignal always returns false as consensus depends on other strategies' signals
+func (s *ConsensusStrategy) ShouldGenerateSignal(ctx context.Context, data *entity.MarketData) (bool, string, error) {
+	return false, "", nil
+}
+
+// Aggregate returns a consensus signal if enough distinct strategies agree on direction
+func (s *ConsensusStrategy) Aggregate(latestData *entity.MarketData, signals []*entity.Signal) *entity.Signal {
+	if !s.IsEnabled() || latestData == nil {
+		return nil
+	}
+
+	// Collect distinct contributing strategies per direction
+	strategiesByType := make(map[entity.SignalType]map[string]string)
+	for _, signal := range signals {
+		if signal.StrategyName == s.Key() {
+			continue
+		}
+		if strategiesByType[signal.Type] == nil {
+			strategiesByType[signal.Type] = make(map[string]string)
+		}
+		strategiesByType[signal.Type][signal.StrategyName] = signal.SignalID
+	}
+
+	longCount := len(strategiesByType[entity.SignalTypeLong])
+	shortCount := len(strategiesByType[entity.SignalTypeShort])
+
+	var signalType entity.SignalType
+	switch {
+	case longCount > shortCount:
+		signalType = entity.SignalTypeLong
+	case shortCount > longCount:
+		signalType = entity.SignalTypeShort
+	default:
+		// No agreement or conflicting directions
+		return nil
+	}
+
+	contributors := strategiesByType[signalType]
+	if len(contributors) < s.config.MinAgreeingStrategies {
+		return nil
+	}
+
+	strategyNames := make([]string, 0, len(contributors))
+	for name := range contributors {
+		strategyNames = append(strategyNames, name)
+	}
+	sort.Strings(strategyNames)
+
+	signalIDs := make([]string, 0, len(strategyNames))
+	for _, name := range strategyNames {
+		signalIDs = append(signalIDs, contributors[name])
+	}
+
+	reason := fmt.Sprintf(
+		"Consensus: %d strategies agree on %s (minimum: %d). Contributing strategies: %s.",
+		len(strategyNames),
+		signalType,
+		s.config.MinAgreeingStrategies,
+		strings.Join(strategyNames, ", "),
+	)
+
+	// Create configuration snapshot
+	configSnapshot := map[string]interface{}{
+		"min_agreeing_strategies":     s.config.MinAgreeingStrategies,
+		"contributing_strategies":     strategyNames,
+		"contributing_signal_ids":     signalIDs,
+		"confirmation_hours":          s.GetConfirmationHours(),
+		"tracking_hours":              s.GetTrackingHours(),
+		"profit_target_pct":           s.GetProfitTargetPct(),
+		"stop_loss_pct":               s.GetStopLossPct(),
+		"kline_from_confirmation":     s.GetKlineFromConfirmation(),
+		"kline_entry_at_confirmation": s.GetKlineEntryAtConfirmation(),
+	}
+
+	return entity.NewSignal(
+		latestData.Symbol,
+		signalType,
+		s.Key(),
+		latestData,
+		s.GetConfirmationHours(),
+		reason,
+		configSnapshot,
+	)
+}
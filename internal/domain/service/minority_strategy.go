@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"ContractAnalysis/internal/domain/entity"
+	"ContractAnalysis/internal/domain/repository"
 
 	"github.com/shopspring/decimal"
 )
@@ -21,17 +22,37 @@ type MinorityStrategyConfig struct {
 // Follows the minority: if 80% are short, go long
 type MinorityStrategy struct {
 	*BaseStrategy
-	config MinorityStrategyConfig
+	config            MinorityStrategyConfig
+	klineRepo         repository.KlineRepository
+	precisionProvider repository.SymbolPrecisionProvider
 }
 
 // NewMinorityStrategy creates a new minority strategy
-func NewMinorityStrategy(config MinorityStrategyConfig) *MinorityStrategy {
+// klineRepo is only used for ATR-based trade levels and may be nil when they are disabled
+// precisionProvider may be nil, in which case ATR levels are not rounded to the tick size
+func NewMinorityStrategy(config MinorityStrategyConfig, klineRepo repository.KlineRepository, precisionProvider repository.SymbolPrecisionProvider) *MinorityStrategy {
 	return &MinorityStrategy{
-		BaseStrategy: NewBaseStrategy(config.BaseConfig),
-		config:       config,
+		BaseStrategy:      NewBaseStrategy(config.BaseConfig),
+		config:            config,
+		klineRepo:         klineRepo,
+		precisionProvider: precisionProvider,
 	}
 }
 
+// Description returns a human-readable explanation of the strategy
+func (s *MinorityStrategy) Description() string {
+	return "Follows the minority: goes LONG when the short account ratio is extreme and SHORT when the long account ratio is extreme."
+}
+
+// Parameters returns the strategy's live configuration values
+func (s *MinorityStrategy) Parameters() map[string]interface{} {
+	params := s.BaseStrategy.Parameters()
+	params["min_ratio_difference"] = s.config.MinRatioDifference
+	params["generate_long_when_short_ratio_above"] = s.config.GenerateLongWhenShortRatioAbove
+	params["generate_short_when_long_ratio_above"] = s.config.GenerateShortWhenLongRatioAbove
+	return params
+}
+
 // Analyze analyzes market data and generates signals based on minority strategy
 func (s *MinorityStrategy) Analyze(ctx context.Context, recentData []*entity.MarketData) ([]*entity.Signal, error) {
 	if !s.IsEnabled() {
@@ -47,8 +68,11 @@ func (s *MinorityStrategy) Analyze(ctx context.Context, recentData []*entity.Mar
 	// Analyze the most recent data point
 	latestData := recentData[0]
 
+	// Thresholds are evaluated on smoothed ratios if configured
+	evalData := s.EvaluationData(recentData)
+
 	// Check if we should generate a signal
-	shouldGenerate, reason, err := s.ShouldGenerateSignal(ctx, latestData)
+	shouldGenerate, reason, err := s.ShouldGenerateSignal(ctx, evalData[0])
 	if err != nil {
 		return nil, fmt.Errorf("failed to check signal condition: %w", err)
 	}
@@ -59,7 +83,7 @@ func (s *MinorityStrategy) Analyze(ctx context.Context, recentData []*entity.Mar
 
 	// Determine signal type based on dominant direction (go opposite)
 	var signalType entity.SignalType
-	if latestData.GetDominantDirection() == "LONG" {
+	if evalData[0].GetDominantDirection() == "LONG" {
 		// If majority is long, we go short
 		signalType = entity.SignalTypeShort
 	} else {
@@ -67,6 +91,13 @@ func (s *MinorityStrategy) Analyze(ctx context.Context, recentData []*entity.Mar
 		signalType = entity.SignalTypeLong
 	}
 
+	// Require the same extreme ratio across the configured number of consecutive points
+	if !s.HoldsAcrossConsecutivePoints(evalData, func(d *entity.MarketData) bool {
+		return s.minoritySignalType(d) == signalType
+	}) {
+		return nil, nil
+	}
+
 	// Create configuration snapshot
 	configSnapshot := map[string]interface{}{
 		"min_ratio_difference":                 s.config.MinRatioDifference,
@@ -76,6 +107,9 @@ func (s *MinorityStrategy) Analyze(ctx context.Context, recentData []*entity.Mar
 		"tracking_hours":                       s.GetTrackingHours(),
 		"profit_target_pct":                    s.GetProfitTargetPct(),
 		"stop_loss_pct":                        s.GetStopLossPct(),
+		"require_consecutive_points":           s.GetRequireConsecutivePoints(),
+		"kline_from_confirmation":              s.GetKlineFromConfirmation(),
+		"kline_entry_at_confirmation":          s.GetKlineEntryAtConfirmation(),
 	}
 
 	// Create signal
@@ -89,6 +123,11 @@ func (s *MinorityStrategy) Analyze(ctx context.Context, recentData []*entity.Mar
 		configSnapshot,
 	)
 
+	// Set volatility-based trade levels if configured
+	if err := s.ApplyATRLevels(ctx, s.klineRepo, s.precisionProvider, signal); err != nil {
+		return nil, err
+	}
+
 	// Enable trailing stop if configured
 	trailingStopCfg := s.GetTrailingStopConfig()
 	if trailingStopCfg.Enabled {
@@ -152,6 +191,23 @@ func (s *MinorityStrategy) ShouldGenerateSignal(ctx context.Context, data *entit
 	return false, "", nil
 }
 
+// minoritySignalType returns the signal type implied by a single data point's
+// account ratios, or an empty type if neither ratio exceeds its threshold
+func (s *MinorityStrategy) minoritySignalType(data *entity.MarketData) entity.SignalType {
+	if data.ShortAccountRatio.GreaterThanOrEqual(decimal.NewFromFloat(s.config.GenerateLongWhenShortRatioAbove)) {
+		return entity.SignalTypeLong
+	}
+	if data.LongAccountRatio.GreaterThanOrEqual(decimal.NewFromFloat(s.config.GenerateShortWhenLongRatioAbove)) {
+		return entity.SignalTypeShort
+	}
+	return ""
+}
+
+// SignalDirection returns the signal type implied by a single data point's account ratios
+func (s *MinorityStrategy) SignalDirection(data *entity.MarketData) entity.SignalType {
+	return s.minoritySignalType(data)
+}
+
 // ValidateConfirmation checks if a signal still meets the strategy conditions
 // This is used during the confirmation period to verify the signal is still valid
 func (s *MinorityStrategy) ValidateConfirmation(ctx context.Context, signal *entity.Signal, currentData *entity.MarketData) (bool, string) {
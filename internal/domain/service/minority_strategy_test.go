@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ContractAnalysis/internal/domain/entity"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestMinorityStrategySmoothedRatios(t *testing.T) {
+	// A single 75% long reading after a steady 55%, newest first
+	now := time.Now()
+	var data []*entity.MarketData
+	for i, long := range []float64{75, 55, 55, 55, 55} {
+		data = append(data, &entity.MarketData{
+			Symbol:            "BTCUSDT",
+			Timestamp:         now.Add(-time.Duration(i) * 5 * time.Minute),
+			LongAccountRatio:  decimal.NewFromFloat(long),
+			ShortAccountRatio: decimal.NewFromFloat(100 - long),
+			Price:             decimal.NewFromInt(100),
+		})
+	}
+
+	newStrategy := func(smoothed bool) *MinorityStrategy {
+		return NewMinorityStrategy(MinorityStrategyConfig{
+			BaseConfig: StrategyConfig{
+				Name:                    entity.StrategyMinority,
+				Enabled:                 true,
+				UseSmoothedRatios:       smoothed,
+				SmoothingHalfLifePoints: 1,
+			},
+			MinRatioDifference:              70,
+			GenerateLongWhenShortRatioAbove: 70,
+			GenerateShortWhenLongRatioAbove: 70,
+		}, nil, nil)
+	}
+
+	raw, err := newStrategy(false).Analyze(context.Background(), data)
+	if err != nil {
+		t.Fatalf("Analyze on raw ratios: %v", err)
+	}
+	if len(raw) != 1 || raw[0].Type != entity.SignalTypeShort {
+		t.Fatalf("Analyze on raw ratios = %v, want one SHORT signal", raw)
+	}
+
+	smoothed, err := newStrategy(true).Analyze(context.Background(), data)
+	if err != nil {
+		t.Fatalf("Analyze on smoothed ratios: %v", err)
+	}
+	if len(smoothed) != 0 {
+		t.Errorf("Analyze on smoothed ratios generated %d signals, want none", len(smoothed))
+	}
+}
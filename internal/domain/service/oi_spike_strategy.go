@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"ContractAnalysis/internal/domain/entity"
+	"ContractAnalysis/internal/domain/repository"
+
+	"github.com/shopspring/decimal"
+)
+
+// OISpikeStrategyConfig represents the configuration for the open interest spike strategy
+type OISpikeStrategyConfig struct {
+	BaseConfig      StrategyConfig
+	MinOIChangePct  float64 // Minimum open interest increase over the lookback (e.g., 5 means +5%)
+	LookbackPoints  int     // Number of recent data points the open interest change is summed over
+	MinAccountRatio float64 // Minimum account ratio of the crowded side (e.g., 70 means 70:30 or more extreme)
+}
+
+// OISpikeStrategy implements the open interest spike fade strategy
+// A sudden surge in open interest while accounts are crowded on one side means
+// late traders are piling into that side; the signal fades them
+// Example: OI +8% in one hour with 75% of accounts long -> crowded longs, go SHORT
+type OISpikeStrategy struct {
+	*BaseStrategy
+	config            OISpikeStrategyConfig
+	klineRepo         repository.KlineRepository
+	precisionProvider repository.SymbolPrecisionProvider
+}
+
+// NewOISpikeStrategy creates a new open interest spike strategy
+// klineRepo is only used for ATR-based trade levels and may be nil when they are disabled
+// precisionProvider may be nil, in which case ATR levels are not rounded to the tick size
+func NewOISpikeStrategy(config OISpikeStrategyConfig, klineRepo repository.KlineRepository, precisionProvider repository.SymbolPrecisionProvider) *OISpikeStrategy {
+	return &OISpikeStrategy{
+		BaseStrategy:      NewBaseStrategy(config.BaseConfig),
+		config:            config,
+		klineRepo:         klineRepo,
+		precisionProvider: precisionProvider,
+	}
+}
+
+// Description returns a human-readable explanation of the strategy
+func (s *OISpikeStrategy) Description() string {
+	return "Fades the crowd when open interest surges while the account ratio is extreme on one side."
+}
+
+// Parameters returns the strategy's live configuration values
+func (s *OISpikeStrategy) Parameters() map[string]interface{} {
+	params := s.BaseStrategy.Parameters()
+	params["min_oi_change_pct"] = s.config.MinOIChangePct
+	params["lookback_points"] = s.lookbackPoints()
+	params["min_account_ratio"] = s.config.MinAccountRatio
+	return params
+}
+
+// Analyze analyzes market data and generates signals based on the open interest spike strategy
+func (s *OISpikeStrategy) Analyze(ctx context.Context, recentData []*entity.MarketData) ([]*entity.Signal, error) {
+	if !s.IsEnabled() {
+		return nil, nil
+	}
+
+	if len(recentData) == 0 {
+		return nil, nil
+	}
+
+	var signals []*entity.Signal
+
+	// Analyze the most recent data point
+	latestData := recentData[0]
+
+	if err := latestData.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid market data: %w", err)
+	}
+
+	// Account ratio thresholds are evaluated on smoothed ratios if configured
+	evalData := s.EvaluationData(recentData)
+
+	// Check the crowded side on the latest data point
+	signalType := s.fadeSignalType(evalData[0])
+	if signalType == "" {
+		return nil, nil
+	}
+
+	// Require the open interest surge across the lookback window
+	oiChange, ok := s.oiChangeOverLookback(recentData)
+	if !ok || oiChange.LessThan(decimal.NewFromFloat(s.config.MinOIChangePct)) {
+		return nil, nil
+	}
+
+	// Require the same crowded side across the configured number of consecutive points
+	if !s.HoldsAcrossConsecutivePoints(evalData, func(d *entity.MarketData) bool {
+		return s.fadeSignalType(d) == signalType
+	}) {
+		return nil, nil
+	}
+
+	reason := s.buildReason(evalData[0], signalType, oiChange)
+
+	// Create configuration snapshot
+	configSnapshot := map[string]interface{}{
+		"min_oi_change_pct":           s.config.MinOIChangePct,
+		"lookback_points":             s.lookbackPoints(),
+		"min_account_ratio":           s.config.MinAccountRatio,
+		"oi_change_pct":               oiChange.InexactFloat64(),
+		"confirmation_hours":          s.GetConfirmationHours(),
+		"tracking_hours":              s.GetTrackingHours(),
+		"profit_target_pct":           s.GetProfitTargetPct(),
+		"stop_loss_pct":               s.GetStopLossPct(),
+		"require_consecutive_points":  s.GetRequireConsecutivePoints(),
+		"kline_from_confirmation":     s.GetKlineFromConfirmation(),
+		"kline_entry_at_confirmation": s.GetKlineEntryAtConfirmation(),
+	}
+
+	// Create signal
+	signal := entity.NewSignal(
+		latestData.Symbol,
+		signalType,
+		s.Key(),
+		latestData,
+		s.GetConfirmationHours(),
+		reason,
+		configSnapshot,
+	)
+
+	// Set volatility-based trade levels if configured
+	if err := s.ApplyATRLevels(ctx, s.klineRepo, s.precisionProvider, signal); err != nil {
+		return nil, err
+	}
+
+	// Enable trailing stop if configured
+	trailingStopCfg := s.GetTrailingStopConfig()
+	if trailingStopCfg.Enabled {
+		signal.TrailingStopEnabled = true
+		signal.TrailingStopActivationPct = decimal.NewFromFloat(trailingStopCfg.ActivationPct)
+		signal.TrailingStopDistancePct = decimal.NewFromFloat(trailingStopCfg.TrailDistancePct)
+	}
+
+	signals = append(signals, signal)
+
+	return signals, nil
+}
+
+// ShouldGenerateSignal checks if conditions are met to generate a signal
+// Only a single data point is available here, so the open interest surge is checked
+// on its change since the previous point; Analyze sums it over LookbackPoints
+func (s *OISpikeStrategy) ShouldGenerateSignal(ctx context.Context, data *entity.MarketData) (bool, string, error) {
+	if !s.IsEnabled() {
+		return false, "", nil
+	}
+
+	// Validate data
+	if err := data.Validate(); err != nil {
+		return false, "", fmt.Errorf("invalid market data: %w", err)
+	}
+
+	signalType := s.fadeSignalType(data)
+	if signalType == "" {
+		return false, "", nil
+	}
+
+	if data.OpenInterestChangePct == nil ||
+		data.OpenInterestChangePct.LessThan(decimal.NewFromFloat(s.config.MinOIChangePct)) {
+		return false, "", nil
+	}
+
+	return true, s.buildReason(data, signalType, *data.OpenInterestChangePct), nil
+}
+
+// ValidateConfirmation checks if a signal still meets the strategy conditions
+// The crowd must still be on the faded side and the open interest must not be unwinding
+func (s *OISpikeStrategy) ValidateConfirmation(ctx context.Context, signal *entity.Signal, currentData *entity.MarketData) (bool, string) {
+	if !s.IsEnabled() {
+		return false, "strategy is disabled"
+	}
+
+	if s.fadeSignalType(currentData) != signal.Type {
+		return false, fmt.Sprintf("account ratio no longer crowded against %s: %.2f%%/%.2f%% (threshold: %.2f%%)",
+			signal.Type,
+			currentData.LongAccountRatio.InexactFloat64(),
+			currentData.ShortAccountRatio.InexactFloat64(),
+			s.config.MinAccountRatio)
+	}
+
+	// A drop as large as the entry spike means the crowded positions are being closed
+	unwind := decimal.NewFromFloat(-s.config.MinOIChangePct)
+	if currentData.OpenInterestChangePct != nil && currentData.OpenInterestChangePct.LessThanOrEqual(unwind) {
+		return false, fmt.Sprintf("open interest unwinding: %.2f%% (threshold: %.2f%%)",
+			currentData.OpenInterestChangePct.InexactFloat64(),
+			unwind.InexactFloat64())
+	}
+
+	return true, "conditions still met"
+}
+
+// SignalDirection returns the signal type fading the crowded side of a single data point
+func (s *OISpikeStrategy) SignalDirection(data *entity.MarketData) entity.SignalType {
+	return s.fadeSignalType(data)
+}
+
+// fadeSignalType returns the signal type fading the crowded side of a single data point,
+// or an empty type if neither account ratio reaches MinAccountRatio
+func (s *OISpikeStrategy) fadeSignalType(data *entity.MarketData) entity.SignalType {
+	threshold := decimal.NewFromFloat(s.config.MinAccountRatio)
+	if data.LongAccountRatio.GreaterThanOrEqual(threshold) {
+		return entity.SignalTypeShort
+	}
+	if data.ShortAccountRatio.GreaterThanOrEqual(threshold) {
+		return entity.SignalTypeLong
+	}
+	return ""
+}
+
+// oiChangeOverLookback sums the open interest change of the latest LookbackPoints data points
+// recentData must be ordered newest first. Returns false if fewer points are available
+// or any of them has no recorded change
+func (s *OISpikeStrategy) oiChangeOverLookback(recentData []*entity.MarketData) (decimal.Decimal, bool) {
+	lookback := s.lookbackPoints()
+	if len(recentData) < lookback {
+		return decimal.Zero, false
+	}
+
+	total := decimal.Zero
+	for _, data := range recentData[:lookback] {
+		if data.OpenInterestChangePct == nil {
+			return decimal.Zero, false
+		}
+		total = total.Add(*data.OpenInterestChangePct)
+	}
+
+	return total, true
+}
+
+// lookbackPoints returns the configured lookback, treating values below 1 as 1
+func (s *OISpikeStrategy) lookbackPoints() int {
+	if s.config.LookbackPoints < 1 {
+		return 1
+	}
+	return s.config.LookbackPoints
+}
+
+// buildReason describes why a signal fires
+func (s *OISpikeStrategy) buildReason(data *entity.MarketData, signalType entity.SignalType, oiChange decimal.Decimal) string {
+	crowdedSide := "LONG"
+	if signalType == entity.SignalTypeLong {
+		crowdedSide = "SHORT"
+	}
+
+	return fmt.Sprintf(
+		"OI Spike Strategy: Open interest rose %.2f%% (threshold: %.2f%%) while %s accounts are crowded. "+
+			"Long/Short ratio: %.2f%%/%.2f%% (threshold: %.2f%%). "+
+			"Fading the crowd, going %s.",
+		oiChange.InexactFloat64(),
+		s.config.MinOIChangePct,
+		crowdedSide,
+		data.LongAccountRatio.InexactFloat64(),
+		data.ShortAccountRatio.InexactFloat64(),
+		s.config.MinAccountRatio,
+		signalType,
+	)
+}
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ContractAnalysis/internal/domain/entity"
+
+	"github.com/shopspring/decimal"
+)
+
+// oiPoint is one collected data point: the long account ratio and the open interest
+// change since the previous point (nil when it was not recorded)
+type oiPoint struct {
+	long     float64
+	oiChange *float64
+}
+
+func oiChange(pct float64) *float64 { return &pct }
+
+// oiSpikeData builds market data from points ordered newest first
+func oiSpikeData(points ...oiPoint) []*entity.MarketData {
+	now := time.Now()
+	data := make([]*entity.MarketData, 0, len(points))
+	for i, p := range points {
+		d := &entity.MarketData{
+			Symbol:            "BTCUSDT",
+			Timestamp:         now.Add(-time.Duration(i) * 5 * time.Minute),
+			LongAccountRatio:  decimal.NewFromFloat(p.long),
+			ShortAccountRatio: decimal.NewFromFloat(100 - p.long),
+			Price:             decimal.NewFromInt(100),
+		}
+		if p.oiChange != nil {
+			change := decimal.NewFromFloat(*p.oiChange)
+			d.OpenInterestChangePct = &change
+		}
+		data = append(data, d)
+	}
+	return data
+}
+
+func newTestOISpikeStrategy(lookback, consecutive int) *OISpikeStrategy {
+	return NewOISpikeStrategy(OISpikeStrategyConfig{
+		BaseConfig: StrategyConfig{
+			Name:                     "OI Spike Fade",
+			Enabled:                  true,
+			RequireConsecutivePoints: consecutive,
+		},
+		MinOIChangePct:  5,
+		LookbackPoints:  lookback,
+		MinAccountRatio: 70,
+	}, nil, nil)
+}
+
+func TestOISpikeStrategyAnalyze(t *testing.T) {
+	tests := []struct {
+		name        string
+		lookback    int
+		consecutive int
+		points      []oiPoint
+		want        entity.SignalType // Empty for no signal
+	}{
+		{
+			name:     "crowded longs are faded short",
+			lookback: 1,
+			points:   []oiPoint{{75, oiChange(6)}},
+			want:     entity.SignalTypeShort,
+		},
+		{
+			name:     "crowded shorts are faded long",
+			lookback: 1,
+			points:   []oiPoint{{25, oiChange(6)}},
+			want:     entity.SignalTypeLong,
+		},
+		{
+			name:     "balanced accounts",
+			lookback: 1,
+			points:   []oiPoint{{60, oiChange(10)}},
+		},
+		{
+			name:     "surge below the threshold",
+			lookback: 1,
+			points:   []oiPoint{{75, oiChange(4)}},
+		},
+		{
+			name:     "change summed over the lookback",
+			lookback: 3,
+			points:   []oiPoint{{75, oiChange(2)}, {75, oiChange(2)}, {75, oiChange(1.5)}},
+			want:     entity.SignalTypeShort,
+		},
+		{
+			name:     "older points beyond the lookback are ignored",
+			lookback: 2,
+			points:   []oiPoint{{75, oiChange(2)}, {75, oiChange(2)}, {75, oiChange(10)}},
+		},
+		{
+			name:     "a drop inside the lookback offsets the surge",
+			lookback: 3,
+			points:   []oiPoint{{75, oiChange(6)}, {75, oiChange(-3)}, {75, oiChange(1)}},
+		},
+		{
+			name:     "missing change inside the lookback",
+			lookback: 2,
+			points:   []oiPoint{{75, oiChange(6)}, {75, nil}},
+		},
+		{
+			name:     "fewer points than the lookback",
+			lookback: 3,
+			points:   []oiPoint{{75, oiChange(6)}, {75, oiChange(6)}},
+		},
+		{
+			name:     "lookback below 1 uses the latest point",
+			lookback: 0,
+			points:   []oiPoint{{75, oiChange(6)}, {75, nil}},
+			want:     entity.SignalTypeShort,
+		},
+		{
+			name:        "crowd held across consecutive points",
+			lookback:    1,
+			consecutive: 2,
+			points:      []oiPoint{{75, oiChange(6)}, {72, oiChange(1)}},
+			want:        entity.SignalTypeShort,
+		},
+		{
+			name:        "crowd not held across consecutive points",
+			lookback:    1,
+			consecutive: 2,
+			points:      []oiPoint{{75, oiChange(6)}, {65, oiChange(1)}},
+		},
+		{
+			name:        "crowd flipped sides within the consecutive points",
+			lookback:    1,
+			consecutive: 2,
+			points:      []oiPoint{{75, oiChange(6)}, {25, oiChange(1)}},
+		},
+		{
+			name:        "fewer points than required consecutive points",
+			lookback:    1,
+			consecutive: 3,
+			points:      []oiPoint{{75, oiChange(6)}, {75, oiChange(1)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strategy := newTestOISpikeStrategy(tt.lookback, tt.consecutive)
+
+			signals, err := strategy.Analyze(context.Background(), oiSpikeData(tt.points...))
+			if err != nil {
+				t.Fatalf("Analyze: %v", err)
+			}
+
+			if tt.want == "" {
+				if len(signals) != 0 {
+					t.Errorf("Analyze generated %s signal, want none", signals[0].Type)
+				}
+				return
+			}
+			if len(signals) != 1 || signals[0].Type != tt.want {
+				t.Fatalf("Analyze = %v, want one %s signal", signals, tt.want)
+			}
+		})
+	}
+}
+
+func TestOISpikeStrategyValidateConfirmation(t *testing.T) {
+	tests := []struct {
+		name       string
+		signalType entity.SignalType
+		current    oiPoint
+		wantValid  bool
+	}{
+		{"crowd still long", entity.SignalTypeShort, oiPoint{75, oiChange(1)}, true},
+		{"crowd still short", entity.SignalTypeLong, oiPoint{25, oiChange(1)}, true},
+		{"crowd dispersed", entity.SignalTypeShort, oiPoint{60, oiChange(1)}, false},
+		{"crowd flipped sides", entity.SignalTypeShort, oiPoint{25, oiChange(1)}, false},
+		{"open interest easing", entity.SignalTypeShort, oiPoint{75, oiChange(-4.9)}, true},
+		{"open interest unwinding", entity.SignalTypeShort, oiPoint{75, oiChange(-5)}, false},
+		{"no recorded change", entity.SignalTypeShort, oiPoint{75, nil}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strategy := newTestOISpikeStrategy(1, 1)
+			signal := &entity.Signal{Symbol: "BTCUSDT", Type: tt.signalType}
+
+			valid, reason := strategy.ValidateConfirmation(context.Background(), signal, oiSpikeData(tt.current)[0])
+			if valid != tt.wantValid {
+				t.Errorf("ValidateConfirmation = %v (%s), want %v", valid, reason, tt.wantValid)
+			}
+		})
+	}
+}
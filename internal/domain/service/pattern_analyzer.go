@@ -7,6 +7,8 @@ import (
 )
 
 // PatternAnalyzer provides methods for candlestick pattern detection
+// It holds no state, every method is a pure function of its candles, so one instance
+// can be shared across goroutines
 type PatternAnalyzer struct{}
 
 // NewPatternAnalyzer creates a new pattern analyzer
@@ -102,3 +104,31 @@ func (p *PatternAnalyzer) IsSwingFailurePattern(triggerCandle *entity.Kline, res
 	// 2. Close must be below resistance (Failure)
 	return triggerCandle.High.GreaterThan(resistanceHigh) && triggerCandle.Close.LessThan(resistanceHigh)
 }
+
+// IsDoji checks if a candle is a Doji (indecision candle)
+// The body must be at most maxBodyPct percent of the candle's total range
+// Zero-range candles carry no price action and are not treated as a Doji
+func (p *PatternAnalyzer) IsDoji(k *entity.Kline, maxBodyPct float64) bool {
+	totalRange := k.High.Sub(k.Low)
+	if totalRange.LessThanOrEqual(decimal.Zero) {
+		return false
+	}
+
+	bodySize := k.Open.Sub(k.Close).Abs()
+	bodyPct := bodySize.Div(totalRange).Mul(decimal.NewFromInt(100))
+
+	return bodyPct.LessThanOrEqual(decimal.NewFromFloat(maxBodyPct))
+}
+
+// IsInsideBar checks if the current candle's range lies within the previous candle's range
+// Characteristics:
+// 1. Current High <= Previous High
+// 2. Current Low >= Previous Low
+// A zero-range previous candle cannot contain another candle and never qualifies
+func (p *PatternAnalyzer) IsInsideBar(current, previous *entity.Kline) bool {
+	if previous.High.Sub(previous.Low).LessThanOrEqual(decimal.Zero) {
+		return false
+	}
+
+	return current.High.LessThanOrEqual(previous.High) && current.Low.GreaterThanOrEqual(previous.Low)
+}
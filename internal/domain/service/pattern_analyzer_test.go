@@ -0,0 +1,73 @@
+package service
+
+import (
+	"testing"
+
+	"ContractAnalysis/internal/domain/entity"
+
+	"github.com/shopspring/decimal"
+)
+
+func kline(open, high, low, close float64) *entity.Kline {
+	return &entity.Kline{
+		Open:  decimal.NewFromFloat(open),
+		High:  decimal.NewFromFloat(high),
+		Low:   decimal.NewFromFloat(low),
+		Close: decimal.NewFromFloat(close),
+	}
+}
+
+func TestIsDoji(t *testing.T) {
+	p := NewPatternAnalyzer()
+
+	tests := []struct {
+		name       string
+		candle     *entity.Kline
+		maxBodyPct float64
+		want       bool
+	}{
+		{"no body", kline(100, 105, 95, 100), 10, true},
+		{"body within limit", kline(100, 105, 95, 101), 10, true},
+		{"body at limit", kline(100, 105, 95, 99), 10, true},
+		{"body above limit", kline(100, 105, 95, 102), 10, false},
+		{"full body", kline(95, 105, 95, 105), 10, false},
+		{"zero range", kline(100, 100, 100, 100), 10, false},
+		{"zero range, any body allowed", kline(100, 100, 100, 100), 100, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.IsDoji(tt.candle, tt.maxBodyPct); got != tt.want {
+				t.Errorf("IsDoji = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsInsideBar(t *testing.T) {
+	p := NewPatternAnalyzer()
+	previous := kline(100, 110, 90, 105)
+
+	tests := []struct {
+		name     string
+		current  *entity.Kline
+		previous *entity.Kline
+		want     bool
+	}{
+		{"inside", kline(100, 108, 92, 101), previous, true},
+		{"same range", kline(95, 110, 90, 100), previous, true},
+		{"higher high", kline(100, 111, 92, 101), previous, false},
+		{"lower low", kline(100, 108, 89, 101), previous, false},
+		{"engulfing", kline(100, 115, 85, 101), previous, false},
+		{"zero-range candles", kline(100, 100, 100, 100), kline(100, 100, 100, 100), false},
+		{"zero-range previous", kline(100, 101, 99, 100), kline(100, 100, 100, 100), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.IsInsideBar(tt.current, tt.previous); got != tt.want {
+				t.Errorf("IsInsideBar = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
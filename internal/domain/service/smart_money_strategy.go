@@ -16,29 +16,62 @@ type SmartMoneyStrategyConfig struct {
 	MinLongAccountRatio float64 // Minimum Long Account Ratio to consider (e.g. 70%)
 	LookbackPeriod      int     // Number of candles to look back for High (e.g. 24)
 	KlineInterval       string  // Interval for klines (e.g. "1h", "15m")
+	IndecisionMode      string  // How a Doji or Inside Bar trigger candle is used: "", "confluence" or "filter"
+	DojiMaxBodyPct      float64 // Maximum body size as a percentage of the range for a Doji
+	MinTakerFlowRatio   float64 // Minimum taker sell/buy volume ratio (0 = disabled)
 }
 
+// Indecision candle (Doji / Inside Bar) handling modes for the Smart Money strategy
+const (
+	// IndecisionModeConfluence requires an indecision trigger candle in addition to the reversal pattern
+	IndecisionModeConfluence = "confluence"
+	// IndecisionModeFilter skips setups whose trigger candle shows indecision
+	IndecisionModeFilter = "filter"
+)
+
 // SmartMoneyStrategy implements the "Three-Step" Smart Money logic
 // 1. Monitor: High Retail Long Ratio (>2.0 or 66%), Rising OI, Smart Money divergence
 // 2. Trigger: Swing Failure Pattern (SFP) / Liquidity Grab at previous high
 // 3. Exit: Managed by BaseStrategy (Stop Loss above fake-out high, Profit Target at low)
+// Klines and trade levels are computed per call and the pattern analyzer is stateless,
+// so Analyze may run concurrently for different symbols
 type SmartMoneyStrategy struct {
 	*BaseStrategy
-	config          SmartMoneyStrategyConfig
-	klineRepo       repository.KlineRepository
-	patternAnalyzer *PatternAnalyzer
+	config            SmartMoneyStrategyConfig
+	klineRepo         repository.KlineRepository
+	precisionProvider repository.SymbolPrecisionProvider
+	patternAnalyzer   *PatternAnalyzer
 }
 
 // NewSmartMoneyStrategy creates a new Smart Money strategy
-func NewSmartMoneyStrategy(config SmartMoneyStrategyConfig, klineRepo repository.KlineRepository) *SmartMoneyStrategy {
+// precisionProvider may be nil, in which case trade levels are not rounded to the tick size
+func NewSmartMoneyStrategy(config SmartMoneyStrategyConfig, klineRepo repository.KlineRepository, precisionProvider repository.SymbolPrecisionProvider) *SmartMoneyStrategy {
 	return &SmartMoneyStrategy{
-		BaseStrategy:    NewBaseStrategy(config.BaseConfig),
-		config:          config,
-		klineRepo:       klineRepo,
-		patternAnalyzer: NewPatternAnalyzer(),
+		BaseStrategy:      NewBaseStrategy(config.BaseConfig),
+		config:            config,
+		klineRepo:         klineRepo,
+		precisionProvider: precisionProvider,
+		patternAnalyzer:   NewPatternAnalyzer(),
 	}
 }
 
+// Description returns a human-readable explanation of the strategy
+func (s *SmartMoneyStrategy) Description() string {
+	return "Shorts liquidity grabs: crowded retail longs with positive funding plus a swing failure, shooting star or bearish engulfing at the recent high."
+}
+
+// Parameters returns the strategy's live configuration values
+func (s *SmartMoneyStrategy) Parameters() map[string]interface{} {
+	params := s.BaseStrategy.Parameters()
+	params["min_long_account_ratio"] = s.config.MinLongAccountRatio
+	params["lookback_period"] = s.config.LookbackPeriod
+	params["kline_interval"] = s.config.KlineInterval
+	params["indecision_mode"] = s.config.IndecisionMode
+	params["doji_max_body_pct"] = s.config.DojiMaxBodyPct
+	params["min_taker_flow_ratio"] = s.config.MinTakerFlowRatio
+	return params
+}
+
 // Analyze analyzes market data and generates signals
 func (s *SmartMoneyStrategy) Analyze(ctx context.Context, recentData []*entity.MarketData) ([]*entity.Signal, error) {
 	if !s.IsEnabled() {
@@ -52,8 +85,11 @@ func (s *SmartMoneyStrategy) Analyze(ctx context.Context, recentData []*entity.M
 	// Use the most recent data point
 	latestData := recentData[0]
 
+	// Crowd thresholds are evaluated on smoothed ratios if configured
+	evalData := s.EvaluationData(recentData)
+
 	// Check if we should generate a signal
-	shouldGenerate, _, err := s.ShouldGenerateSignal(ctx, latestData)
+	shouldGenerate, _, err := s.ShouldGenerateSignal(ctx, evalData[0])
 	if err != nil {
 		return nil, fmt.Errorf("failed to check signal condition: %w", err)
 	}
@@ -62,9 +98,20 @@ func (s *SmartMoneyStrategy) Analyze(ctx context.Context, recentData []*entity.M
 		return nil, nil
 	}
 
+	// Require retail crowding to persist across the configured number of consecutive points
+	if !s.HoldsAcrossConsecutivePoints(evalData, s.meetsCrowdConditions) {
+		return nil, nil
+	}
+
 	// Smart Money Logic implies we are Shorting the liquidity grab
 	signalType := entity.SignalTypeShort
 
+	// Require aggressive selling to confirm the liquidity grab if configured
+	if s.config.MinTakerFlowRatio > 0 &&
+		!latestData.TakerFlowConfirms(signalType, decimal.NewFromFloat(s.config.MinTakerFlowRatio)) {
+		return nil, nil
+	}
+
 	setup, err := s.detectSFPSetup(ctx, latestData)
 	if err != nil {
 		return nil, fmt.Errorf("failed to detect setup: %w", err)
@@ -76,14 +123,20 @@ func (s *SmartMoneyStrategy) Analyze(ctx context.Context, recentData []*entity.M
 
 	// Create configuration snapshot
 	configSnapshot := map[string]interface{}{
-		"min_long_account_ratio": s.config.MinLongAccountRatio,
-		"lookback_period":        s.config.LookbackPeriod,
-		"kline_interval":         s.config.KlineInterval,
-		"confirmation_hours":     s.GetConfirmationHours(),
-		"tracking_hours":         s.GetTrackingHours(),
-		"profit_target_pct":      s.GetProfitTargetPct(),
-		"stop_loss_pct":          s.GetStopLossPct(),
-		"setup_type":             "SFP_SHORT",
+		"min_long_account_ratio":      s.config.MinLongAccountRatio,
+		"lookback_period":             s.config.LookbackPeriod,
+		"kline_interval":              s.config.KlineInterval,
+		"indecision_mode":             s.config.IndecisionMode,
+		"doji_max_body_pct":           s.config.DojiMaxBodyPct,
+		"min_taker_flow_ratio":        s.config.MinTakerFlowRatio,
+		"confirmation_hours":          s.GetConfirmationHours(),
+		"tracking_hours":              s.GetTrackingHours(),
+		"profit_target_pct":           s.GetProfitTargetPct(),
+		"stop_loss_pct":               s.GetStopLossPct(),
+		"setup_type":                  "SFP_SHORT",
+		"require_consecutive_points":  s.GetRequireConsecutivePoints(),
+		"kline_from_confirmation":     s.GetKlineFromConfirmation(),
+		"kline_entry_at_confirmation": s.GetKlineEntryAtConfirmation(),
 	}
 
 	// Create signal
@@ -97,9 +150,19 @@ func (s *SmartMoneyStrategy) Analyze(ctx context.Context, recentData []*entity.M
 		configSnapshot,
 	)
 
-	// Set Trade Levels
+	// Set Trade Levels, rounded to the exchange tick size when available
+	if s.precisionProvider != nil {
+		if tickSize, err := s.precisionProvider.GetTickSize(ctx, latestData.Symbol); err == nil {
+			signal.TickSize = tickSize
+		}
+	}
 	signal.SetTradeLevels(setup.StopLoss, setup.TakeProfit1, setup.TakeProfit2)
 
+	// ATR-based levels replace the pattern levels when configured
+	if err := s.ApplyATRLevels(ctx, s.klineRepo, s.precisionProvider, signal); err != nil {
+		return nil, err
+	}
+
 	// Enable trailing stop if configured
 	trailingStopCfg := s.GetTrailingStopConfig()
 	if trailingStopCfg.Enabled {
@@ -157,6 +220,22 @@ func (s *SmartMoneyStrategy) detectSFPSetup(ctx context.Context, data *entity.Ma
 	isShootingStar := s.patternAnalyzer.IsShootingStar(triggerCandle)
 	isBearishEngulfing := s.patternAnalyzer.IsBearishEngulfing(triggerCandle, prevCandle)
 
+	// Indecision candles (Doji / Inside Bar) either confirm or veto the setup
+	isDoji := s.patternAnalyzer.IsDoji(triggerCandle, s.config.DojiMaxBodyPct)
+	isInsideBar := s.patternAnalyzer.IsInsideBar(triggerCandle, prevCandle)
+	isIndecision := isDoji || isInsideBar
+
+	switch s.config.IndecisionMode {
+	case IndecisionModeConfluence:
+		if !isIndecision {
+			return nil, nil
+		}
+	case IndecisionModeFilter:
+		if isIndecision {
+			return nil, nil
+		}
+	}
+
 	if isSFP || isShootingStar || isBearishEngulfing {
 		// Calculate SL: High of the trigger candle + buffer (0.1%)
 		stopLoss := triggerCandle.High.Mul(decimal.NewFromFloat(1.001))
@@ -191,6 +270,12 @@ func (s *SmartMoneyStrategy) detectSFPSetup(ctx context.Context, data *entity.Ma
 		if isBearishEngulfing {
 			patternName += "BearishEngulfing "
 		}
+		if isDoji && s.config.IndecisionMode == IndecisionModeConfluence {
+			patternName += "Doji "
+		}
+		if isInsideBar && s.config.IndecisionMode == IndecisionModeConfluence {
+			patternName += "InsideBar "
+		}
 
 		reason := fmt.Sprintf("Smart Money Confluence: %sdetected. SL at %.2f, TP1 at %.2f (Low)", patternName, stopLoss.InexactFloat64(), takeProfit1.InexactFloat64())
 
@@ -215,19 +300,7 @@ func (s *SmartMoneyStrategy) ShouldGenerateSignal(ctx context.Context, data *ent
 		return false, "", fmt.Errorf("invalid market data: %w", err)
 	}
 
-	// 1.1 Long Account Ratio Check
-	minLongRatio := decimal.NewFromFloat(s.config.MinLongAccountRatio)
-	if data.LongAccountRatio.LessThan(minLongRatio) {
-		return false, "", nil
-	}
-
-	// 1.2 Divergence Check
-	if data.LongPositionRatio.GreaterThanOrEqual(data.LongAccountRatio) {
-		return false, "", nil
-	}
-
-	// Funding Rate Check (Positive) - Crowd is Long paying Short
-	if data.FundingRate.LessThanOrEqual(decimal.Zero) {
+	if !s.meetsCrowdConditions(data) {
 		return false, "", nil
 	}
 
@@ -243,3 +316,29 @@ func (s *SmartMoneyStrategy) ShouldGenerateSignal(ctx context.Context, data *ent
 
 	return false, "", nil
 }
+
+// SignalDirection returns SHORT when retail crowding is met, since the strategy only
+// fades crowded longs, or an empty type otherwise
+func (s *SmartMoneyStrategy) SignalDirection(data *entity.MarketData) entity.SignalType {
+	if !s.meetsCrowdConditions(data) {
+		return ""
+	}
+	return entity.SignalTypeShort
+}
+
+// meetsCrowdConditions checks the retail crowding conditions (step 1) for a single data point
+func (s *SmartMoneyStrategy) meetsCrowdConditions(data *entity.MarketData) bool {
+	// 1.1 Long Account Ratio Check
+	minLongRatio := decimal.NewFromFloat(s.config.MinLongAccountRatio)
+	if data.LongAccountRatio.LessThan(minLongRatio) {
+		return false
+	}
+
+	// 1.2 Divergence Check
+	if data.LongPositionRatio.GreaterThanOrEqual(data.LongAccountRatio) {
+		return false
+	}
+
+	// Funding Rate Check (Positive) - Crowd is Long paying Short
+	return data.FundingRate.GreaterThan(decimal.Zero)
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"ContractAnalysis/internal/domain/entity"
+
+	"github.com/shopspring/decimal"
+)
+
+// stubKlineRepo serves a swing failure setup scaled by a per-symbol factor, so signals
+// computed from another symbol's klines are caught by their trade levels
+type stubKlineRepo struct {
+	scale map[string]int64
+}
+
+func (r *stubKlineRepo) GetKlines(ctx context.Context, symbol string, interval string, limit int) ([]*entity.Kline, error) {
+	k := decimal.NewFromInt(r.scale[symbol])
+	candle := func(open, high, low, close float64) *entity.Kline {
+		return &entity.Kline{
+			Open:  decimal.NewFromFloat(open).Mul(k),
+			High:  decimal.NewFromFloat(high).Mul(k),
+			Low:   decimal.NewFromFloat(low).Mul(k),
+			Close: decimal.NewFromFloat(close).Mul(k),
+		}
+	}
+
+	// A fresh slice per call, oldest first: lookback candles topping at 100, a bullish
+	// previous candle, a trigger candle sweeping 100 and closing below, the forming candle
+	klines := make([]*entity.Kline, 0, limit)
+	for len(klines) < limit-3 {
+		klines = append(klines, candle(95, 100, 90, 96))
+	}
+	klines = append(klines,
+		candle(95, 99, 94, 97),
+		candle(98, 102, 97, 99),
+		candle(99, 99.5, 98.5, 99),
+	)
+	return klines, nil
+}
+
+func (r *stubKlineRepo) GetKlinesSince(ctx context.Context, symbol string, interval string, startTime time.Time) ([]*entity.Kline, error) {
+	return r.GetKlines(ctx, symbol, interval, 10)
+}
+
+type stubPrecisionProvider struct{}
+
+func (stubPrecisionProvider) GetTickSize(ctx context.Context, symbol string) (decimal.Decimal, error) {
+	return decimal.NewFromFloat(0.01), nil
+}
+
+func crowdedLongData(symbol string) []*entity.MarketData {
+	return []*entity.MarketData{{
+		Symbol:             symbol,
+		Timestamp:          time.Now(),
+		LongAccountRatio:   decimal.NewFromInt(75),
+		ShortAccountRatio:  decimal.NewFromInt(25),
+		LongPositionRatio:  decimal.NewFromInt(60),
+		ShortPositionRatio: decimal.NewFromInt(40),
+		Price:              decimal.NewFromInt(99),
+		OpenInterest:       decimal.NewFromInt(1_000_000),
+		FundingRate:        decimal.NewFromFloat(0.0001),
+	}}
+}
+
+// TestSmartMoneyAnalyzeConcurrent runs one strategy instance from many goroutines, as the
+// analyzer's workers do; run with -race to catch shared per-analysis state
+func TestSmartMoneyAnalyzeConcurrent(t *testing.T) {
+	ctx := context.Background()
+
+	const symbols = 8
+	repo := &stubKlineRepo{scale: make(map[string]int64)}
+	for i := range symbols {
+		repo.scale[fmt.Sprintf("SYM%dUSDT", i)] = int64(i + 1)
+	}
+
+	strategy := NewSmartMoneyStrategy(SmartMoneyStrategyConfig{
+		BaseConfig: StrategyConfig{
+			Name:              entity.StrategySmartMoney,
+			Enabled:           true,
+			ConfirmationHours: 1,
+			TrackingHours:     24,
+			ProfitTargetPct:   2,
+			StopLossPct:       1,
+		},
+		MinLongAccountRatio: 70,
+		LookbackPeriod:      5,
+		KlineInterval:       "1h",
+		DojiMaxBodyPct:      10,
+	}, repo, stubPrecisionProvider{})
+
+	// Expected trade levels, computed sequentially
+	want := make(map[string]decimal.Decimal)
+	for symbol := range repo.scale {
+		signals, err := strategy.Analyze(ctx, crowdedLongData(symbol))
+		if err != nil {
+			t.Fatalf("Analyze(%s): %v", symbol, err)
+		}
+		if len(signals) != 1 {
+			t.Fatalf("Analyze(%s) returned %d signals, want 1", symbol, len(signals))
+		}
+		want[symbol] = signals[0].StopLossPrice
+	}
+
+	const rounds = 16
+	var wg sync.WaitGroup
+	errs := make(chan error, rounds*symbols)
+	for range rounds {
+		for symbol := range repo.scale {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				signals, err := strategy.Analyze(ctx, crowdedLongData(symbol))
+				switch {
+				case err != nil:
+					errs <- fmt.Errorf("Analyze(%s): %w", symbol, err)
+				case len(signals) != 1:
+					errs <- fmt.Errorf("Analyze(%s) returned %d signals, want 1", symbol, len(signals))
+				case signals[0].Symbol != symbol:
+					errs <- fmt.Errorf("Analyze(%s) returned a signal for %s", symbol, signals[0].Symbol)
+				case !signals[0].StopLossPrice.Equal(want[symbol]):
+					errs <- fmt.Errorf("Analyze(%s) stop loss = %s, want %s", symbol, signals[0].StopLossPrice, want[symbol])
+				}
+			}()
+		}
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+}
@@ -8,6 +8,12 @@ import (
 )
 
 // Strategy defines the interface for all trading strategies
+//
+// Concurrency: a single strategy instance is shared by the analyzer's workers, which
+// analyze different symbols in parallel. Implementations must be safe for concurrent
+// use: configuration is read-only after construction and all per-analysis state
+// (klines, evaluation data, config snapshots) lives in locals of the call, never in
+// fields of the strategy. Dependencies such as KlineRepository must be goroutine-safe too
 type Strategy interface {
 	// Name returns the strategy name
 	Name() string
@@ -18,6 +24,12 @@ type Strategy interface {
 	// IsEnabled returns whether the strategy is enabled
 	IsEnabled() bool
 
+	// Description returns a human-readable explanation of the strategy
+	Description() string
+
+	// Parameters returns the strategy's live configuration values
+	Parameters() map[string]interface{}
+
 	// Analyze analyzes market data and generates signals
 	// Takes a list of recent market data (ordered by time, newest first)
 	// Returns a list of generated signals
@@ -37,6 +49,54 @@ type Strategy interface {
 
 	// GetStopLossPct returns the stop loss percentage
 	GetStopLossPct() float64
+
+	// GetMinLiquidityTier returns the minimum pair liquidity tier the strategy runs on
+	GetMinLiquidityTier() entity.LiquidityTier
+
+	// GetMinDataQualityScore returns the minimum market data quality score the strategy runs on
+	GetMinDataQualityScore() int
+
+	// GetAvoidPreFundingMinutes returns how many minutes before a funding settlement the
+	// strategy skips generating signals (0 = never)
+	GetAvoidPreFundingMinutes() int
+
+	// GetMaxSignalsPerDay returns how many signals the strategy may generate per day
+	// (0 = fall back to the global cap)
+	GetMaxSignalsPerDay() int
+
+	// GetLookbackHours returns how many hours of market data history the strategy analyzes
+	// (0 = fall back to the global lookback)
+	GetLookbackHours() int
+
+	// GetSymbolFilter returns the symbols the strategy runs on
+	GetSymbolFilter() SymbolFilter
+}
+
+// SignalAggregator is implemented by strategies that derive signals from the
+// signals other strategies generated for the same symbol in one analysis cycle
+type SignalAggregator interface {
+	Strategy
+
+	// Aggregate returns a combined signal, or nil if the input signals don't qualify
+	Aggregate(latestData *entity.MarketData, signals []*entity.Signal) *entity.Signal
+}
+
+// ConfirmationValidator is implemented by strategies that re-check their entry
+// conditions when a signal's confirmation period ends
+type ConfirmationValidator interface {
+	Strategy
+
+	// ValidateConfirmation returns false and the reason if the signal no longer qualifies
+	ValidateConfirmation(ctx context.Context, signal *entity.Signal, currentData *entity.MarketData) (bool, string)
+}
+
+// DirectionalStrategy is implemented by strategies that can tell which direction a signal
+// would take on a single data point without generating it
+type DirectionalStrategy interface {
+	Strategy
+
+	// SignalDirection returns the signal type the data point points to, or an empty type if none
+	SignalDirection(data *entity.MarketData) entity.SignalType
 }
 
 // TrailingStopConfig represents trailing stop configuration
@@ -46,6 +106,15 @@ type TrailingStopConfig struct {
 	TrailDistancePct float64
 }
 
+// ATRLevelsConfig represents ATR-based stop loss and profit target configuration
+type ATRLevelsConfig struct {
+	Enabled          bool
+	Interval         string  // Kline interval the ATR is computed on
+	Period           int     // Number of klines in the ATR
+	StopLossMultiple float64 // Stop loss distance from entry in ATRs
+	TargetMultiple   float64 // Profit target distance from entry in ATRs
+}
+
 // StrategyConfig represents common strategy configuration
 type StrategyConfig struct {
 	Name              string
@@ -55,9 +124,55 @@ type StrategyConfig struct {
 	ProfitTargetPct   float64
 	StopLossPct       float64
 	TrailingStop      TrailingStopConfig
+
+	// RequireConsecutivePoints is the number of consecutive collected data points
+	// (newest first) the entry condition must hold for. Values below 1 are treated as 1
+	RequireConsecutivePoints int
+
+	// UseSmoothedRatios evaluates entry thresholds against recency-weighted average ratios
+	// across the recent data points instead of the latest snapshot alone
+	UseSmoothedRatios bool
+
+	// SmoothingHalfLifePoints is the age in collected points at which a data point's weight
+	// halves when UseSmoothedRatios is set. Values <= 0 are treated as 1
+	SmoothingHalfLifePoints float64
+
+	// MinLiquidityTier restricts the strategy to pairs at or above this tier (unknown = all pairs)
+	MinLiquidityTier entity.LiquidityTier
+
+	// MinDataQualityScore skips symbols whose latest market data scores lower (0 = disabled)
+	// Data without a position ratio scores 80, so 100 requires real position ratio data
+	MinDataQualityScore int
+
+	// AvoidPreFundingMinutes skips symbols whose next funding settlement is at most this many
+	// minutes away, since price often reverts around settlement (0 = disabled)
+	AvoidPreFundingMinutes int
+
+	// MaxSignalsPerDay stops the strategy for the rest of the day once it generated this
+	// many signals (0 = use the global cap)
+	MaxSignalsPerDay int
+
+	// LookbackHours is how many hours of market data history the strategy analyzes
+	// (0 = use the global analysis lookback)
+	LookbackHours int
+
+	// KlineFromConfirmation starts kline tracking at the end of the confirmation
+	// window (the trade entry) instead of at signal generation
+	KlineFromConfirmation bool
+
+	// KlineEntryAtConfirmation measures kline changes from the price captured when the
+	// signal is confirmed instead of the signal price; tracking then starts at confirmation too
+	KlineEntryAtConfirmation bool
+
+	// ATRLevels sets the stop loss and profit target from ATR multiples at signal time
+	ATRLevels ATRLevelsConfig
+
+	// Symbols restricts the strategy to allowed symbols and excludes denied ones
+	Symbols SymbolFilter
 }
 
 // BaseStrategy provides common functionality for all strategies
+// Its configuration is never modified after construction, so it is safe for concurrent use
 type BaseStrategy struct {
 	config StrategyConfig
 }
@@ -84,6 +199,41 @@ func (s *BaseStrategy) IsEnabled() bool {
 	return s.config.Enabled
 }
 
+// Description returns the strategy name by default
+func (s *BaseStrategy) Description() string {
+	return s.config.Name
+}
+
+// Parameters returns the common strategy parameters
+func (s *BaseStrategy) Parameters() map[string]interface{} {
+	return map[string]interface{}{
+		"confirmation_hours":          s.config.ConfirmationHours,
+		"tracking_hours":              s.config.TrackingHours,
+		"profit_target_pct":           s.config.ProfitTargetPct,
+		"stop_loss_pct":               s.config.StopLossPct,
+		"require_consecutive_points":  s.GetRequireConsecutivePoints(),
+		"trailing_stop_enabled":       s.config.TrailingStop.Enabled,
+		"trailing_stop_activation":    s.config.TrailingStop.ActivationPct,
+		"trailing_stop_distance":      s.config.TrailingStop.TrailDistancePct,
+		"use_smoothed_ratios":         s.config.UseSmoothedRatios,
+		"smoothing_half_life_points":  s.config.SmoothingHalfLifePoints,
+		"min_liquidity_tier":          string(s.config.MinLiquidityTier),
+		"min_data_quality_score":      s.config.MinDataQualityScore,
+		"avoid_pre_funding_minutes":   s.config.AvoidPreFundingMinutes,
+		"max_signals_per_day":         s.config.MaxSignalsPerDay,
+		"lookback_hours":              s.config.LookbackHours,
+		"kline_from_confirmation":     s.config.KlineFromConfirmation,
+		"kline_entry_at_confirmation": s.config.KlineEntryAtConfirmation,
+		"atr_levels_enabled":          s.config.ATRLevels.Enabled,
+		"atr_interval":                s.config.ATRLevels.Interval,
+		"atr_period":                  s.config.ATRLevels.Period,
+		"atr_stop_loss_multiple":      s.config.ATRLevels.StopLossMultiple,
+		"atr_target_multiple":         s.config.ATRLevels.TargetMultiple,
+		"symbols_allow":               s.config.Symbols.Allow,
+		"symbols_deny":                s.config.Symbols.Deny,
+	}
+}
+
 // GetConfirmationHours returns the confirmation period in hours
 func (s *BaseStrategy) GetConfirmationHours() int {
 	return s.config.ConfirmationHours
@@ -104,6 +254,94 @@ func (s *BaseStrategy) GetStopLossPct() float64 {
 	return s.config.StopLossPct
 }
 
+// GetMinLiquidityTier returns the minimum pair liquidity tier the strategy runs on
+func (s *BaseStrategy) GetMinLiquidityTier() entity.LiquidityTier {
+	return s.config.MinLiquidityTier
+}
+
+// GetMinDataQualityScore returns the minimum market data quality score the strategy runs on
+func (s *BaseStrategy) GetMinDataQualityScore() int {
+	return s.config.MinDataQualityScore
+}
+
+// GetAvoidPreFundingMinutes returns how many minutes before a funding settlement signals are skipped
+func (s *BaseStrategy) GetAvoidPreFundingMinutes() int {
+	return s.config.AvoidPreFundingMinutes
+}
+
+// GetMaxSignalsPerDay returns how many signals the strategy may generate per day
+func (s *BaseStrategy) GetMaxSignalsPerDay() int {
+	return s.config.MaxSignalsPerDay
+}
+
+// GetLookbackHours returns how many hours of market data history the strategy analyzes
+func (s *BaseStrategy) GetLookbackHours() int {
+	return s.config.LookbackHours
+}
+
+// GetSymbolFilter returns the symbols the strategy runs on
+func (s *BaseStrategy) GetSymbolFilter() SymbolFilter {
+	return s.config.Symbols
+}
+
+// GetKlineFromConfirmation returns whether kline tracking starts at the end of the confirmation window
+func (s *BaseStrategy) GetKlineFromConfirmation() bool {
+	return s.config.KlineFromConfirmation
+}
+
+// GetKlineEntryAtConfirmation returns whether kline changes are measured from the confirmation price
+func (s *BaseStrategy) GetKlineEntryAtConfirmation() bool {
+	return s.config.KlineEntryAtConfirmation
+}
+
+// GetRequireConsecutivePoints returns how many consecutive data points must meet the condition
+func (s *BaseStrategy) GetRequireConsecutivePoints() int {
+	if s.config.RequireConsecutivePoints < 1 {
+		return 1
+	}
+	return s.config.RequireConsecutivePoints
+}
+
+// HoldsAcrossConsecutivePoints checks that condition holds for each of the latest
+// N data points, where N is the configured RequireConsecutivePoints.
+// recentData must be ordered newest first. Returns false if fewer than N points are available
+func (s *BaseStrategy) HoldsAcrossConsecutivePoints(recentData []*entity.MarketData, condition func(*entity.MarketData) bool) bool {
+	required := s.GetRequireConsecutivePoints()
+	if len(recentData) < required {
+		return false
+	}
+
+	for _, data := range recentData[:required] {
+		if !condition(data) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// EvaluationData returns the data points (newest first) entry thresholds are evaluated against
+// Without UseSmoothedRatios this is recentData itself. Otherwise each of the latest
+// RequireConsecutivePoints points is replaced by its ratios smoothed over itself and
+// every older point, so one noisy reading can't trigger or block a signal on its own
+func (s *BaseStrategy) EvaluationData(recentData []*entity.MarketData) []*entity.MarketData {
+	if !s.config.UseSmoothedRatios {
+		return recentData
+	}
+
+	points := s.GetRequireConsecutivePoints()
+	if len(recentData) < points {
+		points = len(recentData)
+	}
+
+	smoothed := make([]*entity.MarketData, points)
+	for i := range smoothed {
+		smoothed[i] = entity.SmoothedRatios(recentData[i:], s.config.SmoothingHalfLifePoints)
+	}
+
+	return smoothed
+}
+
 // GetTrailingStopConfig returns the trailing stop configuration
 func (s *BaseStrategy) GetTrailingStopConfig() TrailingStopConfig {
 	return s.config.TrailingStop
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"path"
+	"strings"
+)
+
+// SymbolFilter restricts a strategy to a subset of symbols
+// Entries are symbols or glob patterns (e.g. "BTCUSDT", "*USDC", "1000*"), matched
+// case-insensitively. The zero value matches every symbol
+type SymbolFilter struct {
+	Allow []string // Only these symbols run the strategy (empty = all symbols)
+	Deny  []string // These symbols never run the strategy, even if allowed
+}
+
+// Matches reports whether the strategy should run on symbol
+// A denied symbol never matches; otherwise it must be allowed when an allow list is set
+func (f SymbolFilter) Matches(symbol string) bool {
+	symbol = strings.ToUpper(symbol)
+
+	if matchesAnySymbol(f.Deny, symbol) {
+		return false
+	}
+	return len(f.Allow) == 0 || matchesAnySymbol(f.Allow, symbol)
+}
+
+// IsRestricted reports whether the filter excludes any symbol
+func (f SymbolFilter) IsRestricted() bool {
+	return len(f.Allow) > 0 || len(f.Deny) > 0
+}
+
+// matchesAnySymbol reports whether symbol matches one of the patterns
+// Malformed patterns never match; the config loader rejects them at startup
+func matchesAnySymbol(patterns []string, symbol string) bool {
+	for _, pattern := range patterns {
+		if ok, err := path.Match(strings.ToUpper(pattern), symbol); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
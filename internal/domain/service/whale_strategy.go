@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"ContractAnalysis/internal/domain/entity"
+	"ContractAnalysis/internal/domain/repository"
 	"ContractAnalysis/internal/infrastructure/logger"
 
 	"github.com/shopspring/decimal"
@@ -16,26 +17,55 @@ type WhaleStrategyConfig struct {
 	MinRatioDifference     float64 // Minimum account ratio difference
 	WhalePositionThreshold float64 // Minimum whale position percentage
 	MinDivergence          float64 // Minimum divergence between account and position ratios
+	MinTakerFlowRatio      float64 // Minimum taker volume ratio in the signal direction (0 = disabled)
+
+	// Require the divergence to have widened over the last wideningDivergencePoints data points
+	RequireWideningDivergence bool
 }
 
+// wideningDivergencePoints is how many recent data points the divergence trend is measured over
+const wideningDivergencePoints = 3
+
 // WhaleStrategy implements the whale position analysis strategy
 // Detects divergence between account count ratio and position size ratio
 // Example: 80% accounts long but 70% position size short -> retail being liquidated, follow whales (short)
 type WhaleStrategy struct {
 	*BaseStrategy
-	config WhaleStrategyConfig
-	logger *logger.Logger
+	config            WhaleStrategyConfig
+	klineRepo         repository.KlineRepository
+	precisionProvider repository.SymbolPrecisionProvider
+	logger            *logger.Logger
 }
 
 // NewWhaleStrategy creates a new whale strategy
-func NewWhaleStrategy(config WhaleStrategyConfig) *WhaleStrategy {
+// klineRepo is only used for ATR-based trade levels and may be nil when they are disabled
+// precisionProvider may be nil, in which case ATR levels are not rounded to the tick size
+func NewWhaleStrategy(config WhaleStrategyConfig, klineRepo repository.KlineRepository, precisionProvider repository.SymbolPrecisionProvider) *WhaleStrategy {
 	return &WhaleStrategy{
-		BaseStrategy: NewBaseStrategy(config.BaseConfig),
-		config:       config,
-		logger:       logger.WithComponent("whale-strategy"),
+		BaseStrategy:      NewBaseStrategy(config.BaseConfig),
+		config:            config,
+		klineRepo:         klineRepo,
+		precisionProvider: precisionProvider,
+		logger:            logger.WithComponent("whale-strategy"),
 	}
 }
 
+// Description returns a human-readable explanation of the strategy
+func (s *WhaleStrategy) Description() string {
+	return "Follows whales when the top-trader position ratio diverges from the retail account ratio."
+}
+
+// Parameters returns the strategy's live configuration values
+func (s *WhaleStrategy) Parameters() map[string]interface{} {
+	params := s.BaseStrategy.Parameters()
+	params["min_ratio_difference"] = s.config.MinRatioDifference
+	params["whale_position_threshold"] = s.config.WhalePositionThreshold
+	params["min_divergence"] = s.config.MinDivergence
+	params["min_taker_flow_ratio"] = s.config.MinTakerFlowRatio
+	params["require_widening_divergence"] = s.config.RequireWideningDivergence
+	return params
+}
+
 // Analyze analyzes market data and generates signals based on whale strategy
 func (s *WhaleStrategy) Analyze(ctx context.Context, recentData []*entity.MarketData) ([]*entity.Signal, error) {
 	if !s.IsEnabled() {
@@ -65,8 +95,11 @@ func (s *WhaleStrategy) Analyze(ctx context.Context, recentData []*entity.Market
 	// Analyze the most recent data point with valid position ratio
 	latestData := validData[0]
 
+	// Thresholds are evaluated on smoothed ratios if configured
+	evalData := s.EvaluationData(validData)
+
 	// Check if we should generate a signal
-	shouldGenerate, reason, err := s.ShouldGenerateSignal(ctx, latestData)
+	shouldGenerate, reason, err := s.ShouldGenerateSignal(ctx, evalData[0])
 	if err != nil {
 		return nil, fmt.Errorf("failed to check signal condition: %w", err)
 	}
@@ -78,21 +111,53 @@ func (s *WhaleStrategy) Analyze(ctx context.Context, recentData []*entity.Market
 	// Determine signal type based on whale direction
 	// We follow the whales (position ratio), not the accounts
 	var signalType entity.SignalType
-	if latestData.GetWhaleDirection() == "LONG" {
+	if evalData[0].GetWhaleDirection() == "LONG" {
 		signalType = entity.SignalTypeLong
 	} else {
 		signalType = entity.SignalTypeShort
 	}
 
+	// Require the divergence to persist in the same whale direction across consecutive points
+	// Points dropped for missing position ratios break the run, so only the collected
+	// points adjacent to the latest valid one count towards it
+	run := validRunLength(recentData)
+	consecutiveData := evalData
+	if run < len(consecutiveData) {
+		consecutiveData = consecutiveData[:run]
+	}
+	whaleDirection := evalData[0].GetWhaleDirection()
+	if !s.HoldsAcrossConsecutivePoints(consecutiveData, func(d *entity.MarketData) bool {
+		return d.GetWhaleDirection() == whaleDirection && s.meetsWhaleConditions(d)
+	}) {
+		return nil, nil
+	}
+
+	// Skip fading setups where the divergence is narrowing if configured
+	// The trend is measured over the same unbroken run of valid points
+	if s.config.RequireWideningDivergence && !s.divergenceWidening(validData[:run]) {
+		return nil, nil
+	}
+
+	// Require aggressive order flow to agree with the whales if configured
+	if s.config.MinTakerFlowRatio > 0 &&
+		!latestData.TakerFlowConfirms(signalType, decimal.NewFromFloat(s.config.MinTakerFlowRatio)) {
+		return nil, nil
+	}
+
 	// Create configuration snapshot
 	configSnapshot := map[string]interface{}{
-		"min_ratio_difference":     s.config.MinRatioDifference,
-		"whale_position_threshold": s.config.WhalePositionThreshold,
-		"min_divergence":           s.config.MinDivergence,
-		"confirmation_hours":       s.GetConfirmationHours(),
-		"tracking_hours":           s.GetTrackingHours(),
-		"profit_target_pct":        s.GetProfitTargetPct(),
-		"stop_loss_pct":            s.GetStopLossPct(),
+		"min_ratio_difference":        s.config.MinRatioDifference,
+		"whale_position_threshold":    s.config.WhalePositionThreshold,
+		"min_divergence":              s.config.MinDivergence,
+		"min_taker_flow_ratio":        s.config.MinTakerFlowRatio,
+		"require_widening_divergence": s.config.RequireWideningDivergence,
+		"confirmation_hours":          s.GetConfirmationHours(),
+		"tracking_hours":              s.GetTrackingHours(),
+		"profit_target_pct":           s.GetProfitTargetPct(),
+		"stop_loss_pct":               s.GetStopLossPct(),
+		"require_consecutive_points":  s.GetRequireConsecutivePoints(),
+		"kline_from_confirmation":     s.GetKlineFromConfirmation(),
+		"kline_entry_at_confirmation": s.GetKlineEntryAtConfirmation(),
 	}
 
 	// Create signal
@@ -106,6 +171,11 @@ func (s *WhaleStrategy) Analyze(ctx context.Context, recentData []*entity.Market
 		configSnapshot,
 	)
 
+	// Set volatility-based trade levels if configured
+	if err := s.ApplyATRLevels(ctx, s.klineRepo, s.precisionProvider, signal); err != nil {
+		return nil, err
+	}
+
 	// Enable trailing stop if configured
 	trailingStopCfg := s.GetTrailingStopConfig()
 	if trailingStopCfg.Enabled {
@@ -119,6 +189,29 @@ func (s *WhaleStrategy) Analyze(ctx context.Context, recentData []*entity.Market
 	return signals, nil
 }
 
+// divergenceWidening checks that the divergence has a positive trend across the latest
+// wideningDivergencePoints data points. recentData must be ordered newest first
+func (s *WhaleStrategy) divergenceWidening(recentData []*entity.MarketData) bool {
+	if len(recentData) < wideningDivergencePoints {
+		return false
+	}
+	return entity.DivergenceSlope(recentData[:wideningDivergencePoints]).IsPositive()
+}
+
+// validRunLength returns how many data points with a valid position ratio directly follow
+// each other in recentData (newest first), starting from the latest valid one
+func validRunLength(recentData []*entity.MarketData) int {
+	run := 0
+	for _, d := range recentData {
+		if d.PositionRatioAvailable {
+			run++
+		} else if run > 0 {
+			break
+		}
+	}
+	return run
+}
+
 // ShouldGenerateSignal checks if conditions are met to generate a signal
 func (s *WhaleStrategy) ShouldGenerateSignal(ctx context.Context, data *entity.MarketData) (bool, string, error) {
 	if !s.IsEnabled() {
@@ -130,29 +223,14 @@ func (s *WhaleStrategy) ShouldGenerateSignal(ctx context.Context, data *entity.M
 		return false, "", fmt.Errorf("invalid market data: %w", err)
 	}
 
-	minRatioDiff := decimal.NewFromFloat(s.config.MinRatioDifference)
-	whaleThreshold := decimal.NewFromFloat(s.config.WhalePositionThreshold)
-	minDivergence := decimal.NewFromFloat(s.config.MinDivergence)
-
-	// Check if there's divergence between account ratio and position ratio
-	if !data.HasDivergence() {
+	if !s.meetsWhaleConditions(data) {
 		return false, "", nil
 	}
 
-	// Calculate divergence
+	whaleThreshold := decimal.NewFromFloat(s.config.WhalePositionThreshold)
+	minDivergence := decimal.NewFromFloat(s.config.MinDivergence)
 	divergence := data.CalculateDivergence()
 
-	// Check if divergence is significant
-	if divergence.LessThan(minDivergence) {
-		return false, "", nil
-	}
-
-	// Check if account ratio is extreme
-	if !data.IsAccountRatioExtreme(minRatioDiff) {
-		return false, "", nil
-	}
-
-	// Check if whale position meets threshold
 	whaleDirection := data.GetWhaleDirection()
 	var whalePositionRatio decimal.Decimal
 	if whaleDirection == "LONG" {
@@ -161,10 +239,6 @@ func (s *WhaleStrategy) ShouldGenerateSignal(ctx context.Context, data *entity.M
 		whalePositionRatio = data.ShortPositionRatio
 	}
 
-	if whalePositionRatio.LessThan(whaleThreshold) {
-		return false, "", nil
-	}
-
 	// All conditions met - generate signal
 	accountDirection := data.GetDominantDirection()
 
@@ -192,6 +266,49 @@ func (s *WhaleStrategy) ShouldGenerateSignal(ctx context.Context, data *entity.M
 	return true, reason, nil
 }
 
+// SignalDirection returns the whale side of a single data point, or an empty type
+// if it doesn't meet the whale conditions
+func (s *WhaleStrategy) SignalDirection(data *entity.MarketData) entity.SignalType {
+	if !s.meetsWhaleConditions(data) {
+		return ""
+	}
+	if data.GetWhaleDirection() == "LONG" {
+		return entity.SignalTypeLong
+	}
+	return entity.SignalTypeShort
+}
+
+// meetsWhaleConditions checks the divergence, account ratio and whale position
+// thresholds for a single data point without validating its freshness
+func (s *WhaleStrategy) meetsWhaleConditions(data *entity.MarketData) bool {
+	minRatioDiff := decimal.NewFromFloat(s.config.MinRatioDifference)
+	whaleThreshold := decimal.NewFromFloat(s.config.WhalePositionThreshold)
+	minDivergence := decimal.NewFromFloat(s.config.MinDivergence)
+
+	// Check if there's divergence between account ratio and position ratio
+	if !data.HasDivergence() {
+		return false
+	}
+
+	// Check if divergence is significant
+	if data.CalculateDivergence().LessThan(minDivergence) {
+		return false
+	}
+
+	// Check if account ratio is extreme
+	if !data.IsAccountRatioExtreme(minRatioDiff) {
+		return false
+	}
+
+	// Check if whale position meets threshold
+	whalePositionRatio := data.ShortPositionRatio
+	if data.GetWhaleDirection() == "LONG" {
+		whalePositionRatio = data.LongPositionRatio
+	}
+
+	return whalePositionRatio.GreaterThanOrEqual(whaleThreshold)
+}
+
 // ValidateConfirmation checks if a signal still meets the strategy conditions
 func (s *WhaleStrategy) ValidateConfirmation(ctx context.Context, signal *entity.Signal, currentData *entity.MarketData) (bool, string) {
 	if !s.IsEnabled() {
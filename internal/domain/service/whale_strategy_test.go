@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ContractAnalysis/internal/domain/entity"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestWhaleStrategyConsecutivePointsSpanGaps(t *testing.T) {
+	// Retail 80% long while whales hold 70% short, newest first; false marks a
+	// collected point without a position ratio
+	newData := func(available ...bool) []*entity.MarketData {
+		now := time.Now()
+		var data []*entity.MarketData
+		for i, ok := range available {
+			d := &entity.MarketData{
+				Symbol:                 "BTCUSDT",
+				Timestamp:              now.Add(-time.Duration(i) * 5 * time.Minute),
+				LongAccountRatio:       decimal.NewFromInt(80),
+				ShortAccountRatio:      decimal.NewFromInt(20),
+				Price:                  decimal.NewFromInt(100),
+				PositionRatioAvailable: ok,
+			}
+			if ok {
+				d.LongPositionRatio = decimal.NewFromInt(30)
+				d.ShortPositionRatio = decimal.NewFromInt(70)
+			}
+			data = append(data, d)
+		}
+		return data
+	}
+
+	tests := []struct {
+		name       string
+		points     int
+		available  []bool
+		wantSignal bool
+	}{
+		{"consecutive valid points", 2, []bool{true, true, true}, true},
+		{"a dropped point breaks the run", 2, []bool{true, false, true, true}, false},
+		{"run after a missing latest point", 2, []bool{false, true, true}, true},
+		{"single point ignores older gaps", 1, []bool{true, false, true}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strategy := NewWhaleStrategy(WhaleStrategyConfig{
+				BaseConfig: StrategyConfig{
+					Name:                     entity.StrategyWhale,
+					Enabled:                  true,
+					RequireConsecutivePoints: tt.points,
+				},
+				MinRatioDifference:     70,
+				WhalePositionThreshold: 60,
+				MinDivergence:          50,
+			}, nil, nil)
+
+			signals, err := strategy.Analyze(context.Background(), newData(tt.available...))
+			if err != nil {
+				t.Fatalf("Analyze: %v", err)
+			}
+			if got := len(signals) == 1; got != tt.wantSignal {
+				t.Errorf("Analyze generated %d signals, want signal %v", len(signals), tt.wantSignal)
+			}
+		})
+	}
+}
+
+func TestWhaleStrategyWideningDivergenceSpansGaps(t *testing.T) {
+	// Retail 80% long while whales add to their short, newest first; a zero long
+	// position ratio marks a collected point without position ratios
+	newData := func(longPositions ...int64) []*entity.MarketData {
+		now := time.Now()
+		var data []*entity.MarketData
+		for i, long := range longPositions {
+			d := &entity.MarketData{
+				Symbol:            "BTCUSDT",
+				Timestamp:         now.Add(-time.Duration(i) * 5 * time.Minute),
+				LongAccountRatio:  decimal.NewFromInt(80),
+				ShortAccountRatio: decimal.NewFromInt(20),
+				Price:             decimal.NewFromInt(100),
+			}
+			if long > 0 {
+				d.LongPositionRatio = decimal.NewFromInt(long)
+				d.ShortPositionRatio = decimal.NewFromInt(100 - long)
+				d.PositionRatioAvailable = true
+			}
+			data = append(data, d)
+		}
+		return data
+	}
+
+	tests := []struct {
+		name          string
+		longPositions []int64
+		wantSignal    bool
+	}{
+		{"widening over consecutive points", []int64{25, 30, 35}, true},
+		{"narrowing over consecutive points", []int64{35, 30, 25}, false},
+		{"a dropped point breaks the trend window", []int64{25, 30, 0, 35}, false},
+		{"run after a missing latest point", []int64{0, 25, 30, 35}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strategy := NewWhaleStrategy(WhaleStrategyConfig{
+				BaseConfig: StrategyConfig{
+					Name:    entity.StrategyWhale,
+					Enabled: true,
+				},
+				MinRatioDifference:        70,
+				WhalePositionThreshold:    60,
+				MinDivergence:             50,
+				RequireWideningDivergence: true,
+			}, nil, nil)
+
+			signals, err := strategy.Analyze(context.Background(), newData(tt.longPositions...))
+			if err != nil {
+				t.Fatalf("Analyze: %v", err)
+			}
+			if got := len(signals) == 1; got != tt.wantSignal {
+				t.Errorf("Analyze generated %d signals, want signal %v", len(signals), tt.wantSignal)
+			}
+		})
+	}
+}
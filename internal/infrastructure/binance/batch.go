@@ -0,0 +1,109 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"ContractAnalysis/pkg/utils"
+
+	"go.uber.org/zap"
+)
+
+// batchRequestDelay spaces the symbols of a batch to stay clear of rate limits
+const batchRequestDelay = 100 * time.Millisecond
+
+// SymbolError records why fetching a symbol of a batch failed
+type SymbolError struct {
+	Symbol   string
+	Attempts int // Requests made, 0 if the batch was cancelled before the symbol was tried
+	Err      error
+}
+
+// Error implements the error interface
+func (e *SymbolError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Symbol, e.Err)
+}
+
+// Unwrap returns the underlying error, so errors.Is matches the client's sentinel errors
+func (e *SymbolError) Unwrap() error {
+	return e.Err
+}
+
+// MarketDataBatchResult holds the outcome of fetching market data for several symbols
+// Every requested symbol is in exactly one of Data and Failed, both in request order
+type MarketDataBatchResult struct {
+	Data   []*MarketData
+	Failed []*SymbolError
+}
+
+// Err returns the symbol errors joined, nil if every symbol was fetched
+func (r *MarketDataBatchResult) Err() error {
+	errs := make([]error, len(r.Failed))
+	for i, failed := range r.Failed {
+		errs[i] = failed
+	}
+	return errors.Join(errs...)
+}
+
+// FetchMarketDataBatch retrieves market data for multiple symbols, retrying each failed
+// symbol per the retry policy (utils.NoRetry for a single attempt)
+// Failures are reported per symbol instead of failing the batch; errors that can't succeed
+// on a retry (see IsRetryable) are not retried. Once ctx is done the remaining symbols are
+// reported as failed with ctx's error
+func (c *Client) FetchMarketDataBatch(ctx context.Context, symbols []string, retry utils.RetryPolicy) *MarketDataBatchResult {
+	return c.fetchMarketDataBatch(ctx, symbols, retry, c.GetMarketData)
+}
+
+// fetchMarketDataBatch implements FetchMarketDataBatch with fetch retrieving a single symbol
+func (c *Client) fetchMarketDataBatch(
+	ctx context.Context,
+	symbols []string,
+	retry utils.RetryPolicy,
+	fetch func(ctx context.Context, symbol string) (*MarketData, error),
+) *MarketDataBatchResult {
+	c.logger.Info("Fetching market data batch",
+		zap.Int("count", len(symbols)),
+		zap.Int("max_attempts", max(retry.MaxAttempts, 1)),
+	)
+
+	result := &MarketDataBatchResult{}
+
+	for i, symbol := range symbols {
+		if err := ctx.Err(); err != nil {
+			for _, skipped := range symbols[i:] {
+				result.Failed = append(result.Failed, &SymbolError{Symbol: skipped, Err: err})
+			}
+			break
+		}
+
+		// Small delay to avoid rate limiting
+		if i > 0 {
+			time.Sleep(batchRequestDelay)
+		}
+
+		var data *MarketData
+		attempts, err := utils.Retry(ctx, retry, IsRetryable, func(attempt int) error {
+			var fetchErr error
+			data, fetchErr = fetch(ctx, symbol)
+			return fetchErr
+		})
+		if err != nil {
+			c.logger.WithError(err).WithSymbol(symbol).Warn("Failed to fetch market data for symbol",
+				zap.Int("attempts", attempts),
+			)
+			result.Failed = append(result.Failed, &SymbolError{Symbol: symbol, Attempts: attempts, Err: err})
+			continue
+		}
+
+		result.Data = append(result.Data, data)
+	}
+
+	c.logger.Info("Fetched market data batch",
+		zap.Int("success", len(result.Data)),
+		zap.Int("failed", len(result.Failed)),
+	)
+
+	return result
+}
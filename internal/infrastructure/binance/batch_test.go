@@ -0,0 +1,90 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"ContractAnalysis/internal/infrastructure/logger"
+	"ContractAnalysis/pkg/utils"
+)
+
+func TestFetchMarketDataBatchPartialFailure(t *testing.T) {
+	c := &Client{logger: logger.WithComponent("binance-client")}
+	errTimeout := errors.New("i/o timeout")
+
+	// Per symbol, the error of each attempt; nil once they run out
+	failures := map[string][]error{
+		"ETHUSDT": {errTimeout},                                     // Recovers on the retry
+		"NEWUSDT": {fmt.Errorf("%w for symbol NEWUSDT", ErrNoData)}, // Never retried
+		"SOLUSDT": {errTimeout, errTimeout, errTimeout},             // Retries run out
+	}
+	attempts := make(map[string]int)
+	fetch := func(ctx context.Context, symbol string) (*MarketData, error) {
+		attempts[symbol]++
+		if errs := failures[symbol]; attempts[symbol] <= len(errs) {
+			return nil, errs[attempts[symbol]-1]
+		}
+		return &MarketData{Symbol: symbol}, nil
+	}
+
+	symbols := []string{"BTCUSDT", "ETHUSDT", "NEWUSDT", "SOLUSDT"}
+	result := c.fetchMarketDataBatch(context.Background(), symbols, utils.RetryPolicy{MaxAttempts: 2}, fetch)
+
+	var fetched []string
+	for _, data := range result.Data {
+		fetched = append(fetched, data.Symbol)
+	}
+	if fmt.Sprint(fetched) != "[BTCUSDT ETHUSDT]" {
+		t.Errorf("fetched %v, want [BTCUSDT ETHUSDT]", fetched)
+	}
+
+	want := []struct {
+		symbol   string
+		attempts int
+		err      error
+	}{
+		{"NEWUSDT", 1, ErrNoData},
+		{"SOLUSDT", 2, errTimeout},
+	}
+	if len(result.Failed) != len(want) {
+		t.Fatalf("failed %v, want %d symbols", result.Failed, len(want))
+	}
+	for i, w := range want {
+		failed := result.Failed[i]
+		if failed.Symbol != w.symbol || failed.Attempts != w.attempts || !errors.Is(failed.Err, w.err) {
+			t.Errorf("failed[%d] = %s after %d attempts (%v), want %s after %d (%v)",
+				i, failed.Symbol, failed.Attempts, failed.Err, w.symbol, w.attempts, w.err)
+		}
+	}
+
+	if err := result.Err(); !errors.Is(err, ErrNoData) || !errors.Is(err, errTimeout) {
+		t.Errorf("Err() = %v, want both symbol errors joined", err)
+	}
+}
+
+func TestFetchMarketDataBatchCancelled(t *testing.T) {
+	c := &Client{logger: logger.WithComponent("binance-client")}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	fetch := func(ctx context.Context, symbol string) (*MarketData, error) {
+		// The batch is cancelled while the first symbol is fetched
+		cancel()
+		return &MarketData{Symbol: symbol}, nil
+	}
+
+	result := c.fetchMarketDataBatch(ctx, []string{"BTCUSDT", "ETHUSDT", "SOLUSDT"}, utils.NoRetry, fetch)
+
+	if len(result.Data) != 1 || result.Data[0].Symbol != "BTCUSDT" {
+		t.Errorf("data = %v, want only BTCUSDT", result.Data)
+	}
+	if len(result.Failed) != 2 {
+		t.Fatalf("failed %v, want the 2 remaining symbols", result.Failed)
+	}
+	for _, failed := range result.Failed {
+		if failed.Attempts != 0 || !errors.Is(failed.Err, context.Canceled) {
+			t.Errorf("%s: %d attempts (%v), want skipped with context.Canceled", failed.Symbol, failed.Attempts, failed.Err)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package binance
+
+import (
+	"net/http"
+
+	"ContractAnalysis/pkg/utils"
+)
+
+// breakerTransport guards every request to Binance, both through the SDK clients and the
+// raw /futures/data calls, with a circuit breaker so an outage fails fast instead of
+// being retried across every symbol
+type breakerTransport struct {
+	base    http.RoundTripper
+	breaker *utils.CircuitBreaker
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *breakerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.breaker.Allow(); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	switch {
+	case err != nil && req.Context().Err() != nil:
+		// Canceled or timed out by the caller, says nothing about Binance
+		t.breaker.RecordAborted()
+	case err != nil || isOutageStatus(resp.StatusCode):
+		t.breaker.RecordFailure()
+	default:
+		t.breaker.RecordSuccess()
+	}
+
+	return resp, err
+}
+
+// isOutageStatus reports whether a response status means Binance is unavailable or
+// throttling us, as opposed to rejecting a single bad request
+func isOutageStatus(statusCode int) bool {
+	return statusCode >= http.StatusInternalServerError ||
+		statusCode == http.StatusTooManyRequests ||
+		statusCode == http.StatusTeapot
+}
@@ -0,0 +1,129 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"ContractAnalysis/pkg/utils"
+)
+
+// stubTransport answers every request with the next queued status, or err when set
+type stubTransport struct {
+	statuses []int
+	err      error
+	calls    int
+}
+
+func (t *stubTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls++
+	if t.err != nil {
+		return nil, t.err
+	}
+	status := t.statuses[0]
+	t.statuses = t.statuses[1:]
+	return &http.Response{StatusCode: status, Body: http.NoBody, Request: req}, nil
+}
+
+func TestBreakerTransport(t *testing.T) {
+	const cooldown = 30 * time.Second
+
+	tests := []struct {
+		name      string
+		statuses  []int         // Responses of the consecutive requests
+		err       error         // Transport error of every request, instead of statuses
+		cancelled bool          // Requests are cancelled by the caller
+		wait      time.Duration // Clock advance before the final request
+		wantState utils.CircuitState
+		wantCalls int // Requests that reached the base transport
+	}{
+		{
+			name:      "server errors open the breaker and fail fast",
+			statuses:  []int{500, 502, 503},
+			wantState: utils.CircuitOpen,
+			wantCalls: 3,
+		},
+		{
+			name:      "throttling counts as an outage",
+			statuses:  []int{429, 418, 429},
+			wantState: utils.CircuitOpen,
+			wantCalls: 3,
+		},
+		{
+			name:      "client errors keep it closed",
+			statuses:  []int{400, 404, 400, 200},
+			wantState: utils.CircuitClosed,
+			wantCalls: 4,
+		},
+		{
+			name:      "transport errors open the breaker",
+			err:       errors.New("connection refused"),
+			wantState: utils.CircuitOpen,
+			wantCalls: 3,
+		},
+		{
+			name:      "requests cancelled by the caller are ignored",
+			err:       context.Canceled,
+			cancelled: true,
+			wantState: utils.CircuitClosed,
+			wantCalls: 4,
+		},
+		{
+			name:      "a successful probe after the cooldown closes it",
+			statuses:  []int{500, 500, 500, 200},
+			wait:      cooldown,
+			wantState: utils.CircuitClosed,
+			wantCalls: 4,
+		},
+		{
+			name:      "a failed probe reopens it",
+			statuses:  []int{500, 500, 500, 503},
+			wait:      cooldown,
+			wantState: utils.CircuitOpen,
+			wantCalls: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+			base := &stubTransport{statuses: append([]int(nil), tt.statuses...), err: tt.err}
+			transport := &breakerTransport{
+				base:    base,
+				breaker: utils.NewCircuitBreaker(3, cooldown, nil).WithClock(func() time.Time { return now }),
+			}
+
+			send := func() error {
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+				if tt.cancelled {
+					cancel()
+				}
+				req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://fapi.binance.com/fapi/v1/ping", nil)
+				resp, err := transport.RoundTrip(req)
+				if resp != nil {
+					resp.Body.Close()
+				}
+				return err
+			}
+
+			for range 3 {
+				_ = send()
+			}
+			now = now.Add(tt.wait)
+			err := send()
+
+			if tt.wait == 0 && tt.wantState == utils.CircuitOpen && !errors.Is(err, utils.ErrCircuitOpen) {
+				t.Errorf("request on an open breaker = %v, want ErrCircuitOpen", err)
+			}
+			if got := transport.breaker.State(); got != tt.wantState {
+				t.Errorf("state = %s, want %s", got, tt.wantState)
+			}
+			if base.calls != tt.wantCalls {
+				t.Errorf("base transport got %d requests, want %d", base.calls, tt.wantCalls)
+			}
+		})
+	}
+}
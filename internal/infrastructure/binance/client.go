@@ -4,40 +4,53 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"time"
 
 	"ContractAnalysis/config"
 	"ContractAnalysis/internal/domain/entity"
 	"ContractAnalysis/internal/infrastructure/logger"
+	"ContractAnalysis/pkg/utils"
 
+	"github.com/adshao/go-binance/v2/delivery"
 	"github.com/adshao/go-binance/v2/futures"
 	"github.com/shopspring/decimal"
 	"go.uber.org/zap"
 )
 
 // Client wraps the Binance Futures API client
+// USDT-M symbols are served by the fapi endpoints and COIN-M symbols
+// (e.g. BTCUSD_PERP) by the dapi endpoints
 type Client struct {
-	client     *futures.Client
-	httpClient *http.Client
-	baseURL    string
-	apiKey     string
-	apiSecret  string
-	timeout    time.Duration
-	logger     *logger.Logger
+	client         *futures.Client
+	deliveryClient *delivery.Client
+	httpClient     *http.Client
+	baseURL        string
+	coinMBaseURL   string
+	apiKey         string
+	apiSecret      string
+	timeout        time.Duration
+	precision      precisionCache
+	breaker        *utils.CircuitBreaker // Nil when the circuit breaker is disabled
+	logger         *logger.Logger
 }
 
 // NewClient creates a new Binance API client
 func NewClient(cfg config.BinanceConfig) (*Client, error) {
+	// Resolve the REST base URL once so the SDK client and the raw
+	// /futures/data endpoints always target the same network
+	baseURL := cfg.BaseURL()
+
 	// Create Binance futures client
+	futures.UseTestnet = cfg.UseTestnet
 	futuresClient := futures.NewClient(cfg.APIKey, cfg.APISecret)
+	futuresClient.BaseURL = baseURL
 
-	// Set base URL if custom
-	if cfg.APIURL != "" && cfg.APIURL != "https://fapi.binance.com" {
-		futures.UseTestnet = false
-		futuresClient.BaseURL = cfg.APIURL
-	}
+	// Create Binance COIN-M (delivery) client
+	coinMBaseURL := cfg.CoinMBaseURL()
+	delivery.UseTestnet = cfg.UseTestnet
+	deliveryClient := delivery.NewClient(cfg.APIKey, cfg.APISecret)
+	deliveryClient.BaseURL = coinMBaseURL
 
 	// Create HTTP client with timeout
 	httpClient := &http.Client{
@@ -45,25 +58,62 @@ func NewClient(cfg config.BinanceConfig) (*Client, error) {
 	}
 
 	client := &Client{
-		client:     futuresClient,
-		httpClient: httpClient,
-		baseURL:    cfg.APIURL,
-		apiKey:     cfg.APIKey,
-		apiSecret:  cfg.APISecret,
-		timeout:    cfg.Timeout,
-		logger:     logger.WithComponent("binance-client"),
-	}
+		client:         futuresClient,
+		deliveryClient: deliveryClient,
+		httpClient:     httpClient,
+		baseURL:        baseURL,
+		coinMBaseURL:   coinMBaseURL,
+		apiKey:         cfg.APIKey,
+		apiSecret:      cfg.APISecret,
+		timeout:        cfg.Timeout,
+		logger:         logger.WithComponent("binance-client"),
+	}
+
+	// Route the SDK clients and the raw HTTP client through one shared circuit breaker
+	if cfg.CircuitBreaker.Enabled {
+		client.breaker = utils.NewCircuitBreaker(cfg.CircuitBreaker.FailureThreshold, cfg.CircuitBreaker.Cooldown, client.logBreakerTransition)
+		transport := &breakerTransport{base: http.DefaultTransport, breaker: client.breaker}
+
+		httpClient.Transport = transport
+		futuresClient.HTTPClient = &http.Client{Transport: transport}
+		deliveryClient.HTTPClient = &http.Client{Transport: transport}
+	}
+
+	client.logger.Info("Binance client configured",
+		zap.String("base_url", baseURL),
+		zap.String("coin_m_base_url", coinMBaseURL),
+		zap.Bool("testnet", cfg.UseTestnet),
+	)
 
 	return client, nil
 }
 
+// CircuitBreaker returns the breaker guarding Binance requests, nil when it is disabled
+func (c *Client) CircuitBreaker() *utils.CircuitBreaker {
+	return c.breaker
+}
+
+// logBreakerTransition logs circuit breaker state changes
+func (c *Client) logBreakerTransition(from, to utils.CircuitState) {
+	fields := []zap.Field{
+		zap.String("from", string(from)),
+		zap.String("to", string(to)),
+	}
+
+	if to == utils.CircuitOpen {
+		c.logger.Warn("Binance circuit breaker opened, failing requests fast", fields...)
+		return
+	}
+	c.logger.Info("Binance circuit breaker state changed", fields...)
+}
+
 // GetAllUSDTFuturesPairs retrieves all USDT-margined futures trading pairs
 func (c *Client) GetAllUSDTFuturesPairs(ctx context.Context) ([]string, error) {
 	c.logger.Info("Fetching all USDT futures pairs")
 
 	exchangeInfo, err := c.client.NewExchangeInfoService().Do(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get exchange info: %w", err)
+		return nil, fmt.Errorf("failed to get exchange info: %w", wrapSDKError(err))
 	}
 
 	var usdtPairs []string
@@ -82,7 +132,7 @@ func (c *Client) GetAllUSDTFuturesPairs(ctx context.Context) ([]string, error) {
 
 // GetGlobalLongShortRatio retrieves global long/short account ratio
 func (c *Client) GetGlobalLongShortRatio(ctx context.Context, symbol string, period string) (*GlobalLongShortAccountRatio, error) {
-	endpoint := fmt.Sprintf("%s/futures/data/globalLongShortAccountRatio", c.baseURL)
+	endpoint := c.futuresDataEndpoint(symbol, "globalLongShortAccountRatio")
 
 	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
 	if err != nil {
@@ -90,7 +140,7 @@ func (c *Client) GetGlobalLongShortRatio(ctx context.Context, symbol string, per
 	}
 
 	q := req.URL.Query()
-	q.Add("symbol", symbol)
+	c.addFuturesDataSymbol(q, symbol)
 	q.Add("period", period) // 5m, 15m, 30m, 1h, 2h, 4h, 6h, 12h, 1d
 	q.Add("limit", "1")     // Get only the latest
 	req.URL.RawQuery = q.Encode()
@@ -102,8 +152,7 @@ func (c *Client) GetGlobalLongShortRatio(ctx context.Context, symbol string, per
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+		return nil, newStatusError(resp)
 	}
 
 	var ratios []GlobalLongShortAccountRatio
@@ -112,7 +161,7 @@ func (c *Client) GetGlobalLongShortRatio(ctx context.Context, symbol string, per
 	}
 
 	if len(ratios) == 0 {
-		return nil, fmt.Errorf("no data returned for symbol %s", symbol)
+		return nil, fmt.Errorf("%w for symbol %s", ErrNoData, symbol)
 	}
 
 	return &ratios[0], nil
@@ -120,7 +169,7 @@ func (c *Client) GetGlobalLongShortRatio(ctx context.Context, symbol string, per
 
 // GetTopLongShortPositionRatio retrieves top trader long/short position ratio
 func (c *Client) GetTopLongShortPositionRatio(ctx context.Context, symbol string, period string) (*TopLongShortPositionRatio, error) {
-	endpoint := fmt.Sprintf("%s/futures/data/topLongShortPositionRatio", c.baseURL)
+	endpoint := c.futuresDataEndpoint(symbol, "topLongShortPositionRatio")
 
 	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
 	if err != nil {
@@ -128,7 +177,7 @@ func (c *Client) GetTopLongShortPositionRatio(ctx context.Context, symbol string
 	}
 
 	q := req.URL.Query()
-	q.Add("symbol", symbol)
+	c.addFuturesDataSymbol(q, symbol)
 	q.Add("period", period)
 	q.Add("limit", "1")
 	req.URL.RawQuery = q.Encode()
@@ -140,8 +189,7 @@ func (c *Client) GetTopLongShortPositionRatio(ctx context.Context, symbol string
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+		return nil, newStatusError(resp)
 	}
 
 	var ratios []TopLongShortPositionRatio
@@ -150,7 +198,48 @@ func (c *Client) GetTopLongShortPositionRatio(ctx context.Context, symbol string
 	}
 
 	if len(ratios) == 0 {
-		return nil, fmt.Errorf("no data returned for symbol %s", symbol)
+		return nil, fmt.Errorf("%w for symbol %s", ErrNoData, symbol)
+	}
+
+	return &ratios[0], nil
+}
+
+// GetTakerLongShortRatio retrieves the taker buy/sell volume ratio
+func (c *Client) GetTakerLongShortRatio(ctx context.Context, symbol string, period string) (*TakerLongShortRatio, error) {
+	if IsCoinMSymbol(symbol) {
+		return c.getCoinMTakerBuySellRatio(ctx, symbol, period)
+	}
+
+	endpoint := c.futuresDataEndpoint(symbol, "takerlongshortRatio")
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	q := req.URL.Query()
+	c.addFuturesDataSymbol(q, symbol)
+	q.Add("period", period)
+	q.Add("limit", "1")
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newStatusError(resp)
+	}
+
+	var ratios []TakerLongShortRatio
+	if err := json.NewDecoder(resp.Body).Decode(&ratios); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if len(ratios) == 0 {
+		return nil, fmt.Errorf("%w for symbol %s", ErrNoData, symbol)
 	}
 
 	return &ratios[0], nil
@@ -158,7 +247,7 @@ func (c *Client) GetTopLongShortPositionRatio(ctx context.Context, symbol string
 
 // GetTopLongShortAccountRatio retrieves top trader long/short account ratio
 func (c *Client) GetTopLongShortAccountRatio(ctx context.Context, symbol string, period string) (*TopLongShortAccountRatio, error) {
-	endpoint := fmt.Sprintf("%s/futures/data/topLongShortAccountRatio", c.baseURL)
+	endpoint := c.futuresDataEndpoint(symbol, "topLongShortAccountRatio")
 
 	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
 	if err != nil {
@@ -166,7 +255,7 @@ func (c *Client) GetTopLongShortAccountRatio(ctx context.Context, symbol string,
 	}
 
 	q := req.URL.Query()
-	q.Add("symbol", symbol)
+	c.addFuturesDataSymbol(q, symbol)
 	q.Add("period", period)
 	q.Add("limit", "1")
 	req.URL.RawQuery = q.Encode()
@@ -178,8 +267,7 @@ func (c *Client) GetTopLongShortAccountRatio(ctx context.Context, symbol string,
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+		return nil, newStatusError(resp)
 	}
 
 	var ratios []TopLongShortAccountRatio
@@ -188,7 +276,7 @@ func (c *Client) GetTopLongShortAccountRatio(ctx context.Context, symbol string,
 	}
 
 	if len(ratios) == 0 {
-		return nil, fmt.Errorf("no data returned for symbol %s", symbol)
+		return nil, fmt.Errorf("%w for symbol %s", ErrNoData, symbol)
 	}
 
 	return &ratios[0], nil
@@ -196,7 +284,7 @@ func (c *Client) GetTopLongShortAccountRatio(ctx context.Context, symbol string,
 
 // GetOpenInterest retrieves open interest for a symbol
 func (c *Client) GetOpenInterest(ctx context.Context, symbol string) (*OpenInterest, error) {
-	endpoint := fmt.Sprintf("%s/futures/data/openInterestHist", c.baseURL)
+	endpoint := c.futuresDataEndpoint(symbol, "openInterestHist")
 
 	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
 	if err != nil {
@@ -204,7 +292,10 @@ func (c *Client) GetOpenInterest(ctx context.Context, symbol string) (*OpenInter
 	}
 
 	q := req.URL.Query()
-	q.Add("symbol", symbol)
+	c.addFuturesDataSymbol(q, symbol)
+	if IsCoinMSymbol(symbol) {
+		q.Add("contractType", coinMContractType)
+	}
 	q.Add("period", "5m") // Get latest 5m period
 	q.Add("limit", "1")
 	req.URL.RawQuery = q.Encode()
@@ -216,8 +307,7 @@ func (c *Client) GetOpenInterest(ctx context.Context, symbol string) (*OpenInter
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+		return nil, newStatusError(resp)
 	}
 
 	var interests []OpenInterest
@@ -226,7 +316,7 @@ func (c *Client) GetOpenInterest(ctx context.Context, symbol string) (*OpenInter
 	}
 
 	if len(interests) == 0 {
-		return nil, fmt.Errorf("no open interest data for symbol %s", symbol)
+		return nil, fmt.Errorf("no open interest data for symbol %s: %w", symbol, ErrNoData)
 	}
 
 	return &interests[0], nil
@@ -234,6 +324,10 @@ func (c *Client) GetOpenInterest(ctx context.Context, symbol string) (*OpenInter
 
 // GetFundingRate retrieves the current funding rate for a symbol
 func (c *Client) GetFundingRate(ctx context.Context, symbol string) (*FundingRate, error) {
+	if IsCoinMSymbol(symbol) {
+		return c.getCoinMFundingRate(ctx, symbol)
+	}
+
 	endpoint := fmt.Sprintf("%s/fapi/v1/premiumIndex", c.baseURL)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
@@ -252,8 +346,7 @@ func (c *Client) GetFundingRate(ctx context.Context, symbol string) (*FundingRat
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+		return nil, newStatusError(resp)
 	}
 
 	var fundingRate FundingRate
@@ -266,13 +359,17 @@ func (c *Client) GetFundingRate(ctx context.Context, symbol string) (*FundingRat
 
 // GetPrice retrieves the current price for a symbol
 func (c *Client) GetPrice(ctx context.Context, symbol string) (float64, error) {
+	if IsCoinMSymbol(symbol) {
+		return c.getCoinMPrice(ctx, symbol)
+	}
+
 	prices, err := c.client.NewListPricesService().Symbol(symbol).Do(ctx)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get price: %w", err)
+		return 0, fmt.Errorf("failed to get price: %w", wrapSDKError(err))
 	}
 
 	if len(prices) == 0 {
-		return 0, fmt.Errorf("no price data for symbol %s", symbol)
+		return 0, fmt.Errorf("no price data for symbol %s: %w", symbol, ErrNoData)
 	}
 
 	price := 0.0
@@ -281,15 +378,58 @@ func (c *Client) GetPrice(ctx context.Context, symbol string) (float64, error) {
 	return price, nil
 }
 
+// GetMarkPrice retrieves the current mark price for a symbol
+func (c *Client) GetMarkPrice(ctx context.Context, symbol string) (float64, error) {
+	if IsCoinMSymbol(symbol) {
+		return c.getCoinMMarkPrice(ctx, symbol)
+	}
+
+	endpoint := fmt.Sprintf("%s/fapi/v1/premiumIndex", c.baseURL)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	q := req.URL.Query()
+	q.Add("symbol", symbol)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, newStatusError(resp)
+	}
+
+	var index PremiumIndex
+	if err := json.NewDecoder(resp.Body).Decode(&index); err != nil {
+		return 0, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if index.MarkPrice <= 0 {
+		return 0, fmt.Errorf("no mark price for symbol %s: %w", symbol, ErrNoData)
+	}
+
+	return index.MarkPrice, nil
+}
+
 // Get24hrTicker retrieves 24-hour ticker statistics
 func (c *Client) Get24hrTicker(ctx context.Context, symbol string) (*Ticker24hr, error) {
+	if IsCoinMSymbol(symbol) {
+		return c.getCoinM24hrTicker(ctx, symbol)
+	}
+
 	tickers, err := c.client.NewListPriceChangeStatsService().Symbol(symbol).Do(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get 24hr ticker: %w", err)
+		return nil, fmt.Errorf("failed to get 24hr ticker: %w", wrapSDKError(err))
 	}
 
 	if len(tickers) == 0 {
-		return nil, fmt.Errorf("no ticker data for symbol %s", symbol)
+		return nil, fmt.Errorf("no ticker data for symbol %s: %w", symbol, ErrNoData)
 	}
 
 	ticker := tickers[0]
@@ -360,16 +500,35 @@ func (c *Client) GetMarketData(ctx context.Context, symbol string) (*MarketData,
 		// Wait, user requirement says "Open Interest, OI".
 		// binance API: sumOpenInterest (coins), sumOpenInterestValue (USDT).
 		// Let's use USDT value as it's more standard across pairs.
+
+		// COIN-M reports the value in the base coin; convert to USD so it's comparable to USDT-M
+		if IsCoinMSymbol(symbol) {
+			openInterest = oi.Value * ticker.LastPrice
+		}
 	}
 
 	// Fetch funding rate
 	var fundingRate float64
+	var nextFundingTime time.Time
 	fr, err := c.GetFundingRate(ctx, symbol)
 	if err != nil {
 		c.logger.Debug("Funding rate not available", zap.String("symbol", symbol), zap.Error(err))
 		fundingRate = 0
 	} else {
 		fundingRate = fr.FundingRate
+		if fr.NextFundingTime > 0 {
+			nextFundingTime = time.UnixMilli(fr.NextFundingTime).UTC()
+		}
+	}
+
+	// Fetch taker buy/sell volume ratio (optional - some pairs may not have this data)
+	var takerBuySellRatio float64
+	takerRatio, err := c.GetTakerLongShortRatio(ctx, symbol, "5m")
+	if err != nil {
+		c.logger.Debug("Taker buy/sell ratio not available", zap.String("symbol", symbol), zap.Error(err))
+		takerBuySellRatio = 0
+	} else {
+		takerBuySellRatio = takerRatio.BuySellRatio
 	}
 
 	// Convert account ratios from 0-1 to percentages 0-100
@@ -395,6 +554,8 @@ func (c *Client) GetMarketData(ctx context.Context, symbol string) (*MarketData,
 		Volume24h:              ticker.QuoteVolume,
 		OpenInterest:           openInterest,
 		FundingRate:            fundingRate,
+		NextFundingTime:        nextFundingTime,
+		TakerBuySellRatio:      takerBuySellRatio,
 	}
 
 	c.logger.Debug("Fetched market data successfully",
@@ -404,41 +565,20 @@ func (c *Client) GetMarketData(ctx context.Context, symbol string) (*MarketData,
 	return marketData, nil
 }
 
-// GetMarketDataBatch retrieves market data for multiple symbols
+// GetMarketDataBatch retrieves market data for multiple symbols, dropping the symbols that fail
+// It returns an error only if every symbol failed; use FetchMarketDataBatch for the
+// per-symbol errors and retries
 func (c *Client) GetMarketDataBatch(ctx context.Context, symbols []string) ([]*MarketData, error) {
-	c.logger.Info("Fetching market data batch",
-		zap.Int("count", len(symbols)),
-	)
-
-	var results []*MarketData
-	var errors []error
-
-	for _, symbol := range symbols {
-		data, err := c.GetMarketData(ctx, symbol)
-		if err != nil {
-			c.logger.WithError(err).WithSymbol(symbol).Warn("Failed to fetch market data for symbol")
-			errors = append(errors, fmt.Errorf("%s: %w", symbol, err))
-			continue
-		}
-
-		results = append(results, data)
-
-		// Small delay to avoid rate limiting
-		time.Sleep(100 * time.Millisecond)
+	result := c.FetchMarketDataBatch(ctx, symbols, utils.NoRetry)
+	if len(result.Data) == 0 && len(result.Failed) > 0 {
+		return nil, fmt.Errorf("failed to fetch data for all symbols: %w", result.Err())
 	}
-
-	if len(results) == 0 {
-		return nil, fmt.Errorf("failed to fetch data for all symbols: %v", errors)
-	}
-
-	c.logger.Info("Fetched market data batch",
-		zap.Int("success", len(results)),
-		zap.Int("failed", len(errors)),
-	)
-
-	return results, nil
+	return result.Data, nil
 }
 
+// MaxKlinesPerRequest is the maximum number of klines Binance returns for one request
+const MaxKlinesPerRequest = 1000
+
 // GetKlines retrieves kline/candlestick data for a symbol
 // interval: "1m", "5m", "15m", "1h", "4h", "1d", etc.
 // limit: maximum 1000 (default 500)
@@ -450,10 +590,14 @@ func (c *Client) GetKlines(ctx context.Context, symbol string, interval string,
 	)
 
 	// Validate limit
-	if limit <= 0 || limit > 1000 {
+	if limit <= 0 || limit > MaxKlinesPerRequest {
 		limit = 500
 	}
 
+	if IsCoinMSymbol(symbol) {
+		return c.getCoinMKlines(ctx, symbol, interval, limit, nil)
+	}
+
 	klines, err := c.client.NewKlinesService().
 		Symbol(symbol).
 		Interval(interval).
@@ -461,14 +605,20 @@ func (c *Client) GetKlines(ctx context.Context, symbol string, interval string,
 		Do(ctx)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to get klines: %w", err)
+		return nil, fmt.Errorf("failed to get klines: %w", wrapSDKError(err))
 	}
 
-	// Convert to entity.Kline
-	result := make([]*entity.Kline, len(klines))
-	for i, k := range klines {
-		kline := convertToKline(k)
-		result[i] = kline
+	// Convert to entity.Kline, dropping malformed klines rather than emitting zero prices
+	result := make([]*entity.Kline, 0, len(klines))
+	for _, k := range klines {
+		kline, err := convertToKline(k)
+		if err != nil {
+			c.logger.WithError(err).Warn("Skipping malformed kline",
+				zap.String("symbol", symbol),
+				zap.Int64("open_time", k.OpenTime))
+			continue
+		}
+		result = append(result, kline)
 	}
 
 	c.logger.Debug("Fetched klines successfully",
@@ -487,22 +637,32 @@ func (c *Client) GetKlinesSince(ctx context.Context, symbol string, interval str
 		zap.Time("start_time", startTime),
 	)
 
+	if IsCoinMSymbol(symbol) {
+		return c.getCoinMKlines(ctx, symbol, interval, MaxKlinesPerRequest, &startTime)
+	}
+
 	klines, err := c.client.NewKlinesService().
 		Symbol(symbol).
 		Interval(interval).
 		StartTime(startTime.UnixMilli()).
-		Limit(1000). // Max limit
+		Limit(MaxKlinesPerRequest).
 		Do(ctx)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to get klines since %v: %w", startTime, err)
+		return nil, fmt.Errorf("failed to get klines since %v: %w", startTime, wrapSDKError(err))
 	}
 
-	// Convert to entity.Kline
-	result := make([]*entity.Kline, len(klines))
-	for i, k := range klines {
-		kline := convertToKline(k)
-		result[i] = kline
+	// Convert to entity.Kline, dropping malformed klines rather than emitting zero prices
+	result := make([]*entity.Kline, 0, len(klines))
+	for _, k := range klines {
+		kline, err := convertToKline(k)
+		if err != nil {
+			c.logger.WithError(err).Warn("Skipping malformed kline",
+				zap.String("symbol", symbol),
+				zap.Int64("open_time", k.OpenTime))
+			continue
+		}
+		result = append(result, kline)
 	}
 
 	c.logger.Debug("Fetched klines since successfully",
@@ -514,23 +674,46 @@ func (c *Client) GetKlinesSince(ctx context.Context, symbol string, interval str
 }
 
 // convertToKline converts a Binance API kline to entity.Kline
-func convertToKline(k *futures.Kline) *entity.Kline {
-	// Parse decimal values
-	open, _ := decimal.NewFromString(k.Open)
-	high, _ := decimal.NewFromString(k.High)
-	low, _ := decimal.NewFromString(k.Low)
-	close, _ := decimal.NewFromString(k.Close)
-	volume, _ := decimal.NewFromString(k.Volume)
-	quoteVolume, _ := decimal.NewFromString(k.QuoteAssetVolume)
+func convertToKline(k *futures.Kline) (*entity.Kline, error) {
+	return newKline(k.OpenTime, k.CloseTime, k.Open, k.High, k.Low, k.Close, k.Volume, k.QuoteAssetVolume)
+}
 
-	return &entity.Kline{
-		OpenTime:    time.Unix(0, k.OpenTime*int64(time.Millisecond)),
-		CloseTime:   time.Unix(0, k.CloseTime*int64(time.Millisecond)),
-		Open:        open,
-		High:        high,
-		Low:         low,
-		Close:       close,
-		Volume:      volume,
-		QuoteVolume: quoteVolume,
+// newKline builds an entity.Kline from the raw fields of a Binance kline
+// Returns an error if a value can't be parsed or a price isn't positive, since a zero
+// price would produce absurd change percentages downstream
+func newKline(openTime, closeTime int64, open, high, low, close, volume, quoteVolume string) (*entity.Kline, error) {
+	prices := make([]decimal.Decimal, 4)
+	for i, field := range []struct {
+		name  string
+		value string
+	}{{"open", open}, {"high", high}, {"low", low}, {"close", close}} {
+		price, err := decimal.NewFromString(field.value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid kline %s price %q: %w", field.name, field.value, err)
+		}
+		if !price.IsPositive() {
+			return nil, fmt.Errorf("invalid kline %s price %q: must be positive", field.name, field.value)
+		}
+		prices[i] = price
 	}
+
+	vol, err := decimal.NewFromString(volume)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kline volume %q: %w", volume, err)
+	}
+	quoteVol, err := decimal.NewFromString(quoteVolume)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kline quote volume %q: %w", quoteVolume, err)
+	}
+
+	return &entity.Kline{
+		OpenTime:    time.Unix(0, openTime*int64(time.Millisecond)),
+		CloseTime:   time.Unix(0, closeTime*int64(time.Millisecond)),
+		Open:        prices[0],
+		High:        prices[1],
+		Low:         prices[2],
+		Close:       prices[3],
+		Volume:      vol,
+		QuoteVolume: quoteVol,
+	}, nil
 }
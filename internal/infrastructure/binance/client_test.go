@@ -0,0 +1,58 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/delivery"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+func TestConvertKlineRejectsInvalidPrices(t *testing.T) {
+	tests := []struct {
+		name  string
+		open  string
+		close string
+	}{
+		{"unparsable open", "abc", "100"},
+		{"empty open", "", "100"},
+		{"zero open", "0", "100"},
+		{"negative close", "100", "-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usdtM := &futures.Kline{Open: tt.open, High: "110", Low: "90", Close: tt.close, Volume: "1", QuoteAssetVolume: "100"}
+			if k, err := convertToKline(usdtM); err == nil {
+				t.Errorf("convertToKline = %+v, want an error", k)
+			}
+
+			coinM := &delivery.Kline{Open: tt.open, High: "110", Low: "90", Close: tt.close, Volume: "1", QuoteAssetVolume: "100"}
+			if k, err := convertDeliveryKline(coinM); err == nil {
+				t.Errorf("convertDeliveryKline = %+v, want an error", k)
+			}
+		})
+	}
+}
+
+func TestConvertKline(t *testing.T) {
+	k, err := convertToKline(&futures.Kline{
+		OpenTime:         1_700_000_000_000,
+		CloseTime:        1_700_003_599_999,
+		Open:             "100.5",
+		High:             "110",
+		Low:              "90",
+		Close:            "105.25",
+		Volume:           "12",
+		QuoteAssetVolume: "1260",
+	})
+	if err != nil {
+		t.Fatalf("convertToKline: %v", err)
+	}
+
+	if k.Open.String() != "100.5" || k.Close.String() != "105.25" || k.QuoteVolume.String() != "1260" {
+		t.Errorf("kline = %+v, want the parsed prices and volumes", k)
+	}
+	if got := k.OpenTime.UnixMilli(); got != 1_700_000_000_000 {
+		t.Errorf("OpenTime = %d ms, want 1700000000000", got)
+	}
+}
@@ -0,0 +1,74 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ContractAnalysis/pkg/utils"
+
+	"go.uber.org/zap"
+)
+
+// GetServerTime retrieves the current Binance server time
+func (c *Client) GetServerTime(ctx context.Context) (time.Time, error) {
+	serverTime, err := c.client.NewServerTimeService().Do(ctx)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get server time: %w", wrapSDKError(err))
+	}
+	return time.UnixMilli(serverTime), nil
+}
+
+// SyncServerTime measures how far the Binance server clock is ahead of the local clock and
+// applies the offset to signed request timestamps and to market data timestamp validation
+// A warning is logged when the offset exceeds maxSkew
+func (c *Client) SyncServerTime(ctx context.Context, maxSkew time.Duration) (time.Duration, error) {
+	start := time.Now()
+	serverTime, err := c.GetServerTime(ctx)
+	if err != nil {
+		return 0, err
+	}
+	roundTrip := time.Since(start)
+
+	// Assume the server read its clock halfway through the round trip
+	offset := serverTime.Sub(start.Add(roundTrip / 2))
+
+	// The SDK clients subtract TimeOffset (local minus server, in ms) from the timestamp
+	// of signed requests, and only read it for those
+	c.client.TimeOffset = -offset.Milliseconds()
+	c.deliveryClient.TimeOffset = -offset.Milliseconds()
+	utils.SetServerClockOffset(offset)
+
+	fields := []zap.Field{
+		zap.Duration("offset", offset),
+		zap.Duration("round_trip", roundTrip),
+		zap.Duration("max_skew", maxSkew),
+	}
+
+	if offset.Abs() > maxSkew {
+		c.logger.Warn("Local clock is skewed from the Binance server clock, check the host's time sync (NTP)", fields...)
+	} else {
+		c.logger.Debug("Synced with Binance server time", fields...)
+	}
+
+	return offset, nil
+}
+
+// RunClockSync re-syncs with the Binance server time every interval until ctx is done
+func (c *Client) RunClockSync(ctx context.Context, interval, maxSkew time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			syncCtx, cancel := context.WithTimeout(ctx, c.timeout)
+			if _, err := c.SyncServerTime(syncCtx, maxSkew); err != nil {
+				c.logger.WithError(err).Warn("Failed to sync with Binance server time, keeping the last offset")
+			}
+			cancel()
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+func TestGetServerTimeWrapsAPIErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"code":-1003,"msg":"Too many requests"}`))
+	}))
+	defer server.Close()
+
+	futuresClient := futures.NewClient("", "")
+	futuresClient.BaseURL = server.URL
+	c := &Client{client: futuresClient}
+
+	_, err := c.GetServerTime(context.Background())
+	if !errors.Is(err, ErrRateLimited) || !errors.Is(err, ErrBinanceAPI) {
+		t.Errorf("GetServerTime = %v, want an API error matching ErrRateLimited", err)
+	}
+}
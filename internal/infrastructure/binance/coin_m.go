@@ -0,0 +1,308 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"ContractAnalysis/config"
+	"ContractAnalysis/internal/domain/entity"
+
+	"github.com/adshao/go-binance/v2/delivery"
+	"go.uber.org/zap"
+)
+
+// coinMPerpetualSuffix identifies COIN-M perpetual symbols (e.g. BTCUSD_PERP)
+const coinMPerpetualSuffix = "_PERP"
+
+// coinMContractType is the contract type collected for COIN-M pairs
+const coinMContractType = "PERPETUAL"
+
+// IsCoinMSymbol checks if a symbol is a COIN-M perpetual contract
+func IsCoinMSymbol(symbol string) bool {
+	return strings.HasSuffix(symbol, coinMPerpetualSuffix)
+}
+
+// coinMPair returns the underlying pair of a COIN-M symbol (BTCUSD_PERP -> BTCUSD)
+func coinMPair(symbol string) string {
+	return strings.TrimSuffix(symbol, coinMPerpetualSuffix)
+}
+
+// futuresDataEndpoint builds the /futures/data endpoint URL for a symbol
+// USDT-M data lives under fapi and COIN-M data under dapi
+func (c *Client) futuresDataEndpoint(symbol, path string) string {
+	if IsCoinMSymbol(symbol) {
+		return fmt.Sprintf("%s/futures/data/%s", c.coinMBaseURL, path)
+	}
+	return fmt.Sprintf("%s/futures/data/%s", c.baseURL, path)
+}
+
+// addFuturesDataSymbol adds the symbol query parameter for /futures/data endpoints
+// COIN-M endpoints are queried by pair instead of symbol
+func (c *Client) addFuturesDataSymbol(q url.Values, symbol string) {
+	if IsCoinMSymbol(symbol) {
+		q.Add("pair", coinMPair(symbol))
+		return
+	}
+	q.Add("symbol", symbol)
+}
+
+// GetFuturesPairs retrieves the tradable futures pairs for the given margin mode
+func (c *Client) GetFuturesPairs(ctx context.Context, marginMode string) ([]string, error) {
+	switch marginMode {
+	case config.MarginModeCoin:
+		return c.GetAllCoinMFuturesPairs(ctx)
+	case config.MarginModeBoth:
+		usdtPairs, err := c.GetAllUSDTFuturesPairs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		coinPairs, err := c.GetAllCoinMFuturesPairs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return append(usdtPairs, coinPairs...), nil
+	default:
+		return c.GetAllUSDTFuturesPairs(ctx)
+	}
+}
+
+// GetAllCoinMFuturesPairs retrieves all coin-margined perpetual futures pairs
+func (c *Client) GetAllCoinMFuturesPairs(ctx context.Context) ([]string, error) {
+	c.logger.Info("Fetching all COIN-M futures pairs")
+
+	exchangeInfo, err := c.deliveryClient.NewExchangeInfoService().Do(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get COIN-M exchange info: %w", wrapSDKError(err))
+	}
+
+	var coinPairs []string
+	for _, symbol := range exchangeInfo.Symbols {
+		if symbol.ContractType == coinMContractType && symbol.ContractStatus == "TRADING" {
+			coinPairs = append(coinPairs, symbol.Symbol)
+		}
+	}
+
+	c.logger.Info("Fetched COIN-M futures pairs",
+		zap.Int("count", len(coinPairs)),
+	)
+
+	return coinPairs, nil
+}
+
+// getCoinMFundingRate retrieves the current funding rate for a COIN-M symbol
+func (c *Client) getCoinMFundingRate(ctx context.Context, symbol string) (*FundingRate, error) {
+	endpoint := fmt.Sprintf("%s/dapi/v1/premiumIndex", c.coinMBaseURL)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	q := req.URL.Query()
+	q.Add("symbol", symbol)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newStatusError(resp)
+	}
+
+	// Unlike fapi, dapi returns an array even when a symbol is given
+	var fundingRates []FundingRate
+	if err := json.NewDecoder(resp.Body).Decode(&fundingRates); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if len(fundingRates) == 0 {
+		return nil, fmt.Errorf("no funding rate data for symbol %s: %w", symbol, ErrNoData)
+	}
+
+	return &fundingRates[0], nil
+}
+
+// getCoinMMarkPrice retrieves the current mark price for a COIN-M symbol
+func (c *Client) getCoinMMarkPrice(ctx context.Context, symbol string) (float64, error) {
+	endpoint := fmt.Sprintf("%s/dapi/v1/premiumIndex", c.coinMBaseURL)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	q := req.URL.Query()
+	q.Add("symbol", symbol)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, newStatusError(resp)
+	}
+
+	// dapi returns an array even when a symbol is given
+	var indexes []PremiumIndex
+	if err := json.NewDecoder(resp.Body).Decode(&indexes); err != nil {
+		return 0, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if len(indexes) == 0 || indexes[0].MarkPrice <= 0 {
+		return 0, fmt.Errorf("no mark price for symbol %s: %w", symbol, ErrNoData)
+	}
+
+	return indexes[0].MarkPrice, nil
+}
+
+// getCoinMTakerBuySellRatio retrieves the taker buy/sell volume ratio for a COIN-M symbol
+// dapi only exposes the raw taker volumes, so the ratio is derived from them
+func (c *Client) getCoinMTakerBuySellRatio(ctx context.Context, symbol string, period string) (*TakerLongShortRatio, error) {
+	endpoint := c.futuresDataEndpoint(symbol, "takerBuySellVol")
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	q := req.URL.Query()
+	c.addFuturesDataSymbol(q, symbol)
+	q.Add("contractType", coinMContractType)
+	q.Add("period", period)
+	q.Add("limit", "1")
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newStatusError(resp)
+	}
+
+	var volumes []CoinMTakerBuySellVol
+	if err := json.NewDecoder(resp.Body).Decode(&volumes); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if len(volumes) == 0 {
+		return nil, fmt.Errorf("%w for symbol %s", ErrNoData, symbol)
+	}
+
+	volume := volumes[0]
+	if volume.TakerSellVol <= 0 {
+		return nil, fmt.Errorf("no taker sell volume for symbol %s: %w", symbol, ErrNoData)
+	}
+
+	return &TakerLongShortRatio{
+		BuySellRatio: volume.TakerBuyVol / volume.TakerSellVol,
+		BuyVol:       volume.TakerBuyVol,
+		SellVol:      volume.TakerSellVol,
+		Timestamp:    volume.Timestamp,
+	}, nil
+}
+
+// getCoinMPrice retrieves the current price for a COIN-M symbol
+func (c *Client) getCoinMPrice(ctx context.Context, symbol string) (float64, error) {
+	prices, err := c.deliveryClient.NewListPricesService().Symbol(symbol).Do(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get price: %w", wrapSDKError(err))
+	}
+
+	if len(prices) == 0 {
+		return 0, fmt.Errorf("no price data for symbol %s: %w", symbol, ErrNoData)
+	}
+
+	price := 0.0
+	fmt.Sscanf(prices[0].Price, "%f", &price)
+
+	return price, nil
+}
+
+// getCoinM24hrTicker retrieves 24-hour ticker statistics for a COIN-M symbol
+// QuoteVolume is reported in USD (base volume x weighted average price) so it
+// stays comparable with USDT-M pairs
+func (c *Client) getCoinM24hrTicker(ctx context.Context, symbol string) (*Ticker24hr, error) {
+	tickers, err := c.deliveryClient.NewListPriceChangeStatsService().Symbol(symbol).Do(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get 24hr ticker: %w", wrapSDKError(err))
+	}
+
+	if len(tickers) == 0 {
+		return nil, fmt.Errorf("no ticker data for symbol %s: %w", symbol, ErrNoData)
+	}
+
+	ticker := tickers[0]
+
+	// Parse values
+	var lastPrice, weightedAvgPrice, baseVolume float64
+	fmt.Sscanf(ticker.LastPrice, "%f", &lastPrice)
+	fmt.Sscanf(ticker.WeightedAvgPrice, "%f", &weightedAvgPrice)
+	fmt.Sscanf(ticker.BaseVolume, "%f", &baseVolume)
+
+	return &Ticker24hr{
+		Symbol:      ticker.Symbol,
+		LastPrice:   lastPrice,
+		Volume:      baseVolume,
+		QuoteVolume: baseVolume * weightedAvgPrice,
+		OpenTime:    ticker.OpenTime,
+		CloseTime:   ticker.CloseTime,
+		Count:       ticker.Count,
+	}, nil
+}
+
+// getCoinMKlines retrieves kline data for a COIN-M symbol, optionally since a start time
+func (c *Client) getCoinMKlines(ctx context.Context, symbol string, interval string, limit int, startTime *time.Time) ([]*entity.Kline, error) {
+	service := c.deliveryClient.NewKlinesService().
+		Symbol(symbol).
+		Interval(interval).
+		Limit(limit)
+
+	if startTime != nil {
+		service = service.StartTime(startTime.UnixMilli())
+	}
+
+	klines, err := service.Do(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get klines: %w", wrapSDKError(err))
+	}
+
+	// Convert to entity.Kline, dropping malformed klines rather than emitting zero prices
+	result := make([]*entity.Kline, 0, len(klines))
+	for _, k := range klines {
+		kline, err := convertDeliveryKline(k)
+		if err != nil {
+			c.logger.WithError(err).Warn("Skipping malformed kline",
+				zap.String("symbol", symbol),
+				zap.Int64("open_time", k.OpenTime))
+			continue
+		}
+		result = append(result, kline)
+	}
+
+	c.logger.Debug("Fetched COIN-M klines successfully",
+		zap.String("symbol", symbol),
+		zap.Int("count", len(result)),
+	)
+
+	return result, nil
+}
+
+// convertDeliveryKline converts a Binance COIN-M kline to entity.Kline
+// Volume is in contracts and QuoteVolume in the base coin for COIN-M klines
+func convertDeliveryKline(k *delivery.Kline) (*entity.Kline, error) {
+	return newKline(k.OpenTime, k.CloseTime, k.Open, k.High, k.Low, k.Close, k.Volume, k.QuoteAssetVolume)
+}
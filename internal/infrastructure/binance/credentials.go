@@ -0,0 +1,35 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+)
+
+// KeyPermissions lists what the configured API key may do on the futures account
+type KeyPermissions struct {
+	CanTrade    bool
+	CanDeposit  bool
+	CanWithdraw bool
+}
+
+// HasCredentials reports whether an API key and secret are configured
+// Without them only public market data endpoints are available
+func (c *Client) HasCredentials() bool {
+	return c.apiKey != "" && c.apiSecret != ""
+}
+
+// CheckCredentials verifies the configured API key with an authenticated futures account
+// request and returns its permissions
+// A key Binance rejects is reported as ErrInvalidCredentials
+func (c *Client) CheckCredentials(ctx context.Context) (*KeyPermissions, error) {
+	account, err := c.client.NewGetAccountService().Do(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get futures account: %w", wrapSDKError(err))
+	}
+
+	return &KeyPermissions{
+		CanTrade:    account.CanTrade,
+		CanDeposit:  account.CanDeposit,
+		CanWithdraw: account.CanWithdraw,
+	}, nil
+}
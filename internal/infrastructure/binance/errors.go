@@ -0,0 +1,109 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"ContractAnalysis/pkg/utils"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// Binance error codes the client distinguishes
+const (
+	rateLimitErrorCode        = -1003 // Request weight limit exceeded
+	invalidSignatureErrorCode = -1022 // Signature for the request is not valid
+	invalidKeyFormatErrorCode = -2014 // API key format invalid
+	rejectedKeyErrorCode      = -2015 // Invalid API key, IP, or permissions for action
+)
+
+// Sentinel errors returned by the client, match them with errors.Is
+var (
+	// ErrNoData means Binance answered successfully but had no data for the symbol,
+	// which is expected for some pairs (e.g. newly listed or settling contracts)
+	ErrNoData = errors.New("no data returned")
+
+	// ErrRateLimited means Binance rejected the request for exceeding its rate limits
+	ErrRateLimited = errors.New("rate limited by Binance")
+
+	// ErrBinanceAPI means Binance answered with an error, use errors.As with *APIError for details
+	ErrBinanceAPI = errors.New("binance API error")
+
+	// ErrInvalidCredentials means Binance rejected the configured API key or secret, or the
+	// key lacks permission for the request or this IP
+	ErrInvalidCredentials = errors.New("invalid Binance API credentials")
+
+	// ErrCircuitOpen means the request was not sent because the circuit breaker is open
+	// after repeated failures, Binance will be probed again once the cooldown has passed
+	ErrCircuitOpen = utils.ErrCircuitOpen
+)
+
+// APIError is returned when Binance answers a request with an error
+// It matches ErrBinanceAPI, ErrRateLimited when the error is a rate limit rejection and
+// ErrInvalidCredentials when the API key was rejected
+type APIError struct {
+	StatusCode int    // HTTP status, 0 when the error came through the SDK
+	Code       int64  // Binance error code, 0 when not reported
+	Message    string // Response body or Binance error message
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	if e.StatusCode == 0 {
+		return fmt.Sprintf("API returned code %d: %s", e.Code, e.Message)
+	}
+	return fmt.Sprintf("API returned status %d: %s", e.StatusCode, e.Message)
+}
+
+// Is reports whether the error matches one of the client's sentinel errors
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrBinanceAPI:
+		return true
+	case ErrRateLimited:
+		// 418 is returned once an IP keeps sending requests after a 429
+		return e.StatusCode == http.StatusTooManyRequests ||
+			e.StatusCode == http.StatusTeapot ||
+			e.Code == rateLimitErrorCode
+	case ErrInvalidCredentials:
+		return e.StatusCode == http.StatusUnauthorized ||
+			e.Code == invalidSignatureErrorCode ||
+			e.Code == invalidKeyFormatErrorCode ||
+			e.Code == rejectedKeyErrorCode
+	default:
+		return false
+	}
+}
+
+// IsRetryable reports whether a failed request may succeed when retried
+// Missing data won't appear on a retry, an open breaker stays open for its cooldown and a
+// rejected API key stays rejected
+func IsRetryable(err error) bool {
+	return !errors.Is(err, ErrNoData) &&
+		!errors.Is(err, ErrCircuitOpen) &&
+		!errors.Is(err, ErrInvalidCredentials)
+}
+
+// newStatusError builds an APIError from a non-200 HTTP response
+func newStatusError(resp *http.Response) error {
+	body, _ := io.ReadAll(resp.Body)
+	return &APIError{
+		StatusCode: resp.StatusCode,
+		Message:    string(body),
+	}
+}
+
+// wrapSDKError converts go-binance SDK API errors into APIError so callers can use errors.Is
+// Transport and decoding errors are returned unchanged
+func wrapSDKError(err error) error {
+	var sdkErr *common.APIError
+	if errors.As(err, &sdkErr) {
+		return &APIError{
+			Code:    sdkErr.Code,
+			Message: sdkErr.Message,
+		}
+	}
+	return err
+}
@@ -0,0 +1,101 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+func TestAPIErrorIs(t *testing.T) {
+	tests := []struct {
+		name            string
+		err             error
+		wantAPI         bool
+		wantRateLimited bool
+		wantCredentials bool
+	}{
+		{"server error", &APIError{StatusCode: http.StatusInternalServerError}, true, false, false},
+		{"429", &APIError{StatusCode: http.StatusTooManyRequests}, true, true, false},
+		{"418 ban", &APIError{StatusCode: http.StatusTeapot}, true, true, false},
+		{"weight limit code", &APIError{Code: rateLimitErrorCode}, true, true, false},
+		{"401", &APIError{StatusCode: http.StatusUnauthorized}, true, false, true},
+		{"rejected key code", &APIError{Code: rejectedKeyErrorCode}, true, false, true},
+		{"wrapped", fmt.Errorf("failed to get ratio: %w", &APIError{StatusCode: http.StatusTooManyRequests}), true, true, false},
+		{"SDK error", wrapSDKError(&common.APIError{Code: rateLimitErrorCode, Message: "Too many requests"}), true, true, false},
+		{"no data", fmt.Errorf("%w for symbol BTCUSDT", ErrNoData), false, false, false},
+		{"transport error", wrapSDKError(io.ErrUnexpectedEOF), false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errors.Is(tt.err, ErrBinanceAPI); got != tt.wantAPI {
+				t.Errorf("errors.Is(ErrBinanceAPI) = %v, want %v", got, tt.wantAPI)
+			}
+			if got := errors.Is(tt.err, ErrRateLimited); got != tt.wantRateLimited {
+				t.Errorf("errors.Is(ErrRateLimited) = %v, want %v", got, tt.wantRateLimited)
+			}
+			if got := errors.Is(tt.err, ErrInvalidCredentials); got != tt.wantCredentials {
+				t.Errorf("errors.Is(ErrInvalidCredentials) = %v, want %v", got, tt.wantCredentials)
+			}
+		})
+	}
+}
+
+func TestNewStatusError(t *testing.T) {
+	err := newStatusError(&http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Body:       io.NopCloser(strings.NewReader(`{"code":-1003}`)),
+	})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("newStatusError = %T, want *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusTooManyRequests || apiErr.Message != `{"code":-1003}` {
+		t.Errorf("APIError = %+v, want the status and body", apiErr)
+	}
+	if !errors.Is(err, ErrRateLimited) {
+		t.Error("errors.Is(ErrRateLimited) = false, want true")
+	}
+}
+
+func TestSymbolErrorUnwrap(t *testing.T) {
+	noData := &SymbolError{Symbol: "BTCUSDT", Attempts: 1, Err: fmt.Errorf("%w for symbol BTCUSDT", ErrNoData)}
+	rateLimited := &SymbolError{Symbol: "ETHUSDT", Attempts: 3, Err: &APIError{StatusCode: http.StatusTooManyRequests}}
+
+	if !errors.Is(noData, ErrNoData) {
+		t.Error("errors.Is(SymbolError, ErrNoData) = false, want true")
+	}
+	if errors.Is(noData, ErrBinanceAPI) {
+		t.Error("errors.Is(SymbolError, ErrBinanceAPI) = true for missing data")
+	}
+	if !errors.Is(rateLimited, ErrRateLimited) || !errors.Is(rateLimited, ErrBinanceAPI) {
+		t.Error("rate limited SymbolError doesn't match ErrRateLimited and ErrBinanceAPI")
+	}
+	if IsRetryable(noData) || !IsRetryable(rateLimited) {
+		t.Errorf("IsRetryable = %v, %v, want false for missing data and true for rate limits",
+			IsRetryable(noData), IsRetryable(rateLimited))
+	}
+
+	var apiErr *APIError
+	if !errors.As(rateLimited, &apiErr) || apiErr.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("errors.As(SymbolError, *APIError) = %+v, want the wrapped APIError", apiErr)
+	}
+	if got := rateLimited.Error(); !strings.HasPrefix(got, "ETHUSDT: ") {
+		t.Errorf("Error() = %q, want the symbol prefix", got)
+	}
+
+	// The joined batch error matches the errors of every failed symbol
+	result := &MarketDataBatchResult{Failed: []*SymbolError{noData, rateLimited}}
+	if err := result.Err(); !errors.Is(err, ErrNoData) || !errors.Is(err, ErrRateLimited) {
+		t.Errorf("batch Err() = %v, want it to match both symbol errors", err)
+	}
+	if err := (&MarketDataBatchResult{}).Err(); err != nil {
+		t.Errorf("batch Err() without failures = %v, want nil", err)
+	}
+}
@@ -0,0 +1,164 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// precisionCacheTTL is how long exchangeInfo precision data is reused before refetching
+const precisionCacheTTL = 1 * time.Hour
+
+// SymbolPrecision represents the price precision rules of a symbol
+type SymbolPrecision struct {
+	Symbol         string
+	TickSize       decimal.Decimal
+	PricePrecision int
+	ListedAt       time.Time // Contract onboard date from exchangeInfo (zero = unknown)
+}
+
+// precisionCache holds exchangeInfo precision data for all symbols
+type precisionCache struct {
+	mu        sync.RWMutex
+	symbols   map[string]SymbolPrecision
+	fetchedAt time.Time
+}
+
+// GetTickSize retrieves the minimum price increment for a symbol
+func (c *Client) GetTickSize(ctx context.Context, symbol string) (decimal.Decimal, error) {
+	precision, err := c.GetSymbolPrecision(ctx, symbol)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return precision.TickSize, nil
+}
+
+// GetSymbolPrecision retrieves the price precision rules for a symbol
+// Results come from a cached exchangeInfo snapshot refreshed every hour
+func (c *Client) GetSymbolPrecision(ctx context.Context, symbol string) (*SymbolPrecision, error) {
+	precisions, err := c.GetSymbolPrecisions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	precision, ok := precisions[symbol]
+	if !ok {
+		return nil, fmt.Errorf("no precision data for symbol %s: %w", symbol, ErrNoData)
+	}
+
+	return &precision, nil
+}
+
+// GetListingDate retrieves the date a symbol's contract was listed on the exchange
+// Returns a zero time if exchangeInfo does not report an onboard date for the symbol
+func (c *Client) GetListingDate(ctx context.Context, symbol string) (time.Time, error) {
+	precision, err := c.GetSymbolPrecision(ctx, symbol)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return precision.ListedAt, nil
+}
+
+// ListSymbols lists every USDT-M and COIN-M symbol in the cached exchangeInfo snapshot
+func (c *Client) ListSymbols(ctx context.Context) ([]string, error) {
+	precisions, err := c.GetSymbolPrecisions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	symbols := make([]string, 0, len(precisions))
+	for symbol := range precisions {
+		symbols = append(symbols, symbol)
+	}
+	return symbols, nil
+}
+
+// GetSymbolPrecisions retrieves the price precision rules for all USDT-M and COIN-M symbols
+func (c *Client) GetSymbolPrecisions(ctx context.Context) (map[string]SymbolPrecision, error) {
+	c.precision.mu.RLock()
+	if c.precision.symbols != nil && time.Since(c.precision.fetchedAt) < precisionCacheTTL {
+		symbols := c.precision.symbols
+		c.precision.mu.RUnlock()
+		return symbols, nil
+	}
+	c.precision.mu.RUnlock()
+
+	symbols, err := c.fetchSymbolPrecisions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.precision.mu.Lock()
+	c.precision.symbols = symbols
+	c.precision.fetchedAt = time.Now()
+	c.precision.mu.Unlock()
+
+	return symbols, nil
+}
+
+// fetchSymbolPrecisions loads price precision rules from exchangeInfo
+// COIN-M symbols are best effort so a dapi outage doesn't block USDT-M pairs
+func (c *Client) fetchSymbolPrecisions(ctx context.Context) (map[string]SymbolPrecision, error) {
+	exchangeInfo, err := c.client.NewExchangeInfoService().Do(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange info: %w", wrapSDKError(err))
+	}
+
+	symbols := make(map[string]SymbolPrecision, len(exchangeInfo.Symbols))
+	for _, symbol := range exchangeInfo.Symbols {
+		symbols[symbol.Symbol] = SymbolPrecision{
+			Symbol:         symbol.Symbol,
+			TickSize:       tickSizeFromFilters(symbol.Filters),
+			PricePrecision: symbol.PricePrecision,
+			ListedAt:       onboardTime(symbol.OnboardDate),
+		}
+	}
+
+	coinMInfo, err := c.deliveryClient.NewExchangeInfoService().Do(ctx)
+	if err != nil {
+		c.logger.Warn("COIN-M precision data not available", zap.Error(err))
+	} else {
+		for _, symbol := range coinMInfo.Symbols {
+			symbols[symbol.Symbol] = SymbolPrecision{
+				Symbol:         symbol.Symbol,
+				TickSize:       tickSizeFromFilters(symbol.Filters),
+				PricePrecision: symbol.PricePrecision,
+				ListedAt:       onboardTime(symbol.OnboardDate),
+			}
+		}
+	}
+
+	c.logger.Debug("Fetched symbol precisions", zap.Int("count", len(symbols)))
+
+	return symbols, nil
+}
+
+// onboardTime converts an exchangeInfo onboard date in milliseconds, treating 0 as unknown
+func onboardTime(onboardDate int64) time.Time {
+	if onboardDate <= 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(onboardDate).UTC()
+}
+
+// tickSizeFromFilters extracts the PRICE_FILTER tick size from exchangeInfo filters
+func tickSizeFromFilters(filters []map[string]interface{}) decimal.Decimal {
+	for _, filter := range filters {
+		if filterType, _ := filter["filterType"].(string); filterType != "PRICE_FILTER" {
+			continue
+		}
+
+		tickSize, _ := filter["tickSize"].(string)
+		tick, err := decimal.NewFromString(tickSize)
+		if err != nil {
+			return decimal.Zero
+		}
+		return tick
+	}
+
+	return decimal.Zero
+}
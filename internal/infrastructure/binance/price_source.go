@@ -0,0 +1,56 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+
+	"ContractAnalysis/internal/domain/repository"
+)
+
+// Price sources selectable for signal tracking
+const (
+	PriceSourceLast = "last" // Last traded price
+	PriceSourceMark = "mark" // Mark price, which drives liquidations and is harder to spike with a single trade
+)
+
+// LastPriceSource reports the last traded price of a symbol
+type LastPriceSource struct {
+	client *Client
+}
+
+// GetPrice retrieves the last traded price for a symbol
+func (s *LastPriceSource) GetPrice(ctx context.Context, symbol string) (float64, error) {
+	return s.client.GetPrice(ctx, symbol)
+}
+
+// Name returns the price source name
+func (s *LastPriceSource) Name() string {
+	return PriceSourceLast
+}
+
+// MarkPriceSource reports the mark price of a symbol
+type MarkPriceSource struct {
+	client *Client
+}
+
+// GetPrice retrieves the mark price for a symbol
+func (s *MarkPriceSource) GetPrice(ctx context.Context, symbol string) (float64, error) {
+	return s.client.GetMarkPrice(ctx, symbol)
+}
+
+// Name returns the price source name
+func (s *MarkPriceSource) Name() string {
+	return PriceSourceMark
+}
+
+// NewPriceSource creates the price source with the given name (PriceSourceLast or PriceSourceMark)
+func NewPriceSource(client *Client, name string) (repository.PriceSource, error) {
+	switch name {
+	case PriceSourceLast:
+		return &LastPriceSource{client: client}, nil
+	case PriceSourceMark:
+		return &MarkPriceSource{client: client}, nil
+	default:
+		return nil, fmt.Errorf("unknown price source %q", name)
+	}
+}
@@ -53,6 +53,23 @@ type GlobalLongShortAccountRatio struct {
 	Timestamp      int64   `json:"timestamp"`
 }
 
+// TakerLongShortRatio represents the taker buy/sell volume ratio
+type TakerLongShortRatio struct {
+	BuySellRatio float64 `json:"buySellRatio,string"`
+	BuyVol       float64 `json:"buyVol,string"`
+	SellVol      float64 `json:"sellVol,string"`
+	Timestamp    int64   `json:"timestamp"`
+}
+
+// CoinMTakerBuySellVol represents COIN-M taker buy/sell volume
+type CoinMTakerBuySellVol struct {
+	Pair         string  `json:"pair"`
+	ContractType string  `json:"contractType"`
+	TakerBuyVol  float64 `json:"takerBuyVol,string"`
+	TakerSellVol float64 `json:"takerSellVol,string"`
+	Timestamp    int64   `json:"timestamp"`
+}
+
 // TickerPrice represents the current price for a symbol
 type TickerPrice struct {
 	Symbol string  `json:"symbol"`
@@ -86,6 +103,17 @@ type FundingRate struct {
 	Symbol      string  `json:"symbol"`
 	FundingRate float64 `json:"fundingRate,string"`
 	FundingTime int64   `json:"fundingTime"`
+
+	// Next funding settlement in milliseconds, reported by premiumIndex (0 = unknown)
+	NextFundingTime int64 `json:"nextFundingTime"`
+}
+
+// PremiumIndex represents the mark and index price of a symbol
+type PremiumIndex struct {
+	Symbol     string  `json:"symbol"`
+	MarkPrice  float64 `json:"markPrice,string"`
+	IndexPrice float64 `json:"indexPrice,string"`
+	Time       int64   `json:"time"`
 }
 
 // MarketData represents collected market data for a symbol
@@ -111,8 +139,12 @@ type MarketData struct {
 	// Open Interest
 	OpenInterest float64
 
-	// Funding Rate
-	FundingRate float64
+	// Funding Rate and next settlement time (zero = unknown)
+	FundingRate     float64
+	NextFundingTime time.Time
+
+	// Taker buy/sell volume ratio (0 if unavailable)
+	TakerBuySellRatio float64
 }
 
 // RateLimitInfo represents rate limit information
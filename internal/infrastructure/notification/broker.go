@@ -0,0 +1,108 @@
+package notification
+
+import (
+	"context"
+	"sync"
+
+	"ContractAnalysis/config"
+	"ContractAnalysis/internal/infrastructure/logger"
+
+	"go.uber.org/zap"
+)
+
+// Broker is an in-process pub/sub notifier that fans notifications out to
+// subscribers such as connected websocket clients
+type Broker struct {
+	config      config.WebSocketConfig
+	mu          sync.RWMutex
+	subscribers map[chan *Notification]struct{}
+	logger      *logger.Logger
+}
+
+// NewBroker creates a new in-process notification broker
+func NewBroker(cfg config.WebSocketConfig) *Broker {
+	return &Broker{
+		config:      cfg,
+		subscribers: make(map[chan *Notification]struct{}),
+		logger:      logger.WithComponent("notification-broker"),
+	}
+}
+
+// Name returns the notifier name
+func (b *Broker) Name() string {
+	return "websocket"
+}
+
+// IsEnabled returns whether the notifier is enabled
+func (b *Broker) IsEnabled() bool {
+	return b.config.Enabled
+}
+
+// ShouldNotify checks if this notifier should handle the event
+func (b *Broker) ShouldNotify(eventType EventType) bool {
+	for _, event := range b.config.Events {
+		if event == string(eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+// Notify publishes a notification to all subscribers
+// Slow subscribers whose buffer is full miss the notification instead of blocking the publisher
+func (b *Broker) Notify(ctx context.Context, notification *Notification) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for ch := range b.subscribers {
+		select {
+		case ch <- notification:
+		default:
+			b.logger.Warn("Dropping notification for slow subscriber",
+				zap.String("event_type", string(notification.EventType)),
+			)
+		}
+	}
+
+	return nil
+}
+
+// Subscribe registers a new subscriber and returns its channel with an unsubscribe func
+// The channel is closed once unsubscribe is called
+func (b *Broker) Subscribe() (<-chan *Notification, func()) {
+	bufferSize := b.config.ClientBufferSize
+	if bufferSize <= 0 {
+		bufferSize = 1
+	}
+
+	ch := make(chan *Notification, bufferSize)
+
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	count := len(b.subscribers)
+	b.mu.Unlock()
+
+	b.logger.Debug("Subscriber registered", zap.Int("subscribers", count))
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscribers, ch)
+			count := len(b.subscribers)
+			b.mu.Unlock()
+			close(ch)
+
+			b.logger.Debug("Subscriber removed", zap.Int("subscribers", count))
+		})
+	}
+
+	return ch, unsubscribe
+}
+
+// SubscriberCount returns the number of active subscribers
+func (b *Broker) SubscriberCount() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.subscribers)
+}
@@ -3,6 +3,7 @@ package notification
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"ContractAnalysis/config"
 	"ContractAnalysis/internal/infrastructure/logger"
@@ -10,15 +11,17 @@ import (
 
 // ConsoleNotifier sends notifications to the console/logs
 type ConsoleNotifier struct {
-	config config.ConsoleConfig
-	logger *logger.Logger
+	config   config.ConsoleConfig
+	location *time.Location // Timezone timestamps are displayed in
+	logger   *logger.Logger
 }
 
 // NewConsoleNotifier creates a new console notifier
-func NewConsoleNotifier(cfg config.ConsoleConfig) *ConsoleNotifier {
+func NewConsoleNotifier(cfg config.ConsoleConfig, location *time.Location) *ConsoleNotifier {
 	return &ConsoleNotifier{
-		config: cfg,
-		logger: logger.WithComponent("console-notifier"),
+		config:   cfg,
+		location: location,
+		logger:   logger.WithComponent("console-notifier"),
 	}
 }
 
@@ -55,6 +58,8 @@ func (n *ConsoleNotifier) Notify(ctx context.Context, notification *Notification
 		return n.notifySignalOutcome(notification)
 	case EventSystemError:
 		return n.notifySystemError(notification)
+	case EventStatisticsChange:
+		return n.notifyStatisticsChange(notification)
 	default:
 		return fmt.Errorf("unknown event type: %s", notification.EventType)
 	}
@@ -92,7 +97,7 @@ Long/Short (Position):  %.2f%% / %.2f%%
 		signal.Type,
 		signal.StrategyName,
 		signal.PriceAtSignal.String(),
-		signal.GeneratedAt.Format("2006-01-02 15:04:05"),
+		n.formatTime(signal.GeneratedAt),
 		signal.LongAccountRatio.InexactFloat64(),
 		signal.ShortAccountRatio.InexactFloat64(),
 		signal.LongPositionRatio.InexactFloat64(),
@@ -130,7 +135,7 @@ Confirmed:  %s
 		signal.Type,
 		signal.StrategyName,
 		signal.PriceAtSignal.String(),
-		signal.ConfirmedAt.Format("2006-01-02 15:04:05"),
+		n.formatTime(*signal.ConfirmedAt),
 	)
 
 	n.logger.Info(message)
@@ -233,6 +238,26 @@ func (n *ConsoleNotifier) notifySystemError(notification *Notification) error {
 	return nil
 }
 
+func (n *ConsoleNotifier) notifyStatisticsChange(notification *Notification) error {
+	message := fmt.Sprintf(`
+━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
+📊 STATISTICS CHANGE
+━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
+%s
+━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
+`,
+		notification.Message,
+	)
+
+	n.logger.Warn(message)
+	return nil
+}
+
+// formatTime formats a timestamp in the configured display timezone
+func (n *ConsoleNotifier) formatTime(t time.Time) string {
+	return t.In(n.location).Format("2006-01-02 15:04:05 MST")
+}
+
 func conditionalField(label string, value bool) string {
 	if value {
 		return fmt.Sprintf("✓ %s: YES", label)
@@ -2,7 +2,10 @@ package notification
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
+	"ContractAnalysis/config"
 	"ContractAnalysis/internal/domain/entity"
 )
 
@@ -15,6 +18,7 @@ const (
 	EventSignalInvalidated EventType = "signal_invalidated"
 	EventSignalOutcome    EventType = "signal_outcome"
 	EventSystemError      EventType = "system_error"
+	EventStatisticsChange EventType = "statistics_change"
 )
 
 // Notification represents a notification message
@@ -41,20 +45,37 @@ type Notifier interface {
 	Notify(ctx context.Context, notification *Notification) error
 }
 
+// Flusher is implemented by notifiers that queue notifications instead of sending them
+// immediately (batching, digests, retries), so queued notifications can be delivered on shutdown
+type Flusher interface {
+	// Flush delivers queued notifications, giving up when ctx is done
+	Flush(ctx context.Context) error
+}
+
 // NotificationDispatcher manages multiple notifiers
 type NotificationDispatcher struct {
 	notifiers []Notifier
+	throttle  *notificationThrottle // Nil when throttling is disabled
 }
 
 // NewNotificationDispatcher creates a new notification dispatcher
-func NewNotificationDispatcher(notifiers []Notifier) *NotificationDispatcher {
-	return &NotificationDispatcher{
+func NewNotificationDispatcher(notifiers []Notifier, throttleCfg config.NotificationThrottleConfig) *NotificationDispatcher {
+	d := &NotificationDispatcher{
 		notifiers: notifiers,
 	}
+	if throttleCfg.Enabled {
+		d.throttle = newNotificationThrottle(throttleCfg.Window)
+	}
+	return d
 }
 
 // Notify sends a notification to all enabled notifiers
+// Repeats of a signal event for the same symbol are dropped while throttled
 func (d *NotificationDispatcher) Notify(ctx context.Context, notification *Notification) error {
+	if !d.throttle.allow(notification) {
+		return nil
+	}
+
 	for _, notifier := range d.notifiers {
 		if !notifier.IsEnabled() {
 			continue
@@ -73,6 +94,23 @@ func (d *NotificationDispatcher) Notify(ctx context.Context, notification *Notif
 	return nil
 }
 
+// Flush delivers the notifications queued by notifiers implementing Flusher
+// Every notifier is flushed even if another fails; the errors are returned together
+func (d *NotificationDispatcher) Flush(ctx context.Context) error {
+	var errs []error
+	for _, notifier := range d.notifiers {
+		flusher, ok := notifier.(Flusher)
+		if !ok {
+			continue
+		}
+
+		if err := flusher.Flush(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to flush %s notifier: %w", notifier.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // NotifySignalGenerated sends a notification when a signal is generated
 func (d *NotificationDispatcher) NotifySignalGenerated(ctx context.Context, signal *entity.Signal) error {
 	return d.Notify(ctx, &Notification{
@@ -118,3 +156,12 @@ func (d *NotificationDispatcher) NotifySystemError(ctx context.Context, message
 		Metadata:  metadata,
 	})
 }
+
+// NotifyStatisticsChange sends a notification when significant statistics changes are detected
+func (d *NotificationDispatcher) NotifyStatisticsChange(ctx context.Context, message string, metadata map[string]interface{}) error {
+	return d.Notify(ctx, &Notification{
+		EventType: EventStatisticsChange,
+		Message:   message,
+		Metadata:  metadata,
+	})
+}
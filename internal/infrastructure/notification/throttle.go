@@ -0,0 +1,109 @@
+package notification
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"ContractAnalysis/internal/infrastructure/logger"
+
+	"go.uber.org/zap"
+)
+
+// throttleKey identifies notifications throttled together, so each event type of a symbol
+// has its own window
+type throttleKey struct {
+	eventType EventType
+	symbol    string
+}
+
+// throttleEntry tracks the last notification sent for a throttle key
+type throttleEntry struct {
+	sentAt     time.Time
+	suppressed int // Notifications dropped since sentAt
+}
+
+// notificationThrottle drops repeated notifications of the same event type for the same
+// symbol within a window. The next notification let through reports how many were dropped
+// Notifications without a signal (system errors, statistics changes) are never throttled
+type notificationThrottle struct {
+	window time.Duration
+	logger *logger.Logger
+	now    func() time.Time
+
+	mu              sync.Mutex
+	entries         map[throttleKey]*throttleEntry
+	totalSuppressed int64
+	lastPrune       time.Time
+}
+
+// newNotificationThrottle creates a throttle with the given window, nil if it is disabled
+func newNotificationThrottle(window time.Duration) *notificationThrottle {
+	if window <= 0 {
+		return nil
+	}
+	return &notificationThrottle{
+		window:  window,
+		logger:  logger.WithComponent("notification-throttle"),
+		now:     time.Now,
+		entries: make(map[throttleKey]*throttleEntry),
+	}
+}
+
+// allow reports whether a notification may be sent
+// A notification let through after others were dropped has the dropped count added to its
+// message and to its metadata under "suppressed_count"
+func (t *notificationThrottle) allow(notification *Notification) bool {
+	if t == nil || notification.Signal == nil {
+		return true
+	}
+
+	key := throttleKey{eventType: notification.EventType, symbol: notification.Signal.Symbol}
+	now := t.now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.pruneLocked(now)
+
+	entry, ok := t.entries[key]
+	if ok && now.Sub(entry.sentAt) < t.window {
+		entry.suppressed++
+		t.totalSuppressed++
+		t.logger.Info("Notification throttled",
+			zap.String("event", string(key.eventType)),
+			zap.String("symbol", key.symbol),
+			zap.Int("suppressed", entry.suppressed),
+			zap.Int64("total_suppressed", t.totalSuppressed),
+			zap.Duration("window", t.window),
+		)
+		return false
+	}
+
+	if ok && entry.suppressed > 0 {
+		notification.Message = fmt.Sprintf("%s (%d similar notifications suppressed)", notification.Message, entry.suppressed)
+		if notification.Metadata == nil {
+			notification.Metadata = make(map[string]interface{})
+		}
+		notification.Metadata["suppressed_count"] = entry.suppressed
+	}
+
+	t.entries[key] = &throttleEntry{sentAt: now}
+	return true
+}
+
+// pruneLocked forgets entries whose window has passed and that dropped nothing, so the map
+// doesn't grow with every symbol ever notified. It scans the map at most once per window
+// The caller must hold the lock
+func (t *notificationThrottle) pruneLocked(now time.Time) {
+	if now.Sub(t.lastPrune) < t.window {
+		return
+	}
+	t.lastPrune = now
+
+	for key, entry := range t.entries {
+		if entry.suppressed == 0 && now.Sub(entry.sentAt) >= t.window {
+			delete(t.entries, key)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package notification
+
+import (
+	"testing"
+	"time"
+
+	"ContractAnalysis/internal/domain/entity"
+)
+
+func TestNotificationThrottle(t *testing.T) {
+	const window = 10 * time.Minute
+
+	// send is one notification; at is its offset from the start
+	type send struct {
+		at             time.Duration
+		event          EventType
+		symbol         string // Empty for a notification without a signal
+		wantAllowed    bool
+		wantSuppressed int // suppressed_count reported on an allowed notification
+	}
+
+	tests := []struct {
+		name  string
+		sends []send
+	}{
+		{
+			name: "repeats within the window are dropped",
+			sends: []send{
+				{0, EventSignalGenerated, "BTCUSDT", true, 0},
+				{time.Minute, EventSignalGenerated, "BTCUSDT", false, 0},
+				{window - time.Second, EventSignalGenerated, "BTCUSDT", false, 0},
+				{window, EventSignalGenerated, "BTCUSDT", true, 2},
+				{window + time.Minute, EventSignalGenerated, "BTCUSDT", false, 0},
+			},
+		},
+		{
+			name: "event types and symbols have independent windows",
+			sends: []send{
+				{0, EventSignalGenerated, "BTCUSDT", true, 0},
+				{time.Minute, EventSignalConfirmed, "BTCUSDT", true, 0},
+				{2 * time.Minute, EventSignalOutcome, "BTCUSDT", true, 0},
+				{3 * time.Minute, EventSignalGenerated, "ETHUSDT", true, 0},
+				{4 * time.Minute, EventSignalConfirmed, "BTCUSDT", false, 0},
+				{5 * time.Minute, EventSignalGenerated, "BTCUSDT", false, 0},
+				// Each key reports only its own dropped notifications
+				{window, EventSignalGenerated, "BTCUSDT", true, 1},
+				{window + time.Minute, EventSignalConfirmed, "BTCUSDT", true, 1},
+				{window + 2*time.Minute, EventSignalOutcome, "BTCUSDT", true, 0},
+			},
+		},
+		{
+			name: "suppressed count carries over past idle windows",
+			sends: []send{
+				{0, EventSignalGenerated, "BTCUSDT", true, 0},
+				{time.Minute, EventSignalGenerated, "BTCUSDT", false, 0},
+				{2 * time.Minute, EventSignalGenerated, "BTCUSDT", false, 0},
+				// Other traffic prunes expired entries in the meantime
+				{3 * window, EventSignalGenerated, "ETHUSDT", true, 0},
+				{5 * window, EventSignalGenerated, "BTCUSDT", true, 2},
+				// The count resets once reported
+				{7 * window, EventSignalGenerated, "BTCUSDT", true, 0},
+			},
+		},
+		{
+			name: "notifications without a signal are never throttled",
+			sends: []send{
+				{0, EventSystemError, "", true, 0},
+				{time.Second, EventSystemError, "", true, 0},
+				{2 * time.Second, EventStatisticsChange, "", true, 0},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+			now := start
+			throttle := newNotificationThrottle(window)
+			throttle.now = func() time.Time { return now }
+
+			for i, s := range tt.sends {
+				now = start.Add(s.at)
+				notification := &Notification{EventType: s.event, Message: "test"}
+				if s.symbol != "" {
+					notification.Signal = &entity.Signal{Symbol: s.symbol}
+				}
+
+				if allowed := throttle.allow(notification); allowed != s.wantAllowed {
+					t.Fatalf("send %d (%s %s at %s): allowed = %v, want %v", i, s.event, s.symbol, s.at, allowed, s.wantAllowed)
+				}
+				suppressed, _ := notification.Metadata["suppressed_count"].(int)
+				if s.wantAllowed && suppressed != s.wantSuppressed {
+					t.Errorf("send %d (%s %s): suppressed_count = %d, want %d", i, s.event, s.symbol, suppressed, s.wantSuppressed)
+				}
+			}
+		})
+	}
+}
+
+func TestNotificationThrottlePrunesOncePerWindow(t *testing.T) {
+	const window = time.Minute
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	throttle := newNotificationThrottle(window)
+	throttle.now = func() time.Time { return now }
+
+	for i, symbol := range []string{"BTCUSDT", "ETHUSDT", "SOLUSDT"} {
+		now = start.Add(time.Duration(i) * time.Second)
+		throttle.allow(&Notification{EventType: EventSignalGenerated, Signal: &entity.Signal{Symbol: symbol}})
+	}
+
+	// Expired, but the last prune ran less than a window ago
+	now = start.Add(window - time.Millisecond)
+	throttle.allow(&Notification{EventType: EventSignalGenerated, Signal: &entity.Signal{Symbol: "XRPUSDT"}})
+	if got := len(throttle.entries); got != 4 {
+		t.Errorf("entries = %d before a window passed, want 4", got)
+	}
+
+	now = start.Add(2 * window)
+	throttle.allow(&Notification{EventType: EventSignalGenerated, Signal: &entity.Signal{Symbol: "ADAUSDT"}})
+	if got := len(throttle.entries); got != 1 {
+		t.Errorf("entries = %d after a window passed, want only the new one", got)
+	}
+}
+
+func TestNewNotificationThrottleDisabled(t *testing.T) {
+	throttle := newNotificationThrottle(0)
+	if throttle != nil {
+		t.Fatal("a zero window should disable throttling")
+	}
+	if !throttle.allow(&Notification{EventType: EventSignalGenerated, Signal: &entity.Signal{Symbol: "BTCUSDT"}}) {
+		t.Error("a disabled throttle dropped a notification")
+	}
+}
@@ -13,20 +13,23 @@ import (
 
 // MarketDataModel represents the market_data table
 type MarketDataModel struct {
-	ID                     int64           `gorm:"column:id;primaryKey;autoIncrement"`
-	Symbol                 string          `gorm:"column:symbol;size:50;not null;index:idx_symbol_timestamp"`
-	Timestamp              time.Time       `gorm:"column:timestamp;not null;uniqueIndex:uk_symbol_timestamp;index:idx_symbol_timestamp"`
-	LongAccountRatio       decimal.Decimal `gorm:"column:long_account_ratio;type:decimal(10,4);not null"`
-	ShortAccountRatio      decimal.Decimal `gorm:"column:short_account_ratio;type:decimal(10,4);not null"`
-	LongPositionRatio      decimal.Decimal `gorm:"column:long_position_ratio;type:decimal(10,4);not null"`
-	ShortPositionRatio     decimal.Decimal `gorm:"column:short_position_ratio;type:decimal(10,4);not null"`
-	PositionRatioAvailable bool            `gorm:"column:position_ratio_available;default:true"`
-	DataQualityScore       int             `gorm:"column:data_quality_score;type:tinyint;default:100"`
-	Price                  decimal.Decimal `gorm:"column:price;type:decimal(20,8);not null"`
-	Volume24h              decimal.Decimal `gorm:"column:volume_24h;type:decimal(20,2)"`
-	OpenInterest           decimal.Decimal `gorm:"column:open_interest;type:decimal(20,8);default:0"`
-	FundingRate            decimal.Decimal `gorm:"column:funding_rate;type:decimal(10,8);default:0"`
-	CreatedAt              time.Time       `gorm:"column:created_at;autoCreateTime"`
+	ID                     int64            `gorm:"column:id;primaryKey;autoIncrement"`
+	Symbol                 string           `gorm:"column:symbol;size:50;not null;index:idx_symbol_timestamp"`
+	Timestamp              time.Time        `gorm:"column:timestamp;not null;uniqueIndex:uk_symbol_timestamp;index:idx_symbol_timestamp"`
+	LongAccountRatio       decimal.Decimal  `gorm:"column:long_account_ratio;type:decimal(10,4);not null"`
+	ShortAccountRatio      decimal.Decimal  `gorm:"column:short_account_ratio;type:decimal(10,4);not null"`
+	LongPositionRatio      decimal.Decimal  `gorm:"column:long_position_ratio;type:decimal(10,4);not null"`
+	ShortPositionRatio     decimal.Decimal  `gorm:"column:short_position_ratio;type:decimal(10,4);not null"`
+	PositionRatioAvailable bool             `gorm:"column:position_ratio_available;default:true"`
+	DataQualityScore       int              `gorm:"column:data_quality_score;type:tinyint;default:100"`
+	Price                  decimal.Decimal  `gorm:"column:price;type:decimal(20,8);not null"`
+	Volume24h              decimal.Decimal  `gorm:"column:volume_24h;type:decimal(20,2)"`
+	OpenInterest           decimal.Decimal  `gorm:"column:open_interest;type:decimal(20,8);default:0"`
+	FundingRate            decimal.Decimal  `gorm:"column:funding_rate;type:decimal(10,8);default:0"`
+	TakerBuySellRatio      decimal.Decimal  `gorm:"column:taker_buy_sell_ratio;type:decimal(10,4);default:0"`
+	OpenInterestChangePct  *decimal.Decimal `gorm:"column:open_interest_change_pct;type:decimal(12,4)"`
+	NextFundingTime        *time.Time       `gorm:"column:next_funding_time"`
+	CreatedAt              time.Time        `gorm:"column:created_at;autoCreateTime"`
 }
 
 // TableName specifies the table name
@@ -50,6 +53,9 @@ func (m *MarketDataModel) ToEntity() *entity.MarketData {
 		Volume24h:              m.Volume24h,
 		OpenInterest:           m.OpenInterest,
 		FundingRate:            m.FundingRate,
+		TakerBuySellRatio:      m.TakerBuySellRatio,
+		OpenInterestChangePct:  m.OpenInterestChangePct,
+		NextFundingTime:        m.NextFundingTime,
 		CreatedAt:              m.CreatedAt,
 	}
 }
@@ -69,6 +75,9 @@ func (m *MarketDataModel) FromEntity(entity *entity.MarketData) {
 	m.Volume24h = entity.Volume24h
 	m.OpenInterest = entity.OpenInterest
 	m.FundingRate = entity.FundingRate
+	m.TakerBuySellRatio = entity.TakerBuySellRatio
+	m.OpenInterestChangePct = entity.OpenInterestChangePct
+	m.NextFundingTime = entity.NextFundingTime
 }
 
 // MarketDataRepository implements repository.MarketDataRepository
@@ -155,6 +164,22 @@ func (r *MarketDataRepository) GetLatestBySymbol(ctx context.Context, symbol str
 	return model.ToEntity(), nil
 }
 
+// GetEarliestBySymbol retrieves the oldest stored market data for a symbol
+func (r *MarketDataRepository) GetEarliestBySymbol(ctx context.Context, symbol string) (*entity.MarketData, error) {
+	var model MarketDataModel
+	if err := r.db.WithContext(ctx).
+		Where("symbol = ?", symbol).
+		Order("timestamp ASC").
+		First(&model).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get earliest market data: %w", err)
+	}
+
+	return model.ToEntity(), nil
+}
+
 // GetLatestForAllSymbols retrieves the latest market data for all symbols
 func (r *MarketDataRepository) GetLatestForAllSymbols(ctx context.Context) ([]*entity.MarketData, error) {
 	// Use subquery to get the latest timestamp for each symbol
@@ -198,14 +223,15 @@ func (r *MarketDataRepository) GetRecentBySymbol(ctx context.Context, symbol str
 }
 
 // DeleteOlderThan deletes market data older than the specified time
-func (r *MarketDataRepository) DeleteOlderThan(ctx context.Context, before time.Time) error {
-	if err := r.db.WithContext(ctx).
+func (r *MarketDataRepository) DeleteOlderThan(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
 		Where("timestamp < ?", before).
-		Delete(&MarketDataModel{}).Error; err != nil {
-		return fmt.Errorf("failed to delete old market data: %w", err)
+		Delete(&MarketDataModel{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete old market data: %w", result.Error)
 	}
 
-	return nil
+	return result.RowsAffected, nil
 }
 
 // Count returns the total number of market data records
@@ -0,0 +1,137 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ContractAnalysis/internal/domain/entity"
+	"ContractAnalysis/internal/domain/repository"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// PaperEquityModel represents the paper_equity table
+type PaperEquityModel struct {
+	ID              int64           `gorm:"column:id;primaryKey;autoIncrement"`
+	StrategyName    string          `gorm:"column:strategy_name;size:50;not null;uniqueIndex:uk_strategy_signal;index:idx_strategy_closed,priority:1"`
+	SignalID        string          `gorm:"column:signal_id;size:36;not null;uniqueIndex:uk_strategy_signal"`
+	Symbol          string          `gorm:"column:symbol;size:50;not null"`
+	SignalType      string          `gorm:"column:signal_type;size:10;not null"`
+	ReturnPct       decimal.Decimal `gorm:"column:return_pct;type:decimal(10,4);not null"`
+	PositionSizePct decimal.Decimal `gorm:"column:position_size_pct;type:decimal(10,4);not null"`
+	PnL             decimal.Decimal `gorm:"column:pnl;type:decimal(20,8);not null"`
+	Balance         decimal.Decimal `gorm:"column:balance;type:decimal(20,8);not null"`
+	ClosedAt        time.Time       `gorm:"column:closed_at;not null;index:idx_strategy_closed,priority:2"`
+	CreatedAt       time.Time       `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName specifies the table name
+func (PaperEquityModel) TableName() string {
+	return "paper_equity"
+}
+
+// ToEntity converts model to domain entity
+func (m *PaperEquityModel) ToEntity() *entity.PaperEquitySnapshot {
+	return &entity.PaperEquitySnapshot{
+		ID:              m.ID,
+		StrategyName:    m.StrategyName,
+		SignalID:        m.SignalID,
+		Symbol:          m.Symbol,
+		SignalType:      entity.SignalType(m.SignalType),
+		ReturnPct:       m.ReturnPct,
+		PositionSizePct: m.PositionSizePct,
+		PnL:             m.PnL,
+		Balance:         m.Balance,
+		ClosedAt:        m.ClosedAt,
+		CreatedAt:       m.CreatedAt,
+	}
+}
+
+// FromEntity converts domain entity to model
+func (m *PaperEquityModel) FromEntity(entity *entity.PaperEquitySnapshot) {
+	m.ID = entity.ID
+	m.StrategyName = entity.StrategyName
+	m.SignalID = entity.SignalID
+	m.Symbol = entity.Symbol
+	m.SignalType = string(entity.SignalType)
+	m.ReturnPct = entity.ReturnPct
+	m.PositionSizePct = entity.PositionSizePct
+	m.PnL = entity.PnL
+	m.Balance = entity.Balance
+	m.ClosedAt = entity.ClosedAt
+	m.CreatedAt = entity.CreatedAt
+}
+
+// PaperEquityRepository implements repository.PaperEquityRepository
+type PaperEquityRepository struct {
+	db *gorm.DB
+}
+
+// NewPaperEquityRepository creates a new paper equity repository
+func NewPaperEquityRepository(db *gorm.DB) repository.PaperEquityRepository {
+	return &PaperEquityRepository{db: db}
+}
+
+// CreateBatch creates multiple equity snapshots
+func (r *PaperEquityRepository) CreateBatch(ctx context.Context, snapshots []*entity.PaperEquitySnapshot) error {
+	if len(snapshots) == 0 {
+		return nil
+	}
+
+	models := make([]PaperEquityModel, len(snapshots))
+	for i, snapshot := range snapshots {
+		models[i].FromEntity(snapshot)
+	}
+
+	if err := r.db.WithContext(ctx).CreateInBatches(models, 100).Error; err != nil {
+		return fmt.Errorf("failed to create paper equity snapshots: %w", err)
+	}
+
+	for i := range models {
+		snapshots[i].ID = models[i].ID
+	}
+
+	return nil
+}
+
+// GetLatest retrieves the most recent snapshot of a curve (nil if the curve is empty)
+func (r *PaperEquityRepository) GetLatest(ctx context.Context, strategyName string) (*entity.PaperEquitySnapshot, error) {
+	var model PaperEquityModel
+	if err := r.db.WithContext(ctx).
+		Where("strategy_name = ?", strategyName).
+		Order("closed_at DESC, id DESC").
+		First(&model).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get latest paper equity snapshot: %w", err)
+	}
+
+	return model.ToEntity(), nil
+}
+
+// GetCurve retrieves the snapshots of a curve ordered by close time, optionally within a time range
+func (r *PaperEquityRepository) GetCurve(ctx context.Context, strategyName string, start, end *time.Time) ([]*entity.PaperEquitySnapshot, error) {
+	db := r.db.WithContext(ctx).Where("strategy_name = ?", strategyName)
+
+	if start != nil {
+		db = db.Where("closed_at >= ?", *start)
+	}
+	if end != nil {
+		db = db.Where("closed_at <= ?", *end)
+	}
+
+	var models []PaperEquityModel
+	if err := db.Order("closed_at ASC, id ASC").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to get paper equity curve: %w", err)
+	}
+
+	snapshots := make([]*entity.PaperEquitySnapshot, len(models))
+	for i := range models {
+		snapshots[i] = models[i].ToEntity()
+	}
+
+	return snapshots, nil
+}
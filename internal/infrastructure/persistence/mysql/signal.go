@@ -10,6 +10,7 @@ import (
 	"ContractAnalysis/internal/domain/repository" // Added this import
 
 	"github.com/shopspring/decimal"
+	"golang.org/x/sync/errgroup"
 	"gorm.io/gorm"
 )
 
@@ -26,11 +27,17 @@ type SignalModel struct {
 	ShortAccountRatio  decimal.Decimal `gorm:"column:short_account_ratio;type:decimal(10,4);not null"`
 	LongPositionRatio  decimal.Decimal `gorm:"column:long_position_ratio;type:decimal(10,4);not null"`
 	ShortPositionRatio decimal.Decimal `gorm:"column:short_position_ratio;type:decimal(10,4);not null"`
+	OpenInterest       decimal.Decimal `gorm:"column:open_interest;type:decimal(20,8);default:0"`
+	FundingRate        decimal.Decimal `gorm:"column:funding_rate;type:decimal(10,8);default:0"`
+	Volume24h          decimal.Decimal `gorm:"column:volume_24h;type:decimal(20,2);default:0"`
 	ConfirmationStart  time.Time       `gorm:"column:confirmation_start;not null"`
 	ConfirmationEnd    time.Time       `gorm:"column:confirmation_end;not null"`
 	IsConfirmed        bool            `gorm:"column:is_confirmed;default:false"`
 	ConfirmedAt        *time.Time      `gorm:"column:confirmed_at"`
+	EntryPrice         decimal.Decimal `gorm:"column:entry_price;type:decimal(20,8);default:0"`
 	Status             string          `gorm:"column:status;size:20;not null;index:idx_symbol_status;index:idx_status_generated"`
+	InvalidatedAt      *time.Time      `gorm:"column:invalidated_at"`
+	InvalidationReason string          `gorm:"column:invalidation_reason;size:255;default:''"`
 	Reason             string          `gorm:"column:reason;type:text"`
 	ConfigSnapshot     string          `gorm:"column:config_snapshot;type:json"`
 	StopLossPrice      decimal.Decimal `gorm:"column:stop_loss_price;type:decimal(20,8);default:0"`
@@ -38,6 +45,8 @@ type SignalModel struct {
 	TargetPrice2       decimal.Decimal `gorm:"column:target_price_2;type:decimal(20,8);default:0"`
 	ExitPrice          decimal.Decimal `gorm:"column:exit_price;type:decimal(20,8);default:0"`
 	ExitReason         string          `gorm:"column:exit_reason;size:255;default:''"`
+	RiskRewardRatio    decimal.Decimal `gorm:"column:risk_reward_ratio;type:decimal(10,4);default:0"`
+	PositionSizePct    decimal.Decimal `gorm:"column:position_size_pct;type:decimal(10,4);default:0"`
 	CreatedAt          time.Time       `gorm:"column:created_at;autoCreateTime"`
 	UpdatedAt          time.Time       `gorm:"column:updated_at;autoUpdateTime"`
 }
@@ -68,11 +77,17 @@ func (m *SignalModel) ToEntity() (*entity.Signal, error) {
 		ShortAccountRatio:  m.ShortAccountRatio,
 		LongPositionRatio:  m.LongPositionRatio,
 		ShortPositionRatio: m.ShortPositionRatio,
+		OpenInterest:       m.OpenInterest,
+		FundingRate:        m.FundingRate,
+		Volume24h:          m.Volume24h,
 		ConfirmationStart:  m.ConfirmationStart,
 		ConfirmationEnd:    m.ConfirmationEnd,
 		IsConfirmed:        m.IsConfirmed,
 		ConfirmedAt:        m.ConfirmedAt,
+		EntryPrice:         m.EntryPrice,
 		Status:             entity.SignalStatus(m.Status),
+		InvalidatedAt:      m.InvalidatedAt,
+		InvalidationReason: m.InvalidationReason,
 		Reason:             m.Reason,
 		ConfigSnapshot:     configSnapshot,
 		StopLossPrice:      m.StopLossPrice,
@@ -80,6 +95,8 @@ func (m *SignalModel) ToEntity() (*entity.Signal, error) {
 		TargetPrice2:       m.TargetPrice2,
 		ExitPrice:          m.ExitPrice,
 		ExitReason:         m.ExitReason,
+		RiskRewardRatio:    m.RiskRewardRatio,
+		PositionSizePct:    m.PositionSizePct,
 		CreatedAt:          m.CreatedAt,
 		UpdatedAt:          m.UpdatedAt,
 	}, nil
@@ -107,11 +124,17 @@ func (m *SignalModel) FromEntity(entity *entity.Signal) error {
 	m.ShortAccountRatio = entity.ShortAccountRatio
 	m.LongPositionRatio = entity.LongPositionRatio
 	m.ShortPositionRatio = entity.ShortPositionRatio
+	m.OpenInterest = entity.OpenInterest
+	m.FundingRate = entity.FundingRate
+	m.Volume24h = entity.Volume24h
 	m.ConfirmationStart = entity.ConfirmationStart
 	m.ConfirmationEnd = entity.ConfirmationEnd
 	m.IsConfirmed = entity.IsConfirmed
 	m.ConfirmedAt = entity.ConfirmedAt
+	m.EntryPrice = entity.EntryPrice
 	m.Status = string(entity.Status)
+	m.InvalidatedAt = entity.InvalidatedAt
+	m.InvalidationReason = entity.InvalidationReason
 	m.Reason = entity.Reason
 	m.ConfigSnapshot = configSnapshotJSON
 	m.StopLossPrice = entity.StopLossPrice
@@ -119,6 +142,8 @@ func (m *SignalModel) FromEntity(entity *entity.Signal) error {
 	m.TargetPrice2 = entity.TargetPrice2
 	m.ExitPrice = entity.ExitPrice
 	m.ExitReason = entity.ExitReason
+	m.RiskRewardRatio = entity.RiskRewardRatio
+	m.PositionSizePct = entity.PositionSizePct
 
 	return nil
 }
@@ -182,19 +207,23 @@ func (m *SignalTrackingModel) FromEntity(entity *entity.SignalTracking) {
 
 // SignalOutcomeModel represents the signal_outcomes table
 type SignalOutcomeModel struct {
-	ID                  int64           `gorm:"column:id;primaryKey;autoIncrement"`
-	SignalID            string          `gorm:"column:signal_id;uniqueIndex;size:36;not null"`
-	Outcome             string          `gorm:"column:outcome;size:20;not null;index"`
-	MaxFavorableMovePct decimal.Decimal `gorm:"column:max_favorable_move_pct;type:decimal(10,4);not null"`
-	MaxAdverseMovePct   decimal.Decimal `gorm:"column:max_adverse_move_pct;type:decimal(10,4);not null"`
-	FinalPriceChangePct decimal.Decimal `gorm:"column:final_price_change_pct;type:decimal(10,4);not null"`
-	HoursToPeak         *int            `gorm:"column:hours_to_peak"`
-	HoursToTrough       *int            `gorm:"column:hours_to_trough"`
-	TotalTrackingHours  int             `gorm:"column:total_tracking_hours;not null"`
-	ProfitTargetHit     bool            `gorm:"column:profit_target_hit;default:false"`
-	StopLossHit         bool            `gorm:"column:stop_loss_hit;default:false"`
-	ClosedAt            time.Time       `gorm:"column:closed_at;not null;index"`
-	CreatedAt           time.Time       `gorm:"column:created_at;autoCreateTime"`
+	ID                   int64            `gorm:"column:id;primaryKey;autoIncrement"`
+	SignalID             string           `gorm:"column:signal_id;uniqueIndex;size:36;not null"`
+	Outcome              string           `gorm:"column:outcome;size:20;not null;index"`
+	MaxFavorableMovePct  decimal.Decimal  `gorm:"column:max_favorable_move_pct;type:decimal(10,4);not null"`
+	MaxAdverseMovePct    decimal.Decimal  `gorm:"column:max_adverse_move_pct;type:decimal(10,4);not null"`
+	FinalPriceChangePct  decimal.Decimal  `gorm:"column:final_price_change_pct;type:decimal(10,4);not null"`
+	HoursToPeak          *int             `gorm:"column:hours_to_peak"`
+	HoursToTrough        *int             `gorm:"column:hours_to_trough"`
+	TotalTrackingHours   int              `gorm:"column:total_tracking_hours;not null"`
+	HoursToProfitTarget  *int             `gorm:"column:hours_to_profit_target"`
+	HoursToStopLoss      *int             `gorm:"column:hours_to_stop_loss"`
+	HoursToOptimalExit   *int             `gorm:"column:hours_to_optimal_exit"`
+	OptimalExitChangePct *decimal.Decimal `gorm:"column:optimal_exit_change_pct;type:decimal(10,4)"`
+	ProfitTargetHit      bool             `gorm:"column:profit_target_hit;default:false"`
+	StopLossHit          bool             `gorm:"column:stop_loss_hit;default:false"`
+	ClosedAt             time.Time        `gorm:"column:closed_at;not null;index"`
+	CreatedAt            time.Time        `gorm:"column:created_at;autoCreateTime"`
 }
 
 // TableName specifies the table name
@@ -205,19 +234,23 @@ func (SignalOutcomeModel) TableName() string {
 // ToEntity converts model to domain entity
 func (m *SignalOutcomeModel) ToEntity() *entity.SignalOutcome {
 	return &entity.SignalOutcome{
-		ID:                  m.ID,
-		SignalID:            m.SignalID,
-		Outcome:             m.Outcome,
-		MaxFavorableMovePct: m.MaxFavorableMovePct,
-		MaxAdverseMovePct:   m.MaxAdverseMovePct,
-		FinalPriceChangePct: m.FinalPriceChangePct,
-		HoursToPeak:         m.HoursToPeak,
-		HoursToTrough:       m.HoursToTrough,
-		TotalTrackingHours:  m.TotalTrackingHours,
-		ProfitTargetHit:     m.ProfitTargetHit,
-		StopLossHit:         m.StopLossHit,
-		ClosedAt:            m.ClosedAt,
-		CreatedAt:           m.CreatedAt,
+		ID:                   m.ID,
+		SignalID:             m.SignalID,
+		Outcome:              m.Outcome,
+		MaxFavorableMovePct:  m.MaxFavorableMovePct,
+		MaxAdverseMovePct:    m.MaxAdverseMovePct,
+		FinalPriceChangePct:  m.FinalPriceChangePct,
+		HoursToPeak:          m.HoursToPeak,
+		HoursToTrough:        m.HoursToTrough,
+		TotalTrackingHours:   m.TotalTrackingHours,
+		HoursToProfitTarget:  m.HoursToProfitTarget,
+		HoursToStopLoss:      m.HoursToStopLoss,
+		HoursToOptimalExit:   m.HoursToOptimalExit,
+		OptimalExitChangePct: m.OptimalExitChangePct,
+		ProfitTargetHit:      m.ProfitTargetHit,
+		StopLossHit:          m.StopLossHit,
+		ClosedAt:             m.ClosedAt,
+		CreatedAt:            m.CreatedAt,
 	}
 }
 
@@ -232,11 +265,49 @@ func (m *SignalOutcomeModel) FromEntity(entity *entity.SignalOutcome) {
 	m.HoursToPeak = entity.HoursToPeak
 	m.HoursToTrough = entity.HoursToTrough
 	m.TotalTrackingHours = entity.TotalTrackingHours
+	m.HoursToProfitTarget = entity.HoursToProfitTarget
+	m.HoursToStopLoss = entity.HoursToStopLoss
+	m.HoursToOptimalExit = entity.HoursToOptimalExit
+	m.OptimalExitChangePct = entity.OptimalExitChangePct
 	m.ProfitTargetHit = entity.ProfitTargetHit
 	m.StopLossHit = entity.StopLossHit
 	m.ClosedAt = entity.ClosedAt
 }
 
+// SignalNoteModel represents the signal_notes table
+type SignalNoteModel struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	SignalID  string    `gorm:"column:signal_id;size:36;not null;index"`
+	Author    string    `gorm:"column:author;size:50;not null"`
+	Text      string    `gorm:"column:text;type:text;not null"`
+	CreatedAt time.Time `gorm:"column:created_at;not null;index"`
+}
+
+// TableName specifies the table name
+func (SignalNoteModel) TableName() string {
+	return "signal_notes"
+}
+
+// ToEntity converts model to domain entity
+func (m *SignalNoteModel) ToEntity() *entity.SignalNote {
+	return &entity.SignalNote{
+		ID:        m.ID,
+		SignalID:  m.SignalID,
+		Author:    m.Author,
+		Text:      m.Text,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+// FromEntity converts domain entity to model
+func (m *SignalNoteModel) FromEntity(entity *entity.SignalNote) {
+	m.ID = entity.ID
+	m.SignalID = entity.SignalID
+	m.Author = entity.Author
+	m.Text = entity.Text
+	m.CreatedAt = entity.CreatedAt
+}
+
 // SignalKlineTrackingModel represents the signal_kline_tracking table
 type SignalKlineTrackingModel struct {
 	ID                    int64           `gorm:"column:id;primaryKey;autoIncrement"`
@@ -328,6 +399,13 @@ func NewSignalRepository(db *gorm.DB) *SignalRepository {
 	return &SignalRepository{db: db}
 }
 
+// WithTransaction runs fn with a repository bound to a single transaction
+func (r *SignalRepository) WithTransaction(ctx context.Context, fn func(txRepo repository.SignalRepository) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(NewSignalRepository(tx))
+	})
+}
+
 // Create creates a new signal
 func (r *SignalRepository) Create(ctx context.Context, signal *entity.Signal) error {
 	model := &SignalModel{}
@@ -365,11 +443,17 @@ func (r *SignalRepository) Update(ctx context.Context, signal *entity.Signal) er
 			"short_account_ratio":  model.ShortAccountRatio,
 			"long_position_ratio":  model.LongPositionRatio,
 			"short_position_ratio": model.ShortPositionRatio,
+			"open_interest":        model.OpenInterest,
+			"funding_rate":         model.FundingRate,
+			"volume_24h":           model.Volume24h,
 			"confirmation_start":   model.ConfirmationStart,
 			"confirmation_end":     model.ConfirmationEnd,
 			"is_confirmed":         model.IsConfirmed,
 			"confirmed_at":         model.ConfirmedAt,
+			"entry_price":          model.EntryPrice,
 			"status":               model.Status,
+			"invalidated_at":       model.InvalidatedAt,
+			"invalidation_reason":  model.InvalidationReason,
 			"reason":               model.Reason,
 			"config_snapshot":      model.ConfigSnapshot,
 			"stop_loss_price":      model.StopLossPrice,
@@ -377,6 +461,8 @@ func (r *SignalRepository) Update(ctx context.Context, signal *entity.Signal) er
 			"target_price_2":       model.TargetPrice2,
 			"exit_price":           model.ExitPrice,
 			"exit_reason":          model.ExitReason,
+			"risk_reward_ratio":    model.RiskRewardRatio,
+			"position_size_pct":    model.PositionSizePct,
 		}).Error; err != nil {
 		return fmt.Errorf("failed to update signal: %w", err)
 	}
@@ -397,6 +483,60 @@ func (r *SignalRepository) GetByID(ctx context.Context, signalID string) (*entit
 	return model.ToEntity()
 }
 
+// GetSignalDetail retrieves a signal with its tracking, kline tracking, outcome and notes
+// The related rows are loaded in parallel once the signal is found
+func (r *SignalRepository) GetSignalDetail(ctx context.Context, signalID string) (*repository.SignalDetail, error) {
+	signal, err := r.GetByID(ctx, signalID)
+	if err != nil {
+		return nil, err
+	}
+	if signal == nil {
+		return nil, nil
+	}
+
+	detail := &repository.SignalDetail{Signal: signal}
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		detail.Tracking, err = r.GetAllTracking(gctx, signalID)
+		return err
+	})
+	g.Go(func() (err error) {
+		detail.KlineTracking, err = r.GetKlineTrackingBySignal(gctx, signalID)
+		return err
+	})
+	g.Go(func() (err error) {
+		detail.Outcome, err = r.GetOutcome(gctx, signalID)
+		return err
+	})
+	g.Go(func() (err error) {
+		detail.Notes, err = r.GetNotes(gctx, signalID)
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		return nil, fmt.Errorf("failed to get signal detail: %w", err)
+	}
+
+	return detail, nil
+}
+
+// GetByIDs retrieves multiple signals by their UUIDs
+func (r *SignalRepository) GetByIDs(ctx context.Context, signalIDs []string) ([]*entity.Signal, error) {
+	if len(signalIDs) == 0 {
+		return []*entity.Signal{}, nil
+	}
+
+	var models []SignalModel
+	if err := r.db.WithContext(ctx).
+		Where("signal_id IN ?", signalIDs).
+		Order("generated_at DESC").
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to get signals by IDs: %w", err)
+	}
+
+	return r.modelsToEntities(models)
+}
+
 // GetSignalsWithFilters retrieves signals based on provided filters and applies pagination
 func (r *SignalRepository) GetSignalsWithFilters(ctx context.Context, filters repository.SignalFilterParams, offset, limit int) ([]*entity.Signal, int, error) {
 	var models []SignalModel
@@ -469,6 +609,9 @@ func (r *SignalRepository) GetSignalsWithOutcomes(ctx context.Context, filters r
 	if filters.Type != "" {
 		db = db.Where("signals.signal_type = ?", filters.Type)
 	}
+	if filters.Outcome != "" {
+		db = db.Where("signal_outcomes.outcome = ?", filters.Outcome)
+	}
 	if filters.StartTime != nil {
 		db = db.Where("signals.generated_at >= ?", *filters.StartTime)
 	}
@@ -621,6 +764,87 @@ func (r *SignalRepository) CountActiveSignalsBySymbol(ctx context.Context, symbo
 	return int(count), nil
 }
 
+// CountActiveSignalsBySymbolStrategyType counts active signals for a symbol, strategy and direction
+func (r *SignalRepository) CountActiveSignalsBySymbolStrategyType(ctx context.Context, symbol, strategyName string, signalType entity.SignalType) (int, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).
+		Model(&SignalModel{}).
+		Where("symbol = ? AND strategy_name = ? AND signal_type = ? AND status IN ?", symbol, strategyName, string(signalType), []string{
+			string(entity.SignalStatusPending),
+			string(entity.SignalStatusConfirmed),
+			string(entity.SignalStatusTracking),
+		}).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count active signals: %w", err)
+	}
+
+	return int(count), nil
+}
+
+// CountSignalsByStrategySince counts the signals a strategy generated at or after since, in any status
+func (r *SignalRepository) CountSignalsByStrategySince(ctx context.Context, strategyName string, since time.Time) (int, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).
+		Model(&SignalModel{}).
+		Where("strategy_name = ? AND generated_at >= ?", strategyName, since).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count strategy signals: %w", err)
+	}
+
+	return int(count), nil
+}
+
+// GetActiveSignalBySymbolStrategyType retrieves the most recent active signal for a symbol, strategy and direction
+func (r *SignalRepository) GetActiveSignalBySymbolStrategyType(ctx context.Context, symbol, strategyName string, signalType entity.SignalType) (*entity.Signal, error) {
+	var model SignalModel
+	if err := r.db.WithContext(ctx).
+		Where("symbol = ? AND strategy_name = ? AND signal_type = ? AND status IN ?", symbol, strategyName, string(signalType), []string{
+			string(entity.SignalStatusPending),
+			string(entity.SignalStatusConfirmed),
+			string(entity.SignalStatusTracking),
+		}).
+		Order("generated_at DESC").
+		First(&model).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get active signal: %w", err)
+	}
+
+	return model.ToEntity()
+}
+
+// GetLatestClosedSignal retrieves the most recently closed signal for a symbol, strategy and direction
+func (r *SignalRepository) GetLatestClosedSignal(ctx context.Context, symbol, strategyName string, signalType entity.SignalType) (*repository.SignalWithOutcome, error) {
+	var outcomeModel SignalOutcomeModel
+	if err := r.db.WithContext(ctx).
+		Table("signal_outcomes").
+		Select("signal_outcomes.*").
+		Joins("INNER JOIN signals ON signal_outcomes.signal_id = signals.signal_id").
+		Where("signals.symbol = ? AND signals.strategy_name = ? AND signals.signal_type = ? AND signals.status = ?",
+			symbol, strategyName, string(signalType), string(entity.SignalStatusClosed)).
+		Order("signal_outcomes.closed_at DESC").
+		First(&outcomeModel).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get latest closed signal: %w", err)
+	}
+
+	signal, err := r.GetByID(ctx, outcomeModel.SignalID)
+	if err != nil {
+		return nil, err
+	}
+	if signal == nil {
+		return nil, nil
+	}
+
+	return &repository.SignalWithOutcome{
+		Signal:  signal,
+		Outcome: outcomeModel.ToEntity(),
+	}, nil
+}
+
 // GetSignalsInTimeRange retrieves signals generated within a time range
 func (r *SignalRepository) GetSignalsInTimeRange(ctx context.Context, start, end time.Time) ([]*entity.Signal, error) {
 	var models []SignalModel
@@ -663,6 +887,32 @@ func (r *SignalRepository) CreateTracking(ctx context.Context, tracking *entity.
 	return nil
 }
 
+// UpdateTracking overwrites an existing signal tracking record
+func (r *SignalRepository) UpdateTracking(ctx context.Context, tracking *entity.SignalTracking) error {
+	model := &SignalTrackingModel{}
+	model.FromEntity(tracking)
+
+	// Update the observation columns only, leaving created_at untouched
+	if err := r.db.WithContext(ctx).Model(&SignalTrackingModel{}).
+		Where("id = ?", model.ID).
+		Updates(map[string]interface{}{
+			"tracked_at":        model.TrackedAt,
+			"hours_elapsed":     model.HoursElapsed,
+			"current_price":     model.CurrentPrice,
+			"price_change_pct":  model.PriceChangePct,
+			"highest_price":     model.HighestPrice,
+			"highest_price_pct": model.HighestPricePct,
+			"highest_price_at":  model.HighestPriceAt,
+			"lowest_price":      model.LowestPrice,
+			"lowest_price_pct":  model.LowestPricePct,
+			"lowest_price_at":   model.LowestPriceAt,
+		}).Error; err != nil {
+		return fmt.Errorf("failed to update tracking: %w", err)
+	}
+
+	return nil
+}
+
 // GetLatestTracking retrieves the latest tracking record for a signal
 func (r *SignalRepository) GetLatestTracking(ctx context.Context, signalID string) (*entity.SignalTracking, error) {
 	var model SignalTrackingModel
@@ -710,6 +960,32 @@ func (r *SignalRepository) CreateOutcome(ctx context.Context, outcome *entity.Si
 	return nil
 }
 
+// CreateOutcomesBatch creates multiple signal outcomes in a batch
+func (r *SignalRepository) CreateOutcomesBatch(ctx context.Context, outcomes []*entity.SignalOutcome) error {
+	if len(outcomes) == 0 {
+		return nil
+	}
+
+	models := make([]*SignalOutcomeModel, len(outcomes))
+	for i, outcome := range outcomes {
+		model := &SignalOutcomeModel{}
+		model.FromEntity(outcome)
+		models[i] = model
+	}
+
+	// Insert the whole batch with a single multi-row statement
+	if err := r.db.WithContext(ctx).Create(&models).Error; err != nil {
+		return fmt.Errorf("failed to create outcome batch: %w", err)
+	}
+
+	// Update IDs
+	for i, model := range models {
+		outcomes[i].ID = model.ID
+	}
+
+	return nil
+}
+
 // GetOutcome retrieves the outcome for a signal
 func (r *SignalRepository) GetOutcome(ctx context.Context, signalID string) (*entity.SignalOutcome, error) {
 	var model SignalOutcomeModel
@@ -782,6 +1058,68 @@ func (r *SignalRepository) GetOutcomesByStrategy(ctx context.Context, strategyNa
 	return outcomes, nil
 }
 
+// GetOutcomeTimeseries aggregates outcomes into daily or weekly buckets by close time, oldest first
+func (r *SignalRepository) GetOutcomeTimeseries(ctx context.Context, bucket string, strategyName *string, start, end *time.Time) ([]*repository.OutcomeBucket, error) {
+	var rows []outcomeBucketRow
+	if err := outcomeTimeseriesQuery(r.db.WithContext(ctx), bucket, strategyName, start, end).
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to get outcome timeseries: %w", err)
+	}
+
+	buckets := make([]*repository.OutcomeBucket, len(rows))
+	for i, row := range rows {
+		buckets[i] = &repository.OutcomeBucket{
+			BucketStart:       row.BucketStart,
+			ClosedSignals:     row.ClosedSignals,
+			ProfitableSignals: row.ProfitableSignals,
+			LosingSignals:     row.LosingSignals,
+			NetReturnPct:      row.NetReturnPct,
+		}
+	}
+	return buckets, nil
+}
+
+// outcomeBucketRow is a bucket of the outcome timeseries as aggregated by MySQL
+type outcomeBucketRow struct {
+	BucketStart       time.Time
+	ClosedSignals     int
+	ProfitableSignals int
+	LosingSignals     int
+	NetReturnPct      decimal.Decimal
+}
+
+// outcomeTimeseriesQuery groups outcomes by their UTC close date, or by the Monday of its
+// week for weekly buckets. Buckets without outcomes are left out
+func outcomeTimeseriesQuery(db *gorm.DB, bucket string, strategyName *string, start, end *time.Time) *gorm.DB {
+	bucketStart := "DATE(signal_outcomes.closed_at)"
+	if bucket == repository.OutcomeBucketWeekly {
+		bucketStart = "DATE_SUB(DATE(signal_outcomes.closed_at), INTERVAL WEEKDAY(signal_outcomes.closed_at) DAY)"
+	}
+
+	db = db.Table("signal_outcomes").
+		Joins("INNER JOIN signals ON signal_outcomes.signal_id = signals.signal_id")
+
+	if strategyName != nil {
+		db = db.Where("signals.strategy_name = ?", *strategyName)
+	}
+	if start != nil {
+		db = db.Where("signal_outcomes.closed_at >= ?", *start)
+	}
+	if end != nil {
+		db = db.Where("signal_outcomes.closed_at <= ?", *end)
+	}
+
+	return db.
+		Select(bucketStart+` AS bucket_start,
+			COUNT(*) AS closed_signals,
+			SUM(CASE WHEN signal_outcomes.outcome = ? THEN 1 ELSE 0 END) AS profitable_signals,
+			SUM(CASE WHEN signal_outcomes.outcome = ? THEN 1 ELSE 0 END) AS losing_signals,
+			SUM(signal_outcomes.final_price_change_pct) AS net_return_pct`,
+			string(entity.OutcomeProfit), string(entity.OutcomeLoss)).
+		Group("bucket_start").
+		Order("bucket_start ASC")
+}
+
 // modelsToEntities converts signal models to entities
 func (r *SignalRepository) modelsToEntities(models []SignalModel) ([]*entity.Signal, error) {
 	signals := make([]*entity.Signal, len(models))
@@ -810,6 +1148,33 @@ func (r *SignalRepository) CreateKlineTracking(ctx context.Context, tracking *en
 	return nil
 }
 
+// CreateKlineTrackingBatch creates multiple kline tracking records in a batch
+func (r *SignalRepository) CreateKlineTrackingBatch(ctx context.Context, trackings []*entity.SignalKlineTracking) error {
+	if len(trackings) == 0 {
+		return nil
+	}
+
+	models := make([]*SignalKlineTrackingModel, len(trackings))
+	for i, tracking := range trackings {
+		model := &SignalKlineTrackingModel{}
+		model.FromEntity(tracking)
+		models[i] = model
+	}
+
+	// Use batch insert for better performance
+	batchSize := 100
+	if err := r.db.WithContext(ctx).CreateInBatches(models, batchSize).Error; err != nil {
+		return fmt.Errorf("failed to create kline tracking batch: %w", err)
+	}
+
+	// Update IDs
+	for i, model := range models {
+		trackings[i].ID = model.ID
+	}
+
+	return nil
+}
+
 // GetLatestKlineTracking retrieves the latest kline tracking record for a signal
 func (r *SignalRepository) GetLatestKlineTracking(ctx context.Context, signalID string) (*entity.SignalKlineTracking, error) {
 	var model SignalKlineTrackingModel
@@ -861,3 +1226,36 @@ func (r *SignalRepository) GetKlineTrackingInTimeRange(ctx context.Context, star
 
 	return trackings, nil
 }
+
+// Note methods
+
+// AddNote attaches a review note to a signal
+func (r *SignalRepository) AddNote(ctx context.Context, note *entity.SignalNote) error {
+	model := &SignalNoteModel{}
+	model.FromEntity(note)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return fmt.Errorf("failed to add signal note: %w", err)
+	}
+
+	note.ID = model.ID
+	return nil
+}
+
+// GetNotes retrieves all notes for a signal, oldest first
+func (r *SignalRepository) GetNotes(ctx context.Context, signalID string) ([]*entity.SignalNote, error) {
+	var models []SignalNoteModel
+	if err := r.db.WithContext(ctx).
+		Where("signal_id = ?", signalID).
+		Order("created_at ASC, id ASC").
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to get signal notes: %w", err)
+	}
+
+	notes := make([]*entity.SignalNote, len(models))
+	for i, model := range models {
+		notes[i] = model.ToEntity()
+	}
+
+	return notes, nil
+}
@@ -0,0 +1,179 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"ContractAnalysis/internal/domain/entity"
+	"ContractAnalysis/internal/domain/repository"
+
+	gormmysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestOutcomeTimeseriesQueryGroupsInSQL(t *testing.T) {
+	repo := newFakeInsertRepository(t, &fakeInsertPool{})
+	strategy := "Minority"
+	start := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 3, 18, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		bucket      string
+		bucketStart string
+	}{
+		{repository.OutcomeBucketDaily, "DATE(signal_outcomes.closed_at) AS bucket_start"},
+		{repository.OutcomeBucketWeekly, "DATE_SUB(DATE(signal_outcomes.closed_at), INTERVAL WEEKDAY(signal_outcomes.closed_at) DAY) AS bucket_start"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.bucket, func(t *testing.T) {
+			query := repo.db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				var rows []outcomeBucketRow
+				return outcomeTimeseriesQuery(tx, tt.bucket, &strategy, &start, &end).Scan(&rows)
+			})
+
+			for _, want := range []string{
+				tt.bucketStart,
+				"COUNT(*) AS closed_signals",
+				"signal_outcomes.outcome = 'PROFIT'",
+				"signal_outcomes.outcome = 'LOSS'",
+				"SUM(signal_outcomes.final_price_change_pct) AS net_return_pct",
+				"signals.strategy_name = 'Minority'",
+				"signal_outcomes.closed_at >= '2024-03-04 00:00:00'",
+				"signal_outcomes.closed_at <= '2024-03-18 00:00:00'",
+				"GROUP BY `bucket_start` ORDER BY bucket_start ASC",
+			} {
+				if !strings.Contains(query, want) {
+					t.Errorf("query is missing %q:\n%s", want, query)
+				}
+			}
+		})
+	}
+}
+
+// fakeInsertPool stands in for MySQL on INSERTs, assigning consecutive auto-increment IDs
+type fakeInsertPool struct {
+	nextID  int64
+	inserts int
+}
+
+type fakeInsertResult struct {
+	lastInsertID int64
+	rows         int64
+}
+
+func (r fakeInsertResult) LastInsertId() (int64, error) { return r.lastInsertID, nil }
+func (r fakeInsertResult) RowsAffected() (int64, error) { return r.rows, nil }
+
+func (p *fakeInsertPool) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if !strings.HasPrefix(query, "INSERT") {
+		return nil, fmt.Errorf("unexpected query: %s", query)
+	}
+	// MySQL reports the ID of the first row of a multi-row insert
+	rows := int64(strings.Count(query, "),(") + 1)
+	result := fakeInsertResult{lastInsertID: p.nextID, rows: rows}
+	p.nextID += rows
+	p.inserts++
+	return result, nil
+}
+
+func (p *fakeInsertPool) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return nil, errors.New("not supported")
+}
+
+func (p *fakeInsertPool) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return nil, errors.New("not supported")
+}
+
+func (p *fakeInsertPool) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return nil
+}
+
+// newFakeInsertRepository returns a signal repository backed by a fakeInsertPool
+func newFakeInsertRepository(t *testing.T, pool *fakeInsertPool) *SignalRepository {
+	t.Helper()
+
+	db, err := gorm.Open(gormmysql.New(gormmysql.Config{Conn: pool, SkipInitializeWithVersion: true}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open: %v", err)
+	}
+	return NewSignalRepository(db)
+}
+
+func TestCreateOutcomesBatchPopulatesIDs(t *testing.T) {
+	pool := &fakeInsertPool{nextID: 500}
+	repo := newFakeInsertRepository(t, pool)
+
+	closedAt := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	outcomes := make([]*entity.SignalOutcome, 150)
+	for i := range outcomes {
+		outcomes[i] = &entity.SignalOutcome{
+			SignalID: fmt.Sprintf("signal-%d", i),
+			Outcome:  string(entity.OutcomeProfit),
+			ClosedAt: closedAt,
+		}
+	}
+
+	if err := repo.CreateOutcomesBatch(context.Background(), outcomes); err != nil {
+		t.Fatalf("CreateOutcomesBatch: %v", err)
+	}
+	if pool.inserts != 1 {
+		t.Errorf("ran %d inserts, want a single multi-row insert", pool.inserts)
+	}
+	for i, outcome := range outcomes {
+		if want := int64(500 + i); outcome.ID != want {
+			t.Fatalf("outcomes[%d].ID = %d, want %d", i, outcome.ID, want)
+		}
+	}
+
+	if err := repo.CreateOutcomesBatch(context.Background(), nil); err != nil {
+		t.Errorf("empty batch: %v", err)
+	}
+	if pool.inserts != 1 {
+		t.Errorf("empty batch ran an insert")
+	}
+}
+
+func TestCreateKlineTrackingBatchPopulatesIDs(t *testing.T) {
+	pool := &fakeInsertPool{nextID: 1000}
+	repo := newFakeInsertRepository(t, pool)
+
+	// More than one batch of 100
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	trackings := make([]*entity.SignalKlineTracking, 250)
+	for i := range trackings {
+		trackings[i] = &entity.SignalKlineTracking{
+			SignalID:       "signal-1",
+			KlineOpenTime:  start.Add(time.Duration(i) * time.Hour),
+			KlineCloseTime: start.Add(time.Duration(i+1) * time.Hour),
+		}
+	}
+
+	if err := repo.CreateKlineTrackingBatch(context.Background(), trackings); err != nil {
+		t.Fatalf("CreateKlineTrackingBatch: %v", err)
+	}
+	if pool.inserts != 3 {
+		t.Errorf("ran %d inserts, want 3", pool.inserts)
+	}
+	for i, tracking := range trackings {
+		if want := int64(1000 + i); tracking.ID != want {
+			t.Fatalf("trackings[%d].ID = %d, want %d", i, tracking.ID, want)
+		}
+	}
+
+	if err := repo.CreateKlineTrackingBatch(context.Background(), nil); err != nil {
+		t.Errorf("empty batch: %v", err)
+	}
+	if pool.inserts != 3 {
+		t.Errorf("empty batch ran an insert")
+	}
+}
@@ -3,6 +3,7 @@ package mysql
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -32,10 +33,17 @@ type StrategyStatisticsModel struct {
 	AvgProfitPct       *decimal.Decimal `gorm:"column:avg_profit_pct;type:decimal(10,4)"`
 	AvgLossPct         *decimal.Decimal `gorm:"column:avg_loss_pct;type:decimal(10,4)"`
 	AvgHoldingHours    *decimal.Decimal `gorm:"column:avg_holding_hours;type:decimal(10,2)"`
+	MinHoldingHours    *decimal.Decimal `gorm:"column:min_holding_hours;type:decimal(10,2)"`
+	MaxHoldingHours    *decimal.Decimal `gorm:"column:max_holding_hours;type:decimal(10,2)"`
+	AvgReturnPct       *decimal.Decimal `gorm:"column:avg_return_pct;type:decimal(10,4)"`
 	BestSignalPct      *decimal.Decimal `gorm:"column:best_signal_pct;type:decimal(10,4)"`
 	WorstSignalPct     *decimal.Decimal `gorm:"column:worst_signal_pct;type:decimal(10,4)"`
 	ProfitFactor       *decimal.Decimal `gorm:"column:profit_factor;type:decimal(10,4)"`
 
+	// Volume-weighted performance
+	VolumeWeightedWinRate      *decimal.Decimal `gorm:"column:volume_weighted_win_rate;type:decimal(10,4)"`
+	VolumeWeightedAvgReturnPct *decimal.Decimal `gorm:"column:volume_weighted_avg_return_pct;type:decimal(10,4)"`
+
 	// Kline-based win rate metrics
 	KlineTheoreticalWinRate   *decimal.Decimal `gorm:"column:kline_theoretical_win_rate;type:decimal(10,4)"`
 	KlineCloseWinRate         *decimal.Decimal `gorm:"column:kline_close_win_rate;type:decimal(10,4)"`
@@ -52,6 +60,15 @@ type StrategyStatisticsModel struct {
 	AvgMaxPotentialProfitPct *decimal.Decimal `gorm:"column:avg_max_potential_profit_pct;type:decimal(10,4)"`
 	AvgMaxPotentialLossPct   *decimal.Decimal `gorm:"column:avg_max_potential_loss_pct;type:decimal(10,4)"`
 
+	AvgHoursToTarget    *decimal.Decimal `gorm:"column:avg_hours_to_target;type:decimal(10,2)"`
+	AvgOptimalExitHours *decimal.Decimal `gorm:"column:avg_optimal_exit_hours;type:decimal(10,2)"`
+
+	// Return distribution (JSON encoded repository.ReturnPercentiles)
+	Percentiles *string `gorm:"column:percentiles;type:json"`
+
+	// Holding time distribution (JSON encoded []repository.HoldingTimeBucket)
+	HoldingTimeHistogram *string `gorm:"column:holding_time_histogram;type:json"`
+
 	CalculatedAt time.Time `gorm:"column:calculated_at;autoCreateTime;index"`
 }
 
@@ -67,6 +84,21 @@ func (m *StrategyStatisticsModel) ToEntity() *repository.StrategyStatistics {
 		symbol = &m.Symbol.String
 	}
 
+	var percentiles *repository.ReturnPercentiles
+	if m.Percentiles != nil && *m.Percentiles != "" {
+		var decoded repository.ReturnPercentiles
+		if err := json.Unmarshal([]byte(*m.Percentiles), &decoded); err == nil {
+			percentiles = &decoded
+		}
+	}
+
+	var holdingTimeHistogram []repository.HoldingTimeBucket
+	if m.HoldingTimeHistogram != nil && *m.HoldingTimeHistogram != "" {
+		if err := json.Unmarshal([]byte(*m.HoldingTimeHistogram), &holdingTimeHistogram); err != nil {
+			holdingTimeHistogram = nil
+		}
+	}
+
 	return &repository.StrategyStatistics{
 		ID:                 m.ID,
 		StrategyName:       m.StrategyName,
@@ -84,10 +116,17 @@ func (m *StrategyStatisticsModel) ToEntity() *repository.StrategyStatistics {
 		AvgProfitPct:       m.AvgProfitPct,
 		AvgLossPct:         m.AvgLossPct,
 		AvgHoldingHours:    m.AvgHoldingHours,
+		MinHoldingHours:    m.MinHoldingHours,
+		MaxHoldingHours:    m.MaxHoldingHours,
+		AvgReturnPct:       m.AvgReturnPct,
 		BestSignalPct:      m.BestSignalPct,
 		WorstSignalPct:     m.WorstSignalPct,
 		ProfitFactor:       m.ProfitFactor,
 
+		// Volume-weighted performance
+		VolumeWeightedWinRate:      m.VolumeWeightedWinRate,
+		VolumeWeightedAvgReturnPct: m.VolumeWeightedAvgReturnPct,
+
 		// Kline-based win rate metrics
 		KlineTheoreticalWinRate:   m.KlineTheoreticalWinRate,
 		KlineCloseWinRate:         m.KlineCloseWinRate,
@@ -104,6 +143,11 @@ func (m *StrategyStatisticsModel) ToEntity() *repository.StrategyStatistics {
 		AvgMaxPotentialProfitPct: m.AvgMaxPotentialProfitPct,
 		AvgMaxPotentialLossPct:   m.AvgMaxPotentialLossPct,
 
+		AvgHoursToTarget:     m.AvgHoursToTarget,
+		AvgOptimalExitHours:  m.AvgOptimalExitHours,
+		Percentiles:          percentiles,
+		HoldingTimeHistogram: holdingTimeHistogram,
+
 		CalculatedAt: m.CalculatedAt,
 	}
 }
@@ -132,10 +176,17 @@ func (m *StrategyStatisticsModel) FromEntity(entity *repository.StrategyStatisti
 	m.AvgProfitPct = entity.AvgProfitPct
 	m.AvgLossPct = entity.AvgLossPct
 	m.AvgHoldingHours = entity.AvgHoldingHours
+	m.MinHoldingHours = entity.MinHoldingHours
+	m.MaxHoldingHours = entity.MaxHoldingHours
+	m.AvgReturnPct = entity.AvgReturnPct
 	m.BestSignalPct = entity.BestSignalPct
 	m.WorstSignalPct = entity.WorstSignalPct
 	m.ProfitFactor = entity.ProfitFactor
 
+	// Volume-weighted performance
+	m.VolumeWeightedWinRate = entity.VolumeWeightedWinRate
+	m.VolumeWeightedAvgReturnPct = entity.VolumeWeightedAvgReturnPct
+
 	// Kline-based win rate metrics
 	m.KlineTheoreticalWinRate = entity.KlineTheoreticalWinRate
 	m.KlineCloseWinRate = entity.KlineCloseWinRate
@@ -151,6 +202,27 @@ func (m *StrategyStatisticsModel) FromEntity(entity *repository.StrategyStatisti
 	// Theoretical maximum profit/loss
 	m.AvgMaxPotentialProfitPct = entity.AvgMaxPotentialProfitPct
 	m.AvgMaxPotentialLossPct = entity.AvgMaxPotentialLossPct
+
+	m.AvgHoursToTarget = entity.AvgHoursToTarget
+	m.AvgOptimalExitHours = entity.AvgOptimalExitHours
+
+	// Return distribution
+	m.Percentiles = nil
+	if entity.Percentiles != nil {
+		if data, err := json.Marshal(entity.Percentiles); err == nil {
+			encoded := string(data)
+			m.Percentiles = &encoded
+		}
+	}
+
+	// Holding time distribution
+	m.HoldingTimeHistogram = nil
+	if entity.HoldingTimeHistogram != nil {
+		if data, err := json.Marshal(entity.HoldingTimeHistogram); err == nil {
+			encoded := string(data)
+			m.HoldingTimeHistogram = &encoded
+		}
+	}
 }
 
 // StatisticsRepository implements repository.StatisticsRepository
@@ -207,9 +279,14 @@ func (r *StatisticsRepository) CreateOrUpdate(ctx context.Context, stats *reposi
 				"avg_profit_pct",
 				"avg_loss_pct",
 				"avg_holding_hours",
+				"min_holding_hours",
+				"max_holding_hours",
 				"best_signal_pct",
 				"worst_signal_pct",
 				"profit_factor",
+				"avg_return_pct",
+				"volume_weighted_win_rate",
+				"volume_weighted_avg_return_pct",
 				"kline_theoretical_win_rate",
 				"kline_close_win_rate",
 				"total_kline_hours",
@@ -220,6 +297,10 @@ func (r *StatisticsRepository) CreateOrUpdate(ctx context.Context, stats *reposi
 				"min_hourly_return_pct",
 				"avg_max_potential_profit_pct",
 				"avg_max_potential_loss_pct",
+				"avg_hours_to_target",
+				"avg_optimal_exit_hours",
+				"percentiles",
+				"holding_time_histogram",
 				"calculated_at",
 			}),
 		}).
@@ -410,12 +491,13 @@ func (r *StatisticsRepository) GetByTimeRange(
 }
 
 // DeleteOlderThan deletes statistics older than the specified time
-func (r *StatisticsRepository) DeleteOlderThan(ctx context.Context, before time.Time) error {
-	if err := r.db.WithContext(ctx).
+func (r *StatisticsRepository) DeleteOlderThan(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
 		Where("calculated_at < ?", before).
-		Delete(&StrategyStatisticsModel{}).Error; err != nil {
-		return fmt.Errorf("failed to delete old statistics: %w", err)
+		Delete(&StrategyStatisticsModel{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete old statistics: %w", result.Error)
 	}
 
-	return nil
+	return result.RowsAffected, nil
 }
@@ -0,0 +1,133 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ContractAnalysis/internal/domain/repository"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// StatisticsAlertModel represents the statistics_alerts table
+type StatisticsAlertModel struct {
+	ID                   int64           `gorm:"column:id;primaryKey;autoIncrement"`
+	StrategyName         string          `gorm:"column:strategy_name;size:50;not null;index:idx_strategy_period_created,priority:1"`
+	Symbol               *string         `gorm:"column:symbol;size:50"`
+	PeriodLabel          string          `gorm:"column:period_label;size:20;not null;index:idx_strategy_period_created,priority:2"`
+	MetricName           string          `gorm:"column:metric_name;size:50;not null"`
+	PreviousValue        string          `gorm:"column:previous_value;size:50;not null"`
+	CurrentValue         string          `gorm:"column:current_value;size:50;not null"`
+	Change               decimal.Decimal `gorm:"column:change_value;type:decimal(12,4);not null"`
+	ChangeType           string          `gorm:"column:change_type;size:20;not null"`
+	PreviousCalculatedAt time.Time       `gorm:"column:previous_calculated_at;not null"`
+	CurrentCalculatedAt  time.Time       `gorm:"column:current_calculated_at;not null"`
+	CreatedAt            time.Time       `gorm:"column:created_at;autoCreateTime;index:idx_strategy_period_created,priority:3"`
+}
+
+// TableName specifies the table name
+func (StatisticsAlertModel) TableName() string {
+	return "statistics_alerts"
+}
+
+// ToEntity converts model to repository struct
+func (m *StatisticsAlertModel) ToEntity() *repository.StatisticsAlert {
+	return &repository.StatisticsAlert{
+		ID:                   m.ID,
+		StrategyName:         m.StrategyName,
+		Symbol:               m.Symbol,
+		PeriodLabel:          m.PeriodLabel,
+		MetricName:           m.MetricName,
+		PreviousValue:        m.PreviousValue,
+		CurrentValue:         m.CurrentValue,
+		Change:               m.Change,
+		ChangeType:           m.ChangeType,
+		PreviousCalculatedAt: m.PreviousCalculatedAt,
+		CurrentCalculatedAt:  m.CurrentCalculatedAt,
+		CreatedAt:            m.CreatedAt,
+	}
+}
+
+// FromEntity converts repository struct to model
+func (m *StatisticsAlertModel) FromEntity(alert *repository.StatisticsAlert) {
+	m.ID = alert.ID
+	m.StrategyName = alert.StrategyName
+	m.Symbol = alert.Symbol
+	m.PeriodLabel = alert.PeriodLabel
+	m.MetricName = alert.MetricName
+	m.PreviousValue = alert.PreviousValue
+	m.CurrentValue = alert.CurrentValue
+	m.Change = alert.Change
+	m.ChangeType = alert.ChangeType
+	m.PreviousCalculatedAt = alert.PreviousCalculatedAt
+	m.CurrentCalculatedAt = alert.CurrentCalculatedAt
+	m.CreatedAt = alert.CreatedAt
+}
+
+// StatisticsAlertRepository implements repository.StatisticsAlertRepository
+type StatisticsAlertRepository struct {
+	db *gorm.DB
+}
+
+// NewStatisticsAlertRepository creates a new statistics alert repository
+func NewStatisticsAlertRepository(db *gorm.DB) repository.StatisticsAlertRepository {
+	return &StatisticsAlertRepository{db: db}
+}
+
+// CreateBatch creates multiple statistics alerts
+func (r *StatisticsAlertRepository) CreateBatch(ctx context.Context, alerts []*repository.StatisticsAlert) error {
+	if len(alerts) == 0 {
+		return nil
+	}
+
+	models := make([]StatisticsAlertModel, len(alerts))
+	for i, alert := range alerts {
+		models[i].FromEntity(alert)
+	}
+
+	if err := r.db.WithContext(ctx).CreateInBatches(models, 100).Error; err != nil {
+		return fmt.Errorf("failed to create statistics alerts: %w", err)
+	}
+
+	for i := range models {
+		alerts[i].ID = models[i].ID
+		alerts[i].CreatedAt = models[i].CreatedAt
+	}
+
+	return nil
+}
+
+// GetRecent retrieves alerts newest first with optional filtering by strategy and period,
+// and returns the total number of matching alerts
+func (r *StatisticsAlertRepository) GetRecent(ctx context.Context, strategyName, periodLabel *string, offset, limit int) ([]*repository.StatisticsAlert, int, error) {
+	db := r.db.WithContext(ctx).Model(&StatisticsAlertModel{})
+
+	if strategyName != nil {
+		db = db.Where("strategy_name = ?", *strategyName)
+	}
+	if periodLabel != nil {
+		db = db.Where("period_label = ?", *periodLabel)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count statistics alerts: %w", err)
+	}
+
+	var models []StatisticsAlertModel
+	if err := db.Order("created_at DESC, id DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to get statistics alerts: %w", err)
+	}
+
+	alerts := make([]*repository.StatisticsAlert, len(models))
+	for i := range models {
+		alerts[i] = models[i].ToEntity()
+	}
+
+	return alerts, int(total), nil
+}
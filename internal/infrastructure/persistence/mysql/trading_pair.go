@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"time"
 
+	"ContractAnalysis/internal/domain/entity"
 	"ContractAnalysis/internal/domain/repository"
 
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
@@ -17,6 +19,12 @@ type TradingPairModel struct {
 	IsActive  bool      `gorm:"column:is_active;default:true"`
 	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
 	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
+
+	LiquidityTier string     `gorm:"column:liquidity_tier;size:10;index;default:''"`
+	TierUpdatedAt *time.Time `gorm:"column:tier_updated_at"`
+
+	TickSize       decimal.Decimal `gorm:"column:tick_size;type:decimal(20,10);default:0"`
+	PricePrecision int             `gorm:"column:price_precision;default:0"`
 }
 
 // TableName specifies the table name
@@ -27,9 +35,15 @@ func (TradingPairModel) TableName() string {
 // ToEntity converts model to domain entity
 func (m *TradingPairModel) ToEntity() *repository.TradingPair {
 	return &repository.TradingPair{
-		ID:       m.ID,
-		Symbol:   m.Symbol,
-		IsActive: m.IsActive,
+		ID:             m.ID,
+		Symbol:         m.Symbol,
+		IsActive:       m.IsActive,
+		LiquidityTier:  entity.LiquidityTier(m.LiquidityTier),
+		TierUpdatedAt:  m.TierUpdatedAt,
+		TickSize:       m.TickSize,
+		PricePrecision: m.PricePrecision,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
 	}
 }
 
@@ -38,6 +52,11 @@ func (m *TradingPairModel) FromEntity(entity *repository.TradingPair) {
 	m.ID = entity.ID
 	m.Symbol = entity.Symbol
 	m.IsActive = entity.IsActive
+	m.LiquidityTier = string(entity.LiquidityTier)
+	m.TierUpdatedAt = entity.TierUpdatedAt
+	m.TickSize = entity.TickSize
+	m.PricePrecision = entity.PricePrecision
+	m.CreatedAt = entity.CreatedAt
 }
 
 // TradingPairRepository implements repository.TradingPairRepository
@@ -155,6 +174,53 @@ func (r *TradingPairRepository) SetActive(ctx context.Context, symbol string, is
 	return nil
 }
 
+// GetByTier retrieves all active trading pairs in the given liquidity tier
+func (r *TradingPairRepository) GetByTier(ctx context.Context, tier entity.LiquidityTier) ([]*repository.TradingPair, error) {
+	var models []TradingPairModel
+	if err := r.db.WithContext(ctx).
+		Where("is_active = ? AND liquidity_tier = ?", true, string(tier)).
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to get trading pairs by tier: %w", err)
+	}
+
+	pairs := make([]*repository.TradingPair, len(models))
+	for i, model := range models {
+		pairs[i] = model.ToEntity()
+	}
+
+	return pairs, nil
+}
+
+// SetLiquidityTier sets the liquidity tier of a trading pair
+func (r *TradingPairRepository) SetLiquidityTier(ctx context.Context, symbol string, tier entity.LiquidityTier) error {
+	if err := r.db.WithContext(ctx).
+		Model(&TradingPairModel{}).
+		Where("symbol = ?", symbol).
+		Updates(map[string]interface{}{
+			"liquidity_tier":  string(tier),
+			"tier_updated_at": time.Now(),
+		}).Error; err != nil {
+		return fmt.Errorf("failed to set liquidity tier: %w", err)
+	}
+
+	return nil
+}
+
+// SetPrecision sets the exchange price precision rules of a trading pair
+func (r *TradingPairRepository) SetPrecision(ctx context.Context, symbol string, tickSize decimal.Decimal, pricePrecision int) error {
+	if err := r.db.WithContext(ctx).
+		Model(&TradingPairModel{}).
+		Where("symbol = ?", symbol).
+		Updates(map[string]interface{}{
+			"tick_size":       tickSize,
+			"price_precision": pricePrecision,
+		}).Error; err != nil {
+		return fmt.Errorf("failed to set precision: %w", err)
+	}
+
+	return nil
+}
+
 // Exists checks if a trading pair exists
 func (r *TradingPairRepository) Exists(ctx context.Context, symbol string) (bool, error) {
 	var count int64
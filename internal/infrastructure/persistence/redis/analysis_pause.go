@@ -0,0 +1,56 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"ContractAnalysis/internal/domain/repository"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// analysisPauseKey is the Redis key holding the analysis pause state
+const analysisPauseKey = "contract_analysis:analysis_pause"
+
+// AnalysisPauseStore implements repository.AnalysisPauseRepository with a single Redis key
+// The key has no expiry, so a pause lasts until it is lifted
+type AnalysisPauseStore struct {
+	client *redis.Client
+}
+
+// NewAnalysisPauseStore creates a new Redis analysis pause store
+func NewAnalysisPauseStore(client *redis.Client) repository.AnalysisPauseRepository {
+	return &AnalysisPauseStore{client: client}
+}
+
+// Get retrieves the current pause state, returning nil if it has never been set
+func (s *AnalysisPauseStore) Get(ctx context.Context) (*repository.AnalysisPause, error) {
+	data, err := s.client.Get(ctx, analysisPauseKey).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get analysis pause: %w", err)
+	}
+
+	var pause repository.AnalysisPause
+	if err := json.Unmarshal(data, &pause); err != nil {
+		return nil, fmt.Errorf("failed to decode analysis pause: %w", err)
+	}
+	return &pause, nil
+}
+
+// Set stores the pause state
+func (s *AnalysisPauseStore) Set(ctx context.Context, pause *repository.AnalysisPause) error {
+	data, err := json.Marshal(pause)
+	if err != nil {
+		return fmt.Errorf("failed to encode analysis pause: %w", err)
+	}
+
+	if err := s.client.Set(ctx, analysisPauseKey, data, 0).Err(); err != nil {
+		return fmt.Errorf("failed to set analysis pause: %w", err)
+	}
+	return nil
+}
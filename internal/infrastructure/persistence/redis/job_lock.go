@@ -0,0 +1,71 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// renewScript extends the lock TTL only if this instance still owns it
+var renewScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// JobLocker provides cluster-wide locks on scheduled job ticks using SET NX with a TTL,
+// so that only one replica runs a given job per tick
+// Locks are keyed by job and tick and left to expire rather than released, so a replica
+// firing late finds the tick taken even after the first run finished. Each instance owns
+// its locks through a unique token, so a replica can never renew a lock taken by another one
+type JobLocker struct {
+	client    *redis.Client
+	keyPrefix string
+	ttl       time.Duration
+	token     string
+}
+
+// NewJobLocker creates a new Redis job locker
+func NewJobLocker(client *redis.Client, keyPrefix string, ttl time.Duration) *JobLocker {
+	hostname, _ := os.Hostname()
+
+	return &JobLocker{
+		client:    client,
+		keyPrefix: keyPrefix,
+		ttl:       ttl,
+		token:     fmt.Sprintf("%s:%d:%s", hostname, os.Getpid(), uuid.NewString()),
+	}
+}
+
+// TTL returns how long a lock is held without being renewed
+func (l *JobLocker) TTL() time.Duration {
+	return l.ttl
+}
+
+// Acquire takes the lock for a job tick, returning false if another instance holds it
+func (l *JobLocker) Acquire(ctx context.Context, job string, tick time.Time) (bool, error) {
+	acquired, err := l.client.SetNX(ctx, l.key(job, tick), l.token, l.ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire job lock: %w", err)
+	}
+	return acquired, nil
+}
+
+// Renew extends the lock TTL, returning false if the lock is no longer held by this instance
+func (l *JobLocker) Renew(ctx context.Context, job string, tick time.Time) (bool, error) {
+	renewed, err := renewScript.Run(ctx, l.client, []string{l.key(job, tick)}, l.token, l.ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, fmt.Errorf("failed to renew job lock: %w", err)
+	}
+	return renewed == 1, nil
+}
+
+// key returns the Redis key of a job tick's lock
+func (l *JobLocker) key(job string, tick time.Time) string {
+	return fmt.Sprintf("%s%s:%d", l.keyPrefix, job, tick.Unix())
+}
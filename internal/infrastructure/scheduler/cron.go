@@ -2,8 +2,12 @@ package scheduler
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
+	"time"
 
+	"ContractAnalysis/config"
 	"ContractAnalysis/internal/infrastructure/logger"
 	"ContractAnalysis/internal/infrastructure/notification"
 	"ContractAnalysis/internal/usecase"
@@ -12,6 +16,21 @@ import (
 	"go.uber.org/zap"
 )
 
+// cancelGracePeriod is how long Stop waits for jobs to return once they were cancelled
+const cancelGracePeriod = 5 * time.Second
+
+// JobLocker grants cluster-wide locks on job ticks so that only one replica runs each tick
+// A tick's lock is never released, it expires after its TTL so a replica whose cron fires
+// late can't run the same tick again once the first run finished
+type JobLocker interface {
+	// Acquire takes the lock for a job tick, returning false if another replica holds it
+	Acquire(ctx context.Context, job string, tick time.Time) (bool, error)
+	// Renew extends a held lock, returning false if it has been lost
+	Renew(ctx context.Context, job string, tick time.Time) (bool, error)
+	// TTL returns how long a lock is held without being renewed
+	TTL() time.Duration
+}
+
 // Scheduler manages scheduled jobs
 type Scheduler struct {
 	cron                 *cron.Cron
@@ -20,10 +39,25 @@ type Scheduler struct {
 	tracker              *usecase.Tracker
 	statisticsCalculator *usecase.StatisticsCalculator
 	statisticsMonitor    *usecase.StatisticsMonitor
+	retentionCleaner     *usecase.RetentionCleaner
+	paperTrader          *usecase.PaperTrader
 	notifier             *notification.NotificationDispatcher
+	timeouts             config.JobTimeoutsConfig
+	shutdownTimeout      time.Duration
 	logger               *logger.Logger
 	ctx                  context.Context
 	cancelFunc           context.CancelFunc
+
+	// running tracks jobs that are currently executing to prevent overlapping runs
+	runningMu sync.Mutex
+	running   map[string]bool
+	stopping  bool
+
+	// inFlight counts executing jobs so Stop can drain them before shutdown
+	inFlight sync.WaitGroup
+
+	// locker coordinates job runs across replicas, nil for single-instance deployments
+	locker JobLocker
 }
 
 // NewScheduler creates a new scheduler
@@ -33,7 +67,12 @@ func NewScheduler(
 	tracker *usecase.Tracker,
 	statisticsCalculator *usecase.StatisticsCalculator,
 	statisticsMonitor *usecase.StatisticsMonitor,
+	retentionCleaner *usecase.RetentionCleaner,
+	paperTrader *usecase.PaperTrader,
 	notifier *notification.NotificationDispatcher,
+	timeouts config.JobTimeoutsConfig,
+	shutdownTimeout time.Duration,
+	locker JobLocker,
 ) *Scheduler {
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -44,26 +83,153 @@ func NewScheduler(
 		tracker:              tracker,
 		statisticsCalculator: statisticsCalculator,
 		statisticsMonitor:    statisticsMonitor,
+		retentionCleaner:     retentionCleaner,
+		paperTrader:          paperTrader,
 		notifier:             notifier,
+		timeouts:             timeouts,
+		shutdownTimeout:      shutdownTimeout,
 		logger:               logger.WithComponent("scheduler"),
 		ctx:                  ctx,
 		cancelFunc:           cancel,
+		running:              make(map[string]bool),
+		locker:               locker,
+	}
+}
+
+// wrapJob returns a cron func that runs the job with a timeout derived from the
+// scheduler context and skips the tick if the previous run is still in progress
+func (s *Scheduler) wrapJob(name string, timeout time.Duration, job func(ctx context.Context)) func() {
+	return func() {
+		// Schedules have second precision, so the truncated start time identifies the tick
+		tick := time.Now().Truncate(time.Second)
+
+		if !s.trackJob() {
+			return
+		}
+		defer s.inFlight.Done()
+
+		if !s.tryAcquire(name) {
+			s.logger.Warn("Skipping job run, previous run still in progress", zap.String("job", name))
+			return
+		}
+		defer s.release(name)
+
+		if s.locker != nil {
+			stopRenewing, ok := s.acquireClusterLock(name, tick)
+			if !ok {
+				return
+			}
+			defer stopRenewing()
+		}
+
+		ctx, cancel := context.WithTimeout(s.ctx, timeout)
+		defer cancel()
+
+		job(ctx)
+
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			s.logger.Error("Job exceeded its timeout and was aborted",
+				zap.String("job", name),
+				zap.Duration("timeout", timeout),
+			)
+			_ = s.notifier.NotifySystemError(s.ctx, fmt.Sprintf("Job %s exceeded timeout of %s", name, timeout), nil)
+		}
+	}
+}
+
+// acquireClusterLock takes the distributed lock of the job's tick and keeps renewing it
+// until the returned func is called, after which the lock expires with its TTL. Returns
+// false if another replica holds the lock or the lock backend is unavailable, in which
+// case this replica skips the run
+func (s *Scheduler) acquireClusterLock(name string, tick time.Time) (func(), bool) {
+	acquired, err := s.locker.Acquire(s.ctx, name, tick)
+	if err != nil {
+		s.logger.WithError(err).Error("Failed to acquire distributed job lock, skipping run", zap.String("job", name))
+		return nil, false
+	}
+	if !acquired {
+		s.logger.Info("Job tick already ran on another replica, skipping run",
+			zap.String("job", name),
+			zap.Time("tick", tick),
+		)
+		return nil, false
+	}
+
+	// Renew well before the TTL runs out so long-running jobs keep the lock
+	done := make(chan struct{})
+	renewerStopped := make(chan struct{})
+	go func() {
+		defer close(renewerStopped)
+
+		ticker := time.NewTicker(s.locker.TTL() / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				held, err := s.locker.Renew(s.ctx, name, tick)
+				if err != nil {
+					s.logger.WithError(err).Warn("Failed to renew distributed job lock", zap.String("job", name))
+				} else if !held {
+					s.logger.Warn("Distributed job lock was lost while the job was running", zap.String("job", name))
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-renewerStopped
+	}, true
+}
+
+// trackJob registers an in-flight job, returning false once the scheduler is stopping
+func (s *Scheduler) trackJob() bool {
+	s.runningMu.Lock()
+	defer s.runningMu.Unlock()
+
+	if s.stopping {
+		return false
+	}
+	s.inFlight.Add(1)
+	return true
+}
+
+// tryAcquire marks a job as running, returning false if it already is
+func (s *Scheduler) tryAcquire(name string) bool {
+	s.runningMu.Lock()
+	defer s.runningMu.Unlock()
+
+	if s.running[name] {
+		return false
 	}
+	s.running[name] = true
+	return true
+}
+
+// release marks a job as no longer running
+func (s *Scheduler) release(name string) {
+	s.runningMu.Lock()
+	defer s.runningMu.Unlock()
+
+	delete(s.running, name)
 }
 
 // AddCollectionJob adds the data collection job
 func (s *Scheduler) AddCollectionJob(schedule string) error {
-	_, err := s.cron.AddFunc(schedule, func() {
+	_, err := s.cron.AddFunc(schedule, s.wrapJob("collection", s.timeouts.Collection, func(ctx context.Context) {
 		s.logger.Info("Running data collection job")
 
-		if err := s.collector.CollectAll(s.ctx); err != nil {
+		if err := s.collector.CollectAll(ctx); err != nil {
 			s.logger.WithError(err).Error("Data collection job failed")
 			_ = s.notifier.NotifySystemError(s.ctx, "Data collection failed: "+err.Error(), nil)
 			return
 		}
 
 		s.logger.Info("Data collection job completed")
-	})
+	}))
 
 	if err != nil {
 		return fmt.Errorf("failed to add collection job: %w", err)
@@ -75,11 +241,11 @@ func (s *Scheduler) AddCollectionJob(schedule string) error {
 
 // AddAnalysisJob adds the signal analysis job
 func (s *Scheduler) AddAnalysisJob(schedule string) error {
-	_, err := s.cron.AddFunc(schedule, func() {
+	_, err := s.cron.AddFunc(schedule, s.wrapJob("analysis", s.timeouts.Analysis, func(ctx context.Context) {
 		s.logger.Info("Running signal analysis job")
 
 		// Analyze all symbols
-		signals, err := s.analyzer.AnalyzeAll(s.ctx)
+		signals, err := s.analyzer.AnalyzeAll(ctx)
 		if err != nil {
 			s.logger.WithError(err).Error("Signal analysis job failed")
 			_ = s.notifier.NotifySystemError(s.ctx, "Signal analysis failed: "+err.Error(), nil)
@@ -88,19 +254,19 @@ func (s *Scheduler) AddAnalysisJob(schedule string) error {
 
 		// Send notifications for new signals
 		for _, signal := range signals {
-			if err := s.notifier.NotifySignalGenerated(s.ctx, signal); err != nil {
+			if err := s.notifier.NotifySignalGenerated(ctx, signal); err != nil {
 				s.logger.WithError(err).WithSignalID(signal.SignalID).Warn("Failed to send signal notification")
 			}
 		}
 
 		// Validate pending signals
-		if err := s.analyzer.ValidatePendingSignals(s.ctx); err != nil {
+		if err := s.analyzer.ValidatePendingSignals(ctx); err != nil {
 			s.logger.WithError(err).Error("Signal validation failed")
 			return
 		}
 
 		s.logger.Info("Signal analysis job completed", zap.Int("signals", len(signals)))
-	})
+	}))
 
 	if err != nil {
 		return fmt.Errorf("failed to add analysis job: %w", err)
@@ -112,17 +278,17 @@ func (s *Scheduler) AddAnalysisJob(schedule string) error {
 
 // AddTrackingJob adds the signal tracking job
 func (s *Scheduler) AddTrackingJob(schedule string) error {
-	_, err := s.cron.AddFunc(schedule, func() {
+	_, err := s.cron.AddFunc(schedule, s.wrapJob("tracking", s.timeouts.Tracking, func(ctx context.Context) {
 		s.logger.Info("Running signal tracking job")
 
-		if err := s.tracker.TrackAll(s.ctx); err != nil {
+		if err := s.tracker.TrackAll(ctx); err != nil {
 			s.logger.WithError(err).Error("Signal tracking job failed")
 			_ = s.notifier.NotifySystemError(s.ctx, "Signal tracking failed: "+err.Error(), nil)
 			return
 		}
 
 		s.logger.Info("Signal tracking job completed")
-	})
+	}))
 
 	if err != nil {
 		return fmt.Errorf("failed to add tracking job: %w", err)
@@ -134,10 +300,10 @@ func (s *Scheduler) AddTrackingJob(schedule string) error {
 
 // AddStatisticsJob adds the statistics calculation job
 func (s *Scheduler) AddStatisticsJob(schedule string) error {
-	_, err := s.cron.AddFunc(schedule, func() {
+	_, err := s.cron.AddFunc(schedule, s.wrapJob("statistics", s.timeouts.Statistics, func(ctx context.Context) {
 		s.logger.Info("Running statistics calculation job")
 
-		if err := s.statisticsCalculator.CalculateAll(s.ctx); err != nil {
+		if err := s.statisticsCalculator.CalculateAll(ctx); err != nil {
 			s.logger.WithError(err).Error("Statistics calculation job failed")
 			_ = s.notifier.NotifySystemError(s.ctx, "Statistics calculation failed: "+err.Error(), nil)
 			return
@@ -145,14 +311,14 @@ func (s *Scheduler) AddStatisticsJob(schedule string) error {
 
 		// Monitor statistics changes if enabled
 		if s.statisticsMonitor != nil {
-			if err := s.statisticsMonitor.MonitorAllStatistics(s.ctx); err != nil {
+			if err := s.statisticsMonitor.MonitorAllStatistics(ctx); err != nil {
 				s.logger.WithError(err).Warn("Statistics monitoring failed")
 				// Don't fail the job if monitoring fails
 			}
 		}
 
 		s.logger.Info("Statistics calculation job completed")
-	})
+	}))
 
 	if err != nil {
 		return fmt.Errorf("failed to add statistics job: %w", err)
@@ -164,17 +330,17 @@ func (s *Scheduler) AddStatisticsJob(schedule string) error {
 
 // AddKlineTrackingJob adds the kline tracking job
 func (s *Scheduler) AddKlineTrackingJob(schedule string) error {
-	_, err := s.cron.AddFunc(schedule, func() {
+	_, err := s.cron.AddFunc(schedule, s.wrapJob("kline_tracking", s.timeouts.KlineTracking, func(ctx context.Context) {
 		s.logger.Info("Running kline tracking job")
 
-		if err := s.tracker.TrackAllKlines(s.ctx); err != nil {
+		if err := s.tracker.TrackAllKlines(ctx); err != nil {
 			s.logger.WithError(err).Error("Kline tracking job failed")
 			_ = s.notifier.NotifySystemError(s.ctx, "Kline tracking failed: "+err.Error(), nil)
 			return
 		}
 
 		s.logger.Info("Kline tracking job completed")
-	})
+	}))
 
 	if err != nil {
 		return fmt.Errorf("failed to add kline tracking job: %w", err)
@@ -184,6 +350,50 @@ func (s *Scheduler) AddKlineTrackingJob(schedule string) error {
 	return nil
 }
 
+// AddRetentionJob adds the old-data cleanup job
+func (s *Scheduler) AddRetentionJob(schedule string) error {
+	_, err := s.cron.AddFunc(schedule, s.wrapJob("retention", s.timeouts.Retention, func(ctx context.Context) {
+		s.logger.Info("Running data retention job")
+
+		if err := s.retentionCleaner.Cleanup(ctx); err != nil {
+			s.logger.WithError(err).Error("Data retention job failed")
+			_ = s.notifier.NotifySystemError(s.ctx, "Data retention cleanup failed: "+err.Error(), nil)
+			return
+		}
+
+		s.logger.Info("Data retention job completed")
+	}))
+
+	if err != nil {
+		return fmt.Errorf("failed to add retention job: %w", err)
+	}
+
+	s.logger.Info("Added data retention job", zap.String("schedule", schedule))
+	return nil
+}
+
+// AddPaperTradingJob adds the paper trading equity update job
+func (s *Scheduler) AddPaperTradingJob(schedule string) error {
+	_, err := s.cron.AddFunc(schedule, s.wrapJob("paper_trading", s.timeouts.PaperTrading, func(ctx context.Context) {
+		s.logger.Info("Running paper trading job")
+
+		if err := s.paperTrader.Update(ctx); err != nil {
+			s.logger.WithError(err).Error("Paper trading job failed")
+			_ = s.notifier.NotifySystemError(s.ctx, "Paper trading update failed: "+err.Error(), nil)
+			return
+		}
+
+		s.logger.Info("Paper trading job completed")
+	}))
+
+	if err != nil {
+		return fmt.Errorf("failed to add paper trading job: %w", err)
+	}
+
+	s.logger.Info("Added paper trading job", zap.String("schedule", schedule))
+	return nil
+}
+
 // Start starts the scheduler
 func (s *Scheduler) Start() {
 	s.logger.Info("Starting scheduler")
@@ -191,12 +401,43 @@ func (s *Scheduler) Start() {
 	s.logger.Info("Scheduler started")
 }
 
-// Stop stops the scheduler
+// Stop stops scheduling new runs and waits up to the shutdown timeout for
+// in-flight jobs to finish. Jobs still running after that are cancelled and
+// given a short grace period to return, so they don't outlive their dependencies
 func (s *Scheduler) Stop() {
 	s.logger.Info("Stopping scheduler")
+	s.cron.Stop()
+
+	s.runningMu.Lock()
+	s.stopping = true
+	s.runningMu.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		s.inFlight.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		s.logger.Info("All in-flight jobs finished")
+	case <-time.After(s.shutdownTimeout):
+		s.logger.Warn("In-flight jobs did not finish before the shutdown timeout, cancelling them",
+			zap.Duration("timeout", s.shutdownTimeout),
+		)
+		s.cancelFunc()
+
+		select {
+		case <-drained:
+			s.logger.Info("Cancelled jobs returned")
+		case <-time.After(cancelGracePeriod):
+			s.logger.Warn("Cancelled jobs did not return within the grace period",
+				zap.Duration("grace_period", cancelGracePeriod),
+			)
+		}
+	}
+
 	s.cancelFunc()
-	ctx := s.cron.Stop()
-	<-ctx.Done()
 	s.logger.Info("Scheduler stopped")
 }
 
@@ -0,0 +1,95 @@
+package scheduler
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"ContractAnalysis/config"
+)
+
+func newTestScheduler(shutdownTimeout time.Duration) *Scheduler {
+	return NewScheduler(nil, nil, nil, nil, nil, nil, nil, nil, config.JobTimeoutsConfig{}, shutdownTimeout, nil)
+}
+
+func TestSchedulerStopLifecycle(t *testing.T) {
+	tests := []struct {
+		name            string
+		shutdownTimeout time.Duration
+		jobDuration     time.Duration // How long the job runs unless cancelled
+		wantCancelled   bool
+	}{
+		{"job finishes before the shutdown timeout", time.Second, 50 * time.Millisecond, false},
+		{"job cancelled after the shutdown timeout", 50 * time.Millisecond, time.Minute, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestScheduler(tt.shutdownTimeout)
+
+			started := make(chan struct{})
+			var cancelled, returned atomic.Bool
+			run := s.wrapJob("test", time.Hour, func(ctx context.Context) {
+				close(started)
+				select {
+				case <-time.After(tt.jobDuration):
+				case <-ctx.Done():
+					cancelled.Store(true)
+					// Cleanup after cancellation still completes before Stop returns
+					time.Sleep(50 * time.Millisecond)
+				}
+				returned.Store(true)
+			})
+
+			go run()
+			<-started
+			s.Stop()
+
+			if !returned.Load() {
+				t.Error("Stop returned before the job")
+			}
+			if cancelled.Load() != tt.wantCancelled {
+				t.Errorf("job cancelled = %v, want %v", cancelled.Load(), tt.wantCancelled)
+			}
+
+			// Runs triggered after Stop are dropped
+			var ranAfterStop atomic.Bool
+			s.wrapJob("test", time.Hour, func(ctx context.Context) { ranAfterStop.Store(true) })()
+			if ranAfterStop.Load() {
+				t.Error("job ran after Stop")
+			}
+		})
+	}
+}
+
+func TestWrapJobSkipsOverlappingRuns(t *testing.T) {
+	s := newTestScheduler(time.Second)
+	defer s.Stop()
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	var runs atomic.Int32
+	run := s.wrapJob("test", time.Hour, func(ctx context.Context) {
+		if runs.Add(1) == 1 {
+			close(started)
+		}
+		<-release
+	})
+
+	done := make(chan struct{})
+	go func() {
+		run()
+		close(done)
+	}()
+	<-started
+
+	// The second tick finds the first run still in progress and returns at once
+	run()
+	close(release)
+	<-done
+
+	if got := runs.Load(); got != 1 {
+		t.Errorf("job ran %d times, want 1", got)
+	}
+}
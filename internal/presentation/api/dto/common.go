@@ -18,5 +18,5 @@ type FilterRequest struct {
 
 // PeriodRequest represents a time period filter
 type PeriodRequest struct {
-	Period string `form:"period" binding:"omitempty,oneof=24h 7d 30d all"`
+	Period string `form:"period"` // e.g. 24h, 7d, 30d or all
 }
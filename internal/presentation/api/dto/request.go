@@ -1,5 +1,7 @@
 package dto
 
+import "time"
+
 // SignalListRequest represents request parameters for signal list
 type SignalListRequest struct {
 	FilterRequest
@@ -7,9 +9,27 @@ type SignalListRequest struct {
 	Symbol       string `form:"symbol"`
 	Status       string `form:"status" binding:"omitempty,oneof=PENDING CONFIRMED TRACKING CLOSED INVALIDATED"`
 	Type         string `form:"type" binding:"omitempty,oneof=LONG SHORT"`
+	Outcome      string `form:"outcome" binding:"omitempty,oneof=PROFIT LOSS NEUTRAL TIMEOUT"`
 	StrategyName string `form:"strategy_name"`
 }
 
+// SignalBatchRequest represents request parameters for bulk signal lookup
+type SignalBatchRequest struct {
+	IDs string `form:"ids" binding:"required"` // Comma-separated signal IDs
+}
+
+// KlineBackfillRequest represents request parameters for a kline tracking backfill over a time range
+type KlineBackfillRequest struct {
+	StartTime *time.Time `form:"start_time" binding:"required"`
+	EndTime   *time.Time `form:"end_time"` // Defaults to now
+}
+
+// SignalNoteRequest represents the request body for attaching a note to a signal
+type SignalNoteRequest struct {
+	Text   string `json:"text" binding:"required"`
+	Author string `json:"author"` // Defaults to anonymous
+}
+
 // StatisticsRequest represents request parameters for statistics
 type StatisticsRequest struct {
 	PeriodRequest
@@ -23,6 +43,17 @@ type PairListRequest struct {
 	IsActive *bool `form:"is_active"`
 }
 
+// PairUpdateRequest represents the request body for updating a trading pair
+type PairUpdateRequest struct {
+	IsActive *bool `json:"is_active" binding:"required"`
+}
+
+// AnalysisPauseRequest represents the request body for pausing or resuming signal generation
+type AnalysisPauseRequest struct {
+	Paused *bool  `json:"paused" binding:"required"`
+	Reason string `json:"reason" binding:"max=255"` // Optional note shown with the state
+}
+
 // MarketDataRequest represents request parameters for market data
 type MarketDataRequest struct {
 	TimeRangeRequest
@@ -30,6 +61,12 @@ type MarketDataRequest struct {
 	Limit  int    `form:"limit"`
 }
 
+// StatisticsSummaryRequest represents request parameters for the statistics summary
+type StatisticsSummaryRequest struct {
+	PeriodRequest
+	SymbolLimit int `form:"symbol_limit" binding:"omitempty,min=1,max=50"` // Symbols in each of the top/worst lists, default 5
+}
+
 // StatisticsHistoryRequest represents request for historical statistics
 type StatisticsHistoryRequest struct {
 	TimeRangeRequest
@@ -40,6 +77,48 @@ type StatisticsHistoryRequest struct {
 // StrategyCompareRequest represents request parameters for strategy comparison
 type StrategyCompareRequest struct {
 	StrategyNames []string `form:"strategies" binding:"required,min=2,max=5"` // 2-5 strategies
-	Period        string   `form:"period" binding:"required,oneof=24h 7d 30d all"`
+	Period        string   `form:"period" binding:"required"`
 	Symbols       []string `form:"symbols"` // Optional: filter by specific symbols
 }
+
+// StatisticsRecalculateRequest represents request parameters for recomputing a statistics slice
+type StatisticsRecalculateRequest struct {
+	StrategyName string `form:"strategy" binding:"required"`
+	Period       string `form:"period" binding:"required"`
+	Symbol       string `form:"symbol"` // Optional: recompute a single symbol
+}
+
+// StatisticsTimeseriesRequest represents request parameters for the outcome timeseries
+type StatisticsTimeseriesRequest struct {
+	TimeRangeRequest
+	StrategyName string `form:"strategy"`                                      // Empty for all strategies
+	Period       string `form:"period" binding:"omitempty,oneof=daily weekly"` // Bucket size, defaults to daily
+}
+
+// StatisticsAlertRequest represents request parameters for statistics change alerts
+type StatisticsAlertRequest struct {
+	PeriodRequest
+	StrategyName string `form:"strategy"` // Empty for all strategies
+}
+
+// ConsensusRequest represents request parameters for the strategy consensus of a symbol
+type ConsensusRequest struct {
+	Symbol string `form:"symbol" binding:"required"`
+}
+
+// AnalyzePreviewRequest represents request parameters for symbol analysis preview
+type AnalyzePreviewRequest struct {
+	Dry bool `form:"dry"`
+}
+
+// AnalyzeRunRequest represents request parameters for an on-demand symbol analysis
+type AnalyzeRunRequest struct {
+	Refresh bool `form:"refresh"` // Fetch and store fresh market data before analyzing
+}
+
+// PaperEquityRequest represents request parameters for the paper trading equity curve
+type PaperEquityRequest struct {
+	StrategyName string     `form:"strategy"` // Empty for the combined portfolio curve
+	Start        *time.Time `form:"start"`
+	End          *time.Time `form:"end"`
+}
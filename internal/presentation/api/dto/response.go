@@ -14,21 +14,65 @@ type SignalResponse struct {
 	ShortAccountRatio  string                 `json:"short_account_ratio"`
 	LongPositionRatio  string                 `json:"long_position_ratio"`
 	ShortPositionRatio string                 `json:"short_position_ratio"`
-	LongTraderCount    int                    `json:"long_trader_count"`
-	ShortTraderCount   int                    `json:"short_trader_count"`
+	OpenInterest       string                 `json:"open_interest"`
+	FundingRate        string                 `json:"funding_rate"`
+	Volume24h          string                 `json:"volume_24h"` // 24h quote volume at signal time, "0" if not recorded
 	Status             string                 `json:"status"`
 	IsConfirmed        bool                   `json:"is_confirmed"`
 	ConfirmedAt        *string                `json:"confirmed_at,omitempty"`
+	EntryPrice         *string                `json:"entry_price,omitempty"` // Price at confirmation
+	InvalidatedAt      *string                `json:"invalidated_at,omitempty"`
+	InvalidationReason string                 `json:"invalidation_reason,omitempty"`
 	Reason             string                 `json:"reason,omitempty"`
 	StrategyContext    map[string]interface{} `json:"strategy_context,omitempty"`
+	RiskRewardRatio    *string                `json:"risk_reward_ratio,omitempty"`
+	PositionSizePct    *string                `json:"position_size_pct,omitempty"`
 	CreatedAt          string                 `json:"created_at"`
 	UpdatedAt          string                 `json:"updated_at"`
 
 	// Final outcome (only for CLOSED signals)
-	FinalPnlPct        *string `json:"final_pnl_pct,omitempty"`        // 最终盈亏百分比
-	Outcome            *string `json:"outcome,omitempty"`              // PROFIT, LOSS, NEUTRAL
-	TotalTrackingHours *int    `json:"total_tracking_hours,omitempty"` // 总追踪小时数
-	ClosedAt           *string `json:"closed_at,omitempty"`            // 关闭时间（仅已关闭信号）
+	FinalPnlPct         *string `json:"final_pnl_pct,omitempty"`          // 最终盈亏百分比
+	Outcome             *string `json:"outcome,omitempty"`                // PROFIT, LOSS, NEUTRAL
+	TotalTrackingHours  *int    `json:"total_tracking_hours,omitempty"`   // 总追踪小时数
+	HoursToProfitTarget *int    `json:"hours_to_profit_target,omitempty"` // 首次达到止盈的小时数
+	HoursToStopLoss     *int    `json:"hours_to_stop_loss,omitempty"`     // 首次触及止损的小时数
+	ClosedAt            *string `json:"closed_at,omitempty"`              // 关闭时间（仅已关闭信号）
+}
+
+// SignalBatchResponse represents the result of a bulk signal lookup
+type SignalBatchResponse struct {
+	Signals    []*SignalResponse `json:"signals"`
+	MissingIDs []string          `json:"missing_ids"`
+}
+
+// OpenPositionResponse represents the live unrealized PnL of a tracking signal
+type OpenPositionResponse struct {
+	SignalID         string  `json:"signal_id"`
+	Symbol           string  `json:"symbol"`
+	Type             string  `json:"type"`
+	StrategyName     string  `json:"strategy_name"`
+	GeneratedAt      string  `json:"generated_at"`
+	EntryPrice       string  `json:"entry_price"`
+	CurrentPrice     *string `json:"current_price"`      // Null if the price could not be fetched
+	UnrealizedPnlPct *string `json:"unrealized_pnl_pct"` // Null if the price could not be fetched
+}
+
+// TrackingSummaryResponse represents the unrealized PnL across all tracking signals
+type TrackingSummaryResponse struct {
+	Positions             []*OpenPositionResponse `json:"positions"`
+	Count                 int                     `json:"count"`
+	PricedCount           int                     `json:"priced_count"`
+	TotalUnrealizedPnlPct string                  `json:"total_unrealized_pnl_pct"`
+	AvgUnrealizedPnlPct   string                  `json:"avg_unrealized_pnl_pct"`
+	Winning               int                     `json:"winning"`
+	Losing                int                     `json:"losing"`
+}
+
+// KlineBackfillResponse represents the result of a kline tracking backfill
+type KlineBackfillResponse struct {
+	Signals    int `json:"signals"`
+	Backfilled int `json:"backfilled"`
+	Failed     int `json:"failed"`
 }
 
 // SignalTrackingResponse represents signal tracking data
@@ -67,6 +111,82 @@ type SignalKlineTrackingResponse struct {
 	IsProfitableAtClose bool    `json:"is_profitable_at_close"`
 }
 
+// SignalContextResponse represents everything recorded about why and under which
+// market conditions a signal was generated
+type SignalContextResponse struct {
+	SignalID       string                      `json:"signal_id"`
+	Symbol         string                      `json:"symbol"`
+	Type           string                      `json:"type"`
+	StrategyName   string                      `json:"strategy_name"`
+	GeneratedAt    string                      `json:"generated_at"`
+	Reason         string                      `json:"reason"`
+	MarketData     SignalMarketContextResponse `json:"market_data"`
+	TradeLevels    SignalTradeLevelsResponse   `json:"trade_levels"`
+	StrategyConfig map[string]interface{}      `json:"strategy_config"` // Config snapshot taken at generation
+}
+
+// SignalMarketContextResponse represents the market data a signal was generated from
+type SignalMarketContextResponse struct {
+	Price              string `json:"price"`
+	LongAccountRatio   string `json:"long_account_ratio"`
+	ShortAccountRatio  string `json:"short_account_ratio"`
+	LongPositionRatio  string `json:"long_position_ratio"`
+	ShortPositionRatio string `json:"short_position_ratio"`
+	OpenInterest       string `json:"open_interest"`
+	FundingRate        string `json:"funding_rate"`
+	Volume24h          string `json:"volume_24h"`
+}
+
+// SignalTradeLevelsResponse represents the trade levels and sizing computed for a signal
+// Zero values mean the level was not set
+type SignalTradeLevelsResponse struct {
+	StopLossPrice   string `json:"stop_loss_price"`
+	TargetPrice1    string `json:"target_price_1"`
+	TargetPrice2    string `json:"target_price_2"`
+	TickSize        string `json:"tick_size"`
+	RiskRewardRatio string `json:"risk_reward_ratio"`
+	PositionSizePct string `json:"position_size_pct"`
+}
+
+// SignalOutcomeResponse represents the final outcome of a closed signal
+type SignalOutcomeResponse struct {
+	ID                   int64   `json:"id"`
+	SignalID             string  `json:"signal_id"`
+	Outcome              string  `json:"outcome"` // PROFIT, LOSS, NEUTRAL, TIMEOUT
+	MaxFavorableMovePct  string  `json:"max_favorable_move_pct"`
+	MaxAdverseMovePct    string  `json:"max_adverse_move_pct"`
+	FinalPriceChangePct  string  `json:"final_price_change_pct"`
+	HoursToPeak          *int    `json:"hours_to_peak,omitempty"`
+	HoursToTrough        *int    `json:"hours_to_trough,omitempty"`
+	TotalTrackingHours   int     `json:"total_tracking_hours"`
+	HoursToProfitTarget  *int    `json:"hours_to_profit_target,omitempty"`
+	HoursToStopLoss      *int    `json:"hours_to_stop_loss,omitempty"`
+	HoursToOptimalExit   *int    `json:"hours_to_optimal_exit,omitempty"`   // Hours until exiting would have maximized profit
+	OptimalExitChangePct *string `json:"optimal_exit_change_pct,omitempty"` // Favorable move captured by the optimal exit
+	ProfitTargetHit      bool    `json:"profit_target_hit"`
+	StopLossHit          bool    `json:"stop_loss_hit"`
+	ClosedAt             string  `json:"closed_at"`
+	CreatedAt            string  `json:"created_at"`
+}
+
+// SignalNoteResponse represents a review note attached to a signal
+type SignalNoteResponse struct {
+	ID        int64  `json:"id"`
+	SignalID  string `json:"signal_id"`
+	Author    string `json:"author"`
+	Text      string `json:"text"`
+	CreatedAt string `json:"created_at"`
+}
+
+// SignalDetailResponse represents a signal together with its full tracking timeline
+type SignalDetailResponse struct {
+	Signal   *SignalResponse                `json:"signal"`
+	Tracking []*SignalTrackingResponse      `json:"tracking"`
+	Klines   []*SignalKlineTrackingResponse `json:"klines"`
+	Outcome  *SignalOutcomeResponse         `json:"outcome,omitempty"` // Only present once the signal is closed
+	Notes    []*SignalNoteResponse          `json:"notes"`
+}
+
 // StatisticsResponse represents strategy statistics
 type StatisticsResponse struct {
 	StrategyName string  `json:"strategy_name"`
@@ -86,10 +206,20 @@ type StatisticsResponse struct {
 	NeutralSignals    int `json:"neutral_signals"`
 
 	// Performance metrics
-	WinRate         *string `json:"win_rate,omitempty"`
-	AvgProfitPct    *string `json:"avg_profit_pct,omitempty"`
-	AvgLossPct      *string `json:"avg_loss_pct,omitempty"`
-	AvgHoldingHours *string `json:"avg_holding_hours,omitempty"`
+	WinRate          *string `json:"win_rate,omitempty"`
+	AvgProfitPct     *string `json:"avg_profit_pct,omitempty"`
+	AvgLossPct       *string `json:"avg_loss_pct,omitempty"`
+	AvgHoldingHours  *string `json:"avg_holding_hours,omitempty"`
+	MinHoldingHours  *string `json:"min_holding_hours,omitempty"`
+	MaxHoldingHours  *string `json:"max_holding_hours,omitempty"`
+	AvgHoursToTarget *string `json:"avg_hours_to_target,omitempty"`
+	AvgReturnPct     *string `json:"avg_return_pct,omitempty"`
+
+	// Weighted by the 24h quote volume of each signal's symbol at signal time
+	VolumeWeightedWinRate      *string `json:"volume_weighted_win_rate,omitempty"`
+	VolumeWeightedAvgReturnPct *string `json:"volume_weighted_avg_return_pct,omitempty"`
+
+	AvgOptimalExitHours *string `json:"avg_optimal_exit_hours,omitempty"` // Average hours until exiting would have maximized profit
 
 	// Best/Worst
 	BestSignalPct  *string `json:"best_signal_pct,omitempty"`
@@ -114,15 +244,55 @@ type StatisticsResponse struct {
 	AvgMaxPotentialProfitPct *string `json:"avg_max_potential_profit_pct,omitempty"`
 	AvgMaxPotentialLossPct   *string `json:"avg_max_potential_loss_pct,omitempty"`
 
+	// Return distribution of closed signals
+	Percentiles *ReturnPercentilesResponse `json:"percentiles,omitempty"`
+
+	// Holding time distribution of closed signals
+	HoldingTimeHistogram []HoldingTimeBucketResponse `json:"holding_time_histogram,omitempty"`
+
 	CalculatedAt string `json:"calculated_at"`
 }
 
+// HoldingTimeBucketResponse represents the number of closed signals held for at least
+// min_hours and less than max_hours (no max_hours for the last bucket)
+type HoldingTimeBucketResponse struct {
+	MinHours float64  `json:"min_hours"`
+	MaxHours *float64 `json:"max_hours,omitempty"`
+	Count    int      `json:"count"`
+}
+
+// ReturnPercentilesResponse represents percentile distributions keyed as "p50", "p95", etc.
+type ReturnPercentilesResponse struct {
+	FinalPriceChangePct map[string]string `json:"final_price_change_pct"`
+	MaxFavorableMovePct map[string]string `json:"max_favorable_move_pct"`
+	MaxAdverseMovePct   map[string]string `json:"max_adverse_move_pct"`
+}
+
+// CollectionStatusResponse represents the current state of data collection
+type CollectionStatusResponse struct {
+	Enabled             bool    `json:"enabled"`
+	TotalDataPoints     int64   `json:"total_data_points"`
+	SymbolsTracked      int     `json:"symbols_tracked"`
+	LatestCollection    *string `json:"latest_collection,omitempty"`
+	RunsSinceStartup    int     `json:"runs_since_startup"`
+	LastSuccessRate     *string `json:"last_success_rate,omitempty"`     // Success rate % of the latest run
+	SmoothedSuccessRate *string `json:"smoothed_success_rate,omitempty"` // Exponentially smoothed success rate % across runs
+	DegradedRuns        int     `json:"degraded_runs"`                   // Consecutive runs with the smoothed rate below the alert threshold
+}
+
 // TradingPairResponse represents a trading pair
 type TradingPairResponse struct {
-	Symbol    string `json:"symbol"`
-	IsActive  bool   `json:"is_active"`
-	CreatedAt string `json:"created_at"`
-	UpdatedAt string `json:"updated_at"`
+	Symbol         string  `json:"symbol"`
+	IsActive       bool    `json:"is_active"`
+	LiquidityTier  string  `json:"liquidity_tier,omitempty"`
+	TierUpdatedAt  *string `json:"tier_updated_at,omitempty"`
+	TickSize       string  `json:"tick_size"`
+	PricePrecision int     `json:"price_precision"`
+	CreatedAt      string  `json:"created_at"`
+	UpdatedAt      string  `json:"updated_at"`
+
+	// Keys of the enabled strategies analyzing the pair, after symbol filters and minimum liquidity tiers
+	Strategies []string `json:"strategies"`
 }
 
 // MarketDataResponse represents market data
@@ -133,16 +303,56 @@ type MarketDataResponse struct {
 	ShortAccountRatio  string `json:"short_account_ratio"`
 	LongPositionRatio  string `json:"long_position_ratio"`
 	ShortPositionRatio string `json:"short_position_ratio"`
-	LongTraderCount    int    `json:"long_trader_count"`
-	ShortTraderCount   int    `json:"short_trader_count"`
+	Price              string `json:"price"`
 	Volume24h          string `json:"volume_24h"`
+	OpenInterest       string `json:"open_interest"`
+	FundingRate        string `json:"funding_rate"`
+	TakerBuySellRatio  string `json:"taker_buy_sell_ratio"`
+
+	// Nil for the first data point of a symbol
+	OpenInterestChangePct *string `json:"open_interest_change_pct"`
+
+	// Nil if the next funding settlement time is unknown
+	NextFundingTime *string `json:"next_funding_time"`
+}
+
+// LiveMarketDataResponse represents market data fetched from Binance on request, next to
+// the latest stored data point for comparison
+type LiveMarketDataResponse struct {
+	Live                   *MarketDataResponse `json:"live"`
+	PositionRatioAvailable bool                `json:"position_ratio_available"`
+	DataQualityScore       int                 `json:"data_quality_score"`
+
+	// Nil if no data point was stored for the symbol yet
+	Stored           *MarketDataResponse `json:"stored"`
+	StoredAgeSeconds *int64              `json:"stored_age_seconds"`
 }
 
 // HealthResponse represents health check response
 type HealthResponse struct {
-	Status    string    `json:"status"`
-	Timestamp time.Time `json:"timestamp"`
-	Version   string    `json:"version"`
+	Status         string                  `json:"status"` // healthy, or degraded while the Binance circuit breaker is not closed
+	Timestamp      time.Time               `json:"timestamp"`
+	Version        string                  `json:"version"`
+	BinanceCircuit *CircuitBreakerResponse `json:"binance_circuit,omitempty"` // Omitted when the breaker is disabled
+}
+
+// CircuitBreakerResponse represents the state and counters of a circuit breaker
+type CircuitBreakerResponse struct {
+	State               string  `json:"state"` // closed, open, half_open
+	ConsecutiveFailures int     `json:"consecutive_failures"`
+	FailureThreshold    int     `json:"failure_threshold"`
+	CooldownSeconds     int     `json:"cooldown_seconds"`
+	OpenedAt            *string `json:"opened_at,omitempty"`
+	Trips               int64   `json:"trips"`    // Times the breaker has opened since startup
+	Rejected            int64   `json:"rejected"` // Requests failed fast since startup
+}
+
+// VersionResponse represents the build metadata of the running binary
+type VersionResponse struct {
+	Version   string `json:"version"`
+	GitCommit string `json:"git_commit"`
+	BuildTime string `json:"build_time"`
+	GoVersion string `json:"go_version"`
 }
 
 // SignalStatusDistribution represents signal count by status
@@ -154,7 +364,8 @@ type SignalStatusDistribution struct {
 	Invalidated int `json:"invalidated"`
 }
 
-// StrategyPerformance24h represents 24-hour performance for a single strategy
+// StrategyPerformance24h represents the performance of a single strategy over a period
+// (24 hours in the overview, the requested period in the summary)
 type StrategyPerformance24h struct {
 	StrategyName    string  `json:"strategy_name"`
 	SignalCount     int     `json:"signal_count"`
@@ -176,6 +387,27 @@ type OverviewStatisticsResponse struct {
 	StatusDistribution  *SignalStatusDistribution `json:"status_distribution,omitempty"`
 }
 
+// SymbolPerformance represents the average return of closed signals for a symbol
+type SymbolPerformance struct {
+	Symbol       string `json:"symbol"`
+	SignalCount  int    `json:"signal_count"`
+	AvgReturnPct string `json:"avg_return_pct"`
+}
+
+// StatisticsSummaryResponse represents the overview, strategy breakdown and symbol ranking for one period
+type StatisticsSummaryResponse struct {
+	Period             string                    `json:"period"`
+	TotalSignals       int                       `json:"total_signals"` // Signals generated within the period
+	ActiveSignals      int                       `json:"active_signals"`
+	OverallWinRate     *string                   `json:"overall_win_rate"`
+	AvgReturnPct       *string                   `json:"avg_return_pct"`
+	StatusDistribution *SignalStatusDistribution `json:"status_distribution"` // Of signals generated within the period
+	StrategyBreakdown  []StrategyPerformance24h  `json:"strategy_breakdown"`
+	Strategies         []*StatisticsResponse     `json:"strategies"`    // Full strategy-level statistics
+	TopSymbols         []SymbolPerformance       `json:"top_symbols"`   // Best average return first
+	WorstSymbols       []SymbolPerformance       `json:"worst_symbols"` // Worst average return first
+}
+
 // ComparisonMetrics represents comparison metrics across strategies
 type ComparisonMetrics struct {
 	WinRates      map[string]string `json:"win_rates"`       // strategy -> win rate
@@ -197,8 +429,167 @@ type StrategyComparisonResponse struct {
 
 // StrategyResponse represents a trading strategy
 type StrategyResponse struct {
-	Key         string `json:"key"`
-	Name        string `json:"name"`
-	Enabled     bool   `json:"enabled"`
-	Description string `json:"description"`
+	Key         string                 `json:"key"`
+	Name        string                 `json:"name"`
+	Enabled     bool                   `json:"enabled"`
+	Description string                 `json:"description"`
+	Parameters  map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// StrategyParameterSchema describes a tunable strategy parameter
+type StrategyParameterSchema struct {
+	Name         string      `json:"name"`
+	Type         string      `json:"type"` // bool, int, float or string
+	Value        interface{} `json:"value"`
+	Min          *float64    `json:"min,omitempty"`
+	ExclusiveMin *float64    `json:"exclusive_min,omitempty"` // Value must be greater than this
+	Max          *float64    `json:"max,omitempty"`
+	Enum         []string    `json:"enum,omitempty"`
+	Description  string      `json:"description"`
+}
+
+// StrategySchemaResponse represents the tunable parameters of a strategy
+type StrategySchemaResponse struct {
+	Key        string                     `json:"key"`
+	Name       string                     `json:"name"`
+	Enabled    bool                       `json:"enabled"`
+	Parameters []*StrategyParameterSchema `json:"parameters"`
+}
+
+// StrategyPreviewResponse represents the dry-run result of a single strategy
+type StrategyPreviewResponse struct {
+	StrategyKey  string `json:"strategy_key"`
+	StrategyName string `json:"strategy_name"`
+	WouldFire    bool   `json:"would_fire"`
+	Direction    string `json:"direction,omitempty"` // LONG or SHORT when the strategy would fire
+	Reason       string `json:"reason,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+// AnalyzePreviewResponse represents the dry-run analysis result for a symbol
+type AnalyzePreviewResponse struct {
+	Symbol                 string                    `json:"symbol"`
+	DryRun                 bool                      `json:"dry_run"`
+	MarketData             *MarketDataResponse       `json:"market_data"`
+	DataAgeSeconds         int64                     `json:"data_age_seconds"`
+	IsStale                bool                      `json:"is_stale"`
+	NewlyListed            bool                      `json:"newly_listed"`
+	InCooldown             bool                      `json:"in_cooldown"`
+	ConcurrentLimitReached bool                      `json:"concurrent_limit_reached"`
+	LiquidityTier          string                    `json:"liquidity_tier,omitempty"`
+	Metrics                map[string]interface{}    `json:"metrics"`
+	Strategies             []StrategyPreviewResponse `json:"strategies"`
+}
+
+// ConsensusResponse represents how the enabled strategies currently agree on a symbol
+type ConsensusResponse struct {
+	Symbol         string                    `json:"symbol"`
+	MarketData     *MarketDataResponse       `json:"market_data"`
+	DataAgeSeconds int64                     `json:"data_age_seconds"`
+	IsStale        bool                      `json:"is_stale"`
+	Strategies     []StrategyPreviewResponse `json:"strategies"`
+	Summary        ConsensusSummaryResponse  `json:"summary"`
+}
+
+// ConsensusSummaryResponse represents the vote tally of the strategies that would fire
+type ConsensusSummaryResponse struct {
+	LongVotes    int    `json:"long_votes"`
+	ShortVotes   int    `json:"short_votes"`
+	NetVotes     int    `json:"net_votes"` // Long minus short votes
+	Abstained    int    `json:"abstained"`
+	Direction    string `json:"direction"`     // LONG, SHORT or NEUTRAL
+	AgreementPct string `json:"agreement_pct"` // Share of firing strategies voting for direction
+}
+
+// AnalyzeRunResponse represents the result of an on-demand symbol analysis
+type AnalyzeRunResponse struct {
+	Symbol    string            `json:"symbol"`
+	Refreshed bool              `json:"refreshed"`
+	Signals   []*SignalResponse `json:"signals"`
+	Count     int               `json:"count"`
+}
+
+// AnalysisPauseResponse represents whether new signal generation is paused
+type AnalysisPauseResponse struct {
+	Paused    bool    `json:"paused"`
+	Reason    string  `json:"reason,omitempty"`
+	UpdatedAt *string `json:"updated_at,omitempty"` // Omitted if the pause has never been set
+}
+
+// StatisticsRebuildResponse represents a statistics purge and rebuild job
+type StatisticsRebuildResponse struct {
+	JobID       string  `json:"job_id"`
+	Status      string  `json:"status"` // running, completed or failed
+	StartedAt   string  `json:"started_at"`
+	FinishedAt  *string `json:"finished_at,omitempty"`
+	DeletedRows int64   `json:"deleted_rows"`    // Old statistics rows purged, set once the job completed
+	Calculated  int     `json:"calculated"`      // Statistics saved by the recalculation
+	Failed      int     `json:"failed"`          // Statistics that failed to calculate
+	Error       string  `json:"error,omitempty"` // Set when the job failed
+}
+
+// NotificationMessage represents a notification pushed to websocket clients
+type NotificationMessage struct {
+	EventType string                 `json:"event_type"`
+	Message   string                 `json:"message"`
+	Signal    *SignalResponse        `json:"signal,omitempty"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+	Timestamp int64                  `json:"timestamp"`
+}
+
+// PaperEquityPointResponse represents one point of a paper trading equity curve
+type PaperEquityPointResponse struct {
+	SignalID        string `json:"signal_id"`
+	Symbol          string `json:"symbol"`
+	SignalType      string `json:"signal_type"`
+	ReturnPct       string `json:"return_pct"`
+	PositionSizePct string `json:"position_size_pct"`
+	PnL             string `json:"pnl"`
+	Balance         string `json:"balance"`
+	ClosedAt        string `json:"closed_at"`
+}
+
+// PaperEquityCurveResponse represents a paper trading equity curve with summary metrics
+type PaperEquityCurveResponse struct {
+	Strategy       string                     `json:"strategy"`
+	TotalTrades    int                        `json:"total_trades"`
+	StartBalance   string                     `json:"start_balance"`
+	EndBalance     string                     `json:"end_balance"`
+	TotalReturnPct string                     `json:"total_return_pct"`
+	MaxDrawdownPct string                     `json:"max_drawdown_pct"`
+	Points         []PaperEquityPointResponse `json:"points"`
+}
+
+// StatisticsTimeseriesPointResponse represents strategy performance within one day or week
+type StatisticsTimeseriesPointResponse struct {
+	BucketStart         string `json:"bucket_start"`
+	ClosedSignals       int    `json:"closed_signals"`
+	ProfitableSignals   int    `json:"profitable_signals"`
+	LosingSignals       int    `json:"losing_signals"`
+	WinRate             string `json:"win_rate"`
+	NetReturnPct        string `json:"net_return_pct"`
+	CumulativeReturnPct string `json:"cumulative_return_pct"`
+}
+
+// StatisticsTimeseriesResponse represents strategy performance over time
+type StatisticsTimeseriesResponse struct {
+	Strategy string                              `json:"strategy"` // Empty for all strategies
+	Period   string                              `json:"period"`
+	Points   []StatisticsTimeseriesPointResponse `json:"points"`
+}
+
+// StatisticsAlertResponse represents a significant change of a statistics metric
+type StatisticsAlertResponse struct {
+	ID                   int64   `json:"id"`
+	StrategyName         string  `json:"strategy_name"`
+	Symbol               *string `json:"symbol,omitempty"`
+	PeriodLabel          string  `json:"period_label"`
+	MetricName           string  `json:"metric_name"`
+	PreviousValue        string  `json:"previous_value"`
+	CurrentValue         string  `json:"current_value"`
+	Change               string  `json:"change"`
+	ChangeType           string  `json:"change_type"` // "percentage" or "percentage_points"
+	PreviousCalculatedAt string  `json:"previous_calculated_at"`
+	CurrentCalculatedAt  string  `json:"current_calculated_at"`
+	CreatedAt            string  `json:"created_at"`
 }
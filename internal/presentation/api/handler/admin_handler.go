@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"net/http"
+
+	"ContractAnalysis/internal/infrastructure/logger"
+	"ContractAnalysis/internal/presentation/api/dto"
+	"ContractAnalysis/internal/presentation/api/middleware"
+	"ContractAnalysis/internal/presentation/api/serializer"
+	"ContractAnalysis/internal/usecase"
+	apierrors "ContractAnalysis/pkg/errors"
+	"ContractAnalysis/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// AdminHandler handles operator requests controlling the running system
+type AdminHandler struct {
+	analyzer   *usecase.Analyzer
+	calculator *usecase.StatisticsCalculator
+	logger     *logger.Logger
+}
+
+// NewAdminHandler creates a new admin handler
+func NewAdminHandler(analyzer *usecase.Analyzer, calculator *usecase.StatisticsCalculator, log *logger.Logger) *AdminHandler {
+	return &AdminHandler{
+		analyzer:   analyzer,
+		calculator: calculator,
+		logger:     log,
+	}
+}
+
+// GetAnalysisPause handles GET /api/v1/admin/pause-analysis
+func (h *AdminHandler) GetAnalysisPause(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	if h.analyzer == nil {
+		apiErr := apierrors.NewInternalServerError("Analyzer is not available")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	pause, err := h.analyzer.GetAnalysisPause(c.Request.Context())
+	if err != nil {
+		reqLog.Error("Failed to get analysis pause", zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve analysis pause").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "success", serializer.ToAnalysisPauseResponse(pause))
+}
+
+// SetAnalysisPause handles POST /api/v1/admin/pause-analysis
+// Pausing stops new signals from being generated, while existing signals keep being
+// confirmed and tracked to their exits
+func (h *AdminHandler) SetAnalysisPause(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	var req dto.AnalysisPauseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apiErr := apierrors.NewValidationError("Invalid request body", err.Error())
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	if h.analyzer == nil {
+		apiErr := apierrors.NewInternalServerError("Analyzer is not available")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	pause, err := h.analyzer.SetAnalysisPause(c.Request.Context(), *req.Paused, req.Reason)
+	if err != nil {
+		reqLog.Error("Failed to set analysis pause", zap.Bool("paused", *req.Paused), zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to update analysis pause").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	reqLog.Info("Analysis pause updated", zap.Bool("paused", pause.Paused), zap.String("reason", pause.Reason))
+
+	utils.SuccessResponse(c, http.StatusOK, "success", serializer.ToAnalysisPauseResponse(pause))
+}
+
+// RebuildStatistics handles POST /api/v1/admin/statistics/rebuild
+// Recalculates all statistics in the background and then deletes every record the
+// recalculation didn't write, e.g. after a calculation fix; poll
+// GET /api/v1/admin/statistics/rebuild/:id for the outcome
+// While a rebuild is running the running job is returned instead of starting another
+func (h *AdminHandler) RebuildStatistics(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	if h.calculator == nil {
+		apiErr := apierrors.NewInternalServerError("Statistics calculator is not available")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	job, started := h.calculator.StartRebuild()
+	if !started {
+		reqLog.Info("Statistics rebuild already running", zap.String("job_id", job.ID))
+		utils.SuccessResponse(c, http.StatusAccepted, "Statistics rebuild already running", serializer.ToStatisticsRebuildResponse(job))
+		return
+	}
+
+	reqLog.Info("Statistics rebuild started", zap.String("job_id", job.ID))
+
+	utils.SuccessResponse(c, http.StatusAccepted, "Statistics rebuild started", serializer.ToStatisticsRebuildResponse(job))
+}
+
+// GetStatisticsRebuild handles GET /api/v1/admin/statistics/rebuild/:id
+func (h *AdminHandler) GetStatisticsRebuild(c *gin.Context) {
+	if h.calculator == nil {
+		apiErr := apierrors.NewInternalServerError("Statistics calculator is not available")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	job := h.calculator.GetRebuild(c.Param("id"))
+	if job == nil {
+		apiErr := apierrors.NewNotFoundError("Statistics rebuild job not found")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "success", serializer.ToStatisticsRebuildResponse(job))
+}
@@ -0,0 +1,200 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"ContractAnalysis/internal/infrastructure/logger"
+	"ContractAnalysis/internal/presentation/api/dto"
+	"ContractAnalysis/internal/presentation/api/middleware"
+	"ContractAnalysis/internal/presentation/api/serializer"
+	"ContractAnalysis/internal/usecase"
+	apierrors "ContractAnalysis/pkg/errors"
+	"ContractAnalysis/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// AnalysisHandler handles on-demand analysis requests
+type AnalysisHandler struct {
+	analyzer  *usecase.Analyzer
+	collector *usecase.Collector
+	symbols   *utils.SymbolValidator
+	logger    *logger.Logger
+}
+
+// NewAnalysisHandler creates a new analysis handler
+// symbols may be nil, in which case symbols are only normalized, not checked against the exchange
+func NewAnalysisHandler(analyzer *usecase.Analyzer, collector *usecase.Collector, symbols *utils.SymbolValidator, log *logger.Logger) *AnalysisHandler {
+	return &AnalysisHandler{
+		analyzer:  analyzer,
+		collector: collector,
+		symbols:   symbols,
+		logger:    log,
+	}
+}
+
+// resolveSymbol normalizes a requested symbol and rejects symbols not listed on the exchange
+// with a 400, so typos fail fast instead of reaching Binance or reporting missing data
+// symbols may be nil, in which case the symbol is only normalized
+// Returns false if an error response was written
+func resolveSymbol(c *gin.Context, symbols *utils.SymbolValidator, raw string) (string, bool) {
+	if symbols == nil {
+		return utils.NormalizeSymbol(raw), true
+	}
+
+	symbol, err := symbols.Normalize(c.Request.Context(), raw)
+	if err != nil {
+		apiErr := apierrors.NewBadRequestError("Invalid symbol", err.Error())
+		utils.ErrorResponse(c, apiErr)
+		return "", false
+	}
+	return symbol, true
+}
+
+// AnalyzeSymbol handles GET /api/v1/analyze/:symbol?dry=true
+// Evaluates every enabled strategy against the latest market data without persisting signals
+func (h *AnalysisHandler) AnalyzeSymbol(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	var req dto.AnalyzePreviewRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apiErr := apierrors.NewValidationError("Invalid query parameters", err.Error())
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	if !req.Dry {
+		apiErr := apierrors.NewBadRequestError("Only dry-run analysis is supported, use dry=true")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	if h.analyzer == nil {
+		apiErr := apierrors.NewInternalServerError("Analyzer is not available")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	symbol, ok := resolveSymbol(c, h.symbols, c.Param("symbol"))
+	if !ok {
+		return
+	}
+	ctx := c.Request.Context()
+
+	preview, err := h.analyzer.PreviewSymbol(ctx, symbol)
+	if err != nil {
+		reqLog.Error("Failed to preview symbol analysis", zap.String("symbol", symbol), zap.Error(err))
+		apiErr := apierrors.NewInternalServerError("Failed to analyze symbol").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	if preview == nil {
+		apiErr := apierrors.NewNotFoundError("No market data available for symbol")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	response := serializer.ToAnalyzePreviewResponse(preview)
+	utils.SuccessResponse(c, http.StatusOK, "success", response)
+}
+
+// GetConsensus handles GET /api/v1/consensus?symbol=
+// Reports which direction each enabled strategy would take on the latest market data
+// of a symbol, plus the net long/short vote, without persisting signals
+func (h *AnalysisHandler) GetConsensus(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	var req dto.ConsensusRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apiErr := apierrors.NewValidationError("Invalid query parameters", err.Error())
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	if h.analyzer == nil {
+		apiErr := apierrors.NewInternalServerError("Analyzer is not available")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	symbol, ok := resolveSymbol(c, h.symbols, req.Symbol)
+	if !ok {
+		return
+	}
+
+	consensus, err := h.analyzer.GetConsensus(c.Request.Context(), symbol)
+	if err != nil {
+		reqLog.Error("Failed to compute strategy consensus", zap.String("symbol", symbol), zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to compute strategy consensus").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	if consensus == nil {
+		apiErr := apierrors.NewNotFoundError("No market data available for symbol")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	response := serializer.ToConsensusResponse(consensus)
+	utils.SuccessResponse(c, http.StatusOK, "success", response)
+}
+
+// RunSymbolAnalysis handles POST /api/v1/analyze/:symbol?refresh=true
+// Optionally fetches fresh market data from Binance, then runs analysis and stores any generated signals
+func (h *AnalysisHandler) RunSymbolAnalysis(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	var req dto.AnalyzeRunRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apiErr := apierrors.NewValidationError("Invalid query parameters", err.Error())
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	if h.analyzer == nil || (req.Refresh && h.collector == nil) {
+		apiErr := apierrors.NewInternalServerError("Analyzer is not available")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	symbol, ok := resolveSymbol(c, h.symbols, c.Param("symbol"))
+	if !ok {
+		return
+	}
+	ctx := c.Request.Context()
+
+	if req.Refresh {
+		if err := h.collector.CollectForSymbol(ctx, symbol); err != nil {
+			reqLog.Error("Failed to refresh market data", zap.String("symbol", symbol), zap.Error(err))
+			apiErr := apierrors.NewServiceError("Failed to fetch fresh market data for symbol").WithCause(err)
+			utils.ErrorResponse(c, apiErr)
+			return
+		}
+	}
+
+	signals, err := h.analyzer.AnalyzeSymbol(ctx, symbol)
+	if errors.Is(err, usecase.ErrAnalysisPaused) {
+		apiErr := apierrors.NewServiceUnavailableError("Signal analysis is paused")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+	if err != nil {
+		reqLog.Error("Failed to analyze symbol", zap.String("symbol", symbol), zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to analyze symbol").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	reqLog.Info("On-demand analysis completed",
+		zap.String("symbol", symbol),
+		zap.Bool("refreshed", req.Refresh),
+		zap.Int("signals", len(signals)),
+	)
+
+	response := serializer.ToAnalyzeRunResponse(symbol, req.Refresh, signals)
+	utils.SuccessResponse(c, http.StatusOK, "success", response)
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"ContractAnalysis/internal/presentation/api/dto"
+	"ContractAnalysis/internal/presentation/api/serializer"
+	"ContractAnalysis/pkg/buildinfo"
 	"ContractAnalysis/pkg/utils"
 
 	"github.com/gin-gonic/gin"
@@ -12,17 +14,22 @@ import (
 
 // HealthHandler handles health check requests
 type HealthHandler struct {
-	version string
+	version        string
+	binanceBreaker *utils.CircuitBreaker
 }
 
 // NewHealthHandler creates a new health handler
-func NewHealthHandler(version string) *HealthHandler {
+// binanceBreaker may be nil when the Binance circuit breaker is disabled
+func NewHealthHandler(version string, binanceBreaker *utils.CircuitBreaker) *HealthHandler {
 	return &HealthHandler{
-		version: version,
+		version:        version,
+		binanceBreaker: binanceBreaker,
 	}
 }
 
 // Check handles GET /api/v1/health
+// The API keeps answering while Binance is failing, so an open breaker reports
+// "degraded" without changing the status code probes rely on
 func (h *HealthHandler) Check(c *gin.Context) {
 	response := &dto.HealthResponse{
 		Status:    "healthy",
@@ -30,5 +37,28 @@ func (h *HealthHandler) Check(c *gin.Context) {
 		Version:   h.version,
 	}
 
+	if h.binanceBreaker != nil {
+		stats := h.binanceBreaker.Stats()
+		if stats.State != utils.CircuitClosed {
+			response.Status = "degraded"
+		}
+		response.BinanceCircuit = serializer.ToCircuitBreakerResponse(stats)
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "success", response)
+}
+
+// Version handles GET /api/v1/version
+// Reports the build metadata so operators can confirm which binary is deployed
+func (h *HealthHandler) Version(c *gin.Context) {
+	info := buildinfo.Get(h.version)
+
+	response := &dto.VersionResponse{
+		Version:   info.Version,
+		GitCommit: info.GitCommit,
+		BuildTime: info.BuildTime,
+		GoVersion: info.GoVersion,
+	}
+
 	utils.SuccessResponse(c, http.StatusOK, "success", response)
 }
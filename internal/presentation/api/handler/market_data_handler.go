@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"ContractAnalysis/internal/domain/repository"
+	"ContractAnalysis/internal/infrastructure/binance"
+	"ContractAnalysis/internal/infrastructure/logger"
+	"ContractAnalysis/internal/presentation/api/middleware"
+	"ContractAnalysis/internal/presentation/api/serializer"
+	apierrors "ContractAnalysis/pkg/errors"
+	"ContractAnalysis/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// MarketDataHandler handles market data requests
+type MarketDataHandler struct {
+	binanceClient  *binance.Client
+	marketDataRepo repository.MarketDataRepository
+	symbols        *utils.SymbolValidator
+	logger         *logger.Logger
+}
+
+// NewMarketDataHandler creates a new market data handler
+// symbols may be nil, in which case symbols are only normalized, not checked against the exchange
+func NewMarketDataHandler(binanceClient *binance.Client, marketDataRepo repository.MarketDataRepository, symbols *utils.SymbolValidator, log *logger.Logger) *MarketDataHandler {
+	return &MarketDataHandler{
+		binanceClient:  binanceClient,
+		marketDataRepo: marketDataRepo,
+		symbols:        symbols,
+		logger:         log,
+	}
+}
+
+// GetLiveMarketData handles GET /api/v1/market-data/:symbol/live
+// Fetches the current market data of a symbol straight from Binance, without retries,
+// validation or storing it, and returns it next to the latest stored data point
+// Intended for debugging collection issues
+func (h *MarketDataHandler) GetLiveMarketData(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	if h.binanceClient == nil {
+		apiErr := apierrors.NewInternalServerError("Binance client is not available")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	symbol, ok := resolveSymbol(c, h.symbols, c.Param("symbol"))
+	if !ok {
+		return
+	}
+	ctx := c.Request.Context()
+
+	live, err := h.binanceClient.GetMarketData(ctx, symbol)
+	if err != nil {
+		reqLog.Warn("Failed to fetch live market data", zap.String("symbol", symbol), zap.Error(err))
+
+		var apiErr *apierrors.APIError
+		switch {
+		case errors.Is(err, binance.ErrNoData):
+			apiErr = apierrors.NewNotFoundError("Binance returned no market data for symbol")
+		case errors.Is(err, binance.ErrCircuitOpen), errors.Is(err, binance.ErrRateLimited):
+			apiErr = apierrors.NewServiceUnavailableError("Binance is temporarily unavailable")
+		default:
+			apiErr = apierrors.NewServiceError("Failed to fetch live market data from Binance").WithCause(err)
+		}
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	stored, err := h.marketDataRepo.GetLatestBySymbol(ctx, symbol)
+	if err != nil {
+		reqLog.Error("Failed to get latest stored market data", zap.String("symbol", symbol), zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve stored market data").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "success", serializer.ToLiveMarketDataResponse(live, stored))
+}
@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	"ContractAnalysis/internal/domain/repository"
+	"ContractAnalysis/internal/infrastructure/logger"
+	"ContractAnalysis/internal/presentation/api/dto"
+	"ContractAnalysis/internal/presentation/api/middleware"
+	"ContractAnalysis/internal/presentation/api/serializer"
+	"ContractAnalysis/internal/usecase"
+	apierrors "ContractAnalysis/pkg/errors"
+	"ContractAnalysis/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// PairHandler handles trading pair requests
+type PairHandler struct {
+	tradingPairRepo repository.TradingPairRepository
+	collector       *usecase.Collector
+	analyzer        *usecase.Analyzer
+	logger          *logger.Logger
+}
+
+// NewPairHandler creates a new trading pair handler
+func NewPairHandler(tradingPairRepo repository.TradingPairRepository, collector *usecase.Collector, analyzer *usecase.Analyzer, log *logger.Logger) *PairHandler {
+	return &PairHandler{
+		tradingPairRepo: tradingPairRepo,
+		collector:       collector,
+		analyzer:        analyzer,
+		logger:          log,
+	}
+}
+
+// toPairResponse converts a pair to its response with the strategies analyzing it
+func (h *PairHandler) toPairResponse(pair *repository.TradingPair) *dto.TradingPairResponse {
+	return serializer.ToTradingPairResponse(pair, h.analyzer.StrategiesForSymbol(pair.Symbol, pair.LiquidityTier))
+}
+
+// GetPairs handles GET /api/v1/pairs
+// Supports filtering by active state with ?is_active=true|false
+func (h *PairHandler) GetPairs(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	var req dto.PairListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apiErr := apierrors.NewValidationError("Invalid query parameters", err.Error())
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	pagination, apiErr := utils.ParsePaginationParams(c)
+	if apiErr != nil {
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	pairs, err := h.tradingPairRepo.GetAll(c.Request.Context())
+	if err != nil {
+		reqLog.Error("Failed to get trading pairs", zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve trading pairs").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	filtered := make([]*repository.TradingPair, 0, len(pairs))
+	for _, pair := range pairs {
+		if req.IsActive != nil && pair.IsActive != *req.IsActive {
+			continue
+		}
+		filtered = append(filtered, pair)
+	}
+
+	// Sort by symbol so pages are stable
+	sort.Slice(filtered, func(i, j int) bool {
+		return filtered[i].Symbol < filtered[j].Symbol
+	})
+
+	total := len(filtered)
+	start := min(pagination.Offset, total)
+	end := min(start+pagination.Limit, total)
+
+	response := make([]*dto.TradingPairResponse, 0, end-start)
+	for _, pair := range filtered[start:end] {
+		response = append(response, h.toPairResponse(pair))
+	}
+
+	utils.PaginatedSuccessResponse(c, http.StatusOK, "success", response, pagination.Page, pagination.Limit, total)
+}
+
+// UpdatePair handles PUT /api/v1/pairs/:symbol
+// Pauses or resumes collection and analysis for a pair
+func (h *PairHandler) UpdatePair(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	var req dto.PairUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apiErr := apierrors.NewValidationError("Invalid request body", err.Error())
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	symbol := utils.NormalizeSymbol(c.Param("symbol"))
+	ctx := c.Request.Context()
+
+	var pair *repository.TradingPair
+	var err error
+	if *req.IsActive {
+		// Reactivating also clears the collection failure count
+		pair, err = h.collector.ReactivatePair(ctx, symbol)
+	} else {
+		pair, err = h.deactivatePair(ctx, symbol)
+	}
+	if err != nil {
+		reqLog.Error("Failed to update trading pair",
+			zap.String("symbol", symbol),
+			zap.Bool("is_active", *req.IsActive),
+			zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to update trading pair").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	if pair == nil {
+		apiErr := apierrors.NewNotFoundError("Trading pair not found")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	reqLog.Info("Trading pair updated", zap.String("symbol", symbol), zap.Bool("is_active", pair.IsActive))
+
+	utils.SuccessResponse(c, http.StatusOK, "success", h.toPairResponse(pair))
+}
+
+// deactivatePair marks a pair inactive, returning nil if it does not exist
+func (h *PairHandler) deactivatePair(ctx context.Context, symbol string) (*repository.TradingPair, error) {
+	pair, err := h.tradingPairRepo.GetBySymbol(ctx, symbol)
+	if err != nil {
+		return nil, err
+	}
+	if pair == nil {
+		return nil, nil
+	}
+
+	if pair.IsActive {
+		if err := h.tradingPairRepo.SetActive(ctx, symbol, false); err != nil {
+			return nil, err
+		}
+		pair.IsActive = false
+	}
+
+	return pair, nil
+}
+
+// ActivatePair handles POST /api/v1/pairs/:symbol/activate
+// Re-enables a pair that was deactivated after repeated collection failures
+func (h *PairHandler) ActivatePair(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	symbol := utils.NormalizeSymbol(c.Param("symbol"))
+	ctx := c.Request.Context()
+
+	pair, err := h.collector.ReactivatePair(ctx, symbol)
+	if err != nil {
+		reqLog.Error("Failed to reactivate trading pair", zap.String("symbol", symbol), zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to activate trading pair").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	if pair == nil {
+		apiErr := apierrors.NewNotFoundError("Trading pair not found")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "success", h.toPairResponse(pair))
+}
+
+// GetCollectionStatus handles GET /api/v1/collection/status
+// Reports stored data volume and the per-run and smoothed collection success rates
+func (h *PairHandler) GetCollectionStatus(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	status, err := h.collector.GetCollectionStatus(c.Request.Context())
+	if err != nil {
+		reqLog.Error("Failed to get collection status", zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve collection status").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	response := serializer.ToCollectionStatusResponse(status)
+	utils.SuccessResponse(c, http.StatusOK, "success", response)
+}
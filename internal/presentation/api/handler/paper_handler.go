@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"net/http"
+
+	"ContractAnalysis/internal/domain/entity"
+	"ContractAnalysis/internal/domain/repository"
+	"ContractAnalysis/internal/infrastructure/logger"
+	"ContractAnalysis/internal/presentation/api/dto"
+	"ContractAnalysis/internal/presentation/api/middleware"
+	"ContractAnalysis/internal/presentation/api/serializer"
+	apierrors "ContractAnalysis/pkg/errors"
+	"ContractAnalysis/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// PaperHandler handles paper trading requests
+type PaperHandler struct {
+	equityRepo repository.PaperEquityRepository
+	logger     *logger.Logger
+}
+
+// NewPaperHandler creates a new paper trading handler
+func NewPaperHandler(equityRepo repository.PaperEquityRepository, log *logger.Logger) *PaperHandler {
+	return &PaperHandler{
+		equityRepo: equityRepo,
+		logger:     log,
+	}
+}
+
+// GetEquity handles GET /api/v1/paper/equity
+func (h *PaperHandler) GetEquity(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	var req dto.PaperEquityRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apiErr := apierrors.NewValidationError("Invalid query parameters", err.Error())
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	if req.Start != nil && req.End != nil && req.End.Before(*req.Start) {
+		apiErr := apierrors.NewBadRequestError("end must be after start", "")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	// Default to the combined portfolio curve
+	strategy := req.StrategyName
+	if strategy == "" {
+		strategy = entity.PaperPortfolioScope
+	}
+
+	snapshots, err := h.equityRepo.GetCurve(c.Request.Context(), strategy, req.Start, req.End)
+	if err != nil {
+		reqLog.Error("Failed to get paper equity curve", zap.String("strategy", strategy), zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve equity curve").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "success", serializer.ToPaperEquityCurveResponse(strategy, snapshots))
+}
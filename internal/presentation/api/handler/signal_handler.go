@@ -1,13 +1,18 @@
 package handler
 
 import (
+	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"ContractAnalysis/internal/domain/entity"
 	"ContractAnalysis/internal/domain/repository"
 	"ContractAnalysis/internal/infrastructure/logger"
 	"ContractAnalysis/internal/presentation/api/dto"
+	"ContractAnalysis/internal/presentation/api/middleware"
 	"ContractAnalysis/internal/presentation/api/serializer"
+	"ContractAnalysis/internal/usecase"
 	apierrors "ContractAnalysis/pkg/errors"
 	"ContractAnalysis/pkg/utils"
 
@@ -15,22 +20,29 @@ import (
 	"go.uber.org/zap"
 )
 
+// maxBatchSignalIDs caps the number of IDs accepted by the bulk lookup endpoint
+const maxBatchSignalIDs = 100
+
 // SignalHandler handles signal-related requests
 type SignalHandler struct {
 	signalRepo repository.SignalRepository
+	tracker    *usecase.Tracker
 	logger     *logger.Logger
 }
 
 // NewSignalHandler creates a new signal handler
-func NewSignalHandler(signalRepo repository.SignalRepository, log *logger.Logger) *SignalHandler {
+func NewSignalHandler(signalRepo repository.SignalRepository, tracker *usecase.Tracker, log *logger.Logger) *SignalHandler {
 	return &SignalHandler{
 		signalRepo: signalRepo,
+		tracker:    tracker,
 		logger:     log,
 	}
 }
 
 // GetSignals handles GET /api/v1/signals
 func (h *SignalHandler) GetSignals(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
 	var req dto.SignalListRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
 		apiErr := apierrors.NewValidationError("Invalid query parameters", err.Error())
@@ -50,9 +62,10 @@ func (h *SignalHandler) GetSignals(c *gin.Context) {
 	// Construct filters for the repository
 	filters := repository.SignalFilterParams{
 		Status:       req.Status,
-		Symbol:       req.Symbol,
+		Symbol:       utils.NormalizeSymbol(req.Symbol),
 		StrategyName: req.StrategyName,
 		Type:         req.Type,
+		Outcome:      req.Outcome,
 		StartTime:    req.StartTime,
 		EndTime:      req.EndTime,
 	}
@@ -60,8 +73,8 @@ func (h *SignalHandler) GetSignals(c *gin.Context) {
 	// Get signals with outcomes using single LEFT JOIN query (optimized)
 	signalsWithOutcomes, total, err := h.signalRepo.GetSignalsWithOutcomes(ctx, filters, pagination.Offset, pagination.Limit)
 	if err != nil {
-		h.logger.Error("Failed to get signals with outcomes", zap.Error(err))
-		apiErr := apierrors.NewDatabaseError("Failed to retrieve signals")
+		reqLog.Error("Failed to get signals with outcomes", zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve signals").WithCause(err)
 		utils.ErrorResponse(c, apiErr)
 		return
 	}
@@ -77,12 +90,14 @@ func (h *SignalHandler) GetSignals(c *gin.Context) {
 
 // GetSignalByID handles GET /api/v1/signals/:id
 func (h *SignalHandler) GetSignalByID(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
 	signalID := c.Param("id")
 	ctx := c.Request.Context()
 
 	signal, err := h.signalRepo.GetByID(ctx, signalID)
 	if err != nil {
-		h.logger.Error("Failed to get signal", zap.String("signal_id", signalID), zap.Error(err))
+		reqLog.Error("Failed to get signal", zap.String("signal_id", signalID), zap.Error(err))
 		apiErr := apierrors.NewNotFoundError("Signal not found")
 		utils.ErrorResponse(c, apiErr)
 		return
@@ -93,7 +108,7 @@ func (h *SignalHandler) GetSignalByID(c *gin.Context) {
 	if signal.Status == entity.SignalStatusClosed {
 		outcome, err = h.signalRepo.GetOutcome(ctx, signalID)
 		if err != nil {
-			h.logger.Warn("Failed to get outcome for closed signal", zap.String("signal_id", signalID), zap.Error(err))
+			reqLog.Warn("Failed to get outcome for closed signal", zap.String("signal_id", signalID), zap.Error(err))
 		}
 	}
 
@@ -101,15 +116,166 @@ func (h *SignalHandler) GetSignalByID(c *gin.Context) {
 	utils.SuccessResponse(c, http.StatusOK, "success", response)
 }
 
+// GetSignalsBatch handles GET /api/v1/signals/batch?ids=a,b,c
+func (h *SignalHandler) GetSignalsBatch(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	var req dto.SignalBatchRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apiErr := apierrors.NewValidationError("Invalid query parameters", err.Error())
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	// Parse and de-duplicate IDs, preserving request order
+	seen := make(map[string]bool)
+	signalIDs := make([]string, 0)
+	for _, id := range strings.Split(req.IDs, ",") {
+		id = strings.TrimSpace(id)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		signalIDs = append(signalIDs, id)
+	}
+
+	if len(signalIDs) == 0 {
+		apiErr := apierrors.NewValidationError("At least one signal ID is required")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	if len(signalIDs) > maxBatchSignalIDs {
+		apiErr := apierrors.NewValidationError(fmt.Sprintf("Too many signal IDs, maximum is %d", maxBatchSignalIDs))
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	signals, err := h.signalRepo.GetByIDs(ctx, signalIDs)
+	if err != nil {
+		reqLog.Error("Failed to get signals by IDs", zap.Int("count", len(signalIDs)), zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve signals").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	outcomes, err := h.signalRepo.GetOutcomesBySignalIDs(ctx, signalIDs)
+	if err != nil {
+		reqLog.Warn("Failed to get outcomes for signals", zap.Error(err))
+		outcomes = make(map[string]*entity.SignalOutcome)
+	}
+
+	found := make(map[string]bool, len(signals))
+	response := &dto.SignalBatchResponse{
+		Signals:    make([]*dto.SignalResponse, 0, len(signals)),
+		MissingIDs: make([]string, 0),
+	}
+	for _, signal := range signals {
+		found[signal.SignalID] = true
+		response.Signals = append(response.Signals, serializer.ToSignalResponseWithOutcome(signal, outcomes[signal.SignalID]))
+	}
+
+	for _, id := range signalIDs {
+		if !found[id] {
+			response.MissingIDs = append(response.MissingIDs, id)
+		}
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "success", response)
+}
+
+// GetSignalContext handles GET /api/v1/signals/:id/context
+// Returns the full reason, market data and strategy config a signal was generated with
+func (h *SignalHandler) GetSignalContext(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	signalID := c.Param("id")
+
+	signal, err := h.signalRepo.GetByID(c.Request.Context(), signalID)
+	if err != nil {
+		reqLog.Error("Failed to get signal", zap.String("signal_id", signalID), zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve signal").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	if signal == nil {
+		apiErr := apierrors.NewNotFoundError("Signal not found")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "success", serializer.ToSignalContextResponse(signal))
+}
+
+// GetSignalDetail handles GET /api/v1/signals/:id/full
+// Returns the signal with its tracking timeline, kline tracking, outcome and notes in one response
+func (h *SignalHandler) GetSignalDetail(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	signalID := c.Param("id")
+
+	detail, err := h.signalRepo.GetSignalDetail(c.Request.Context(), signalID)
+	if err != nil {
+		reqLog.Error("Failed to get signal detail", zap.String("signal_id", signalID), zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve signal").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	if detail == nil {
+		apiErr := apierrors.NewNotFoundError("Signal not found")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "success", serializer.ToSignalDetailResponse(detail))
+}
+
+// GetSignalConfig handles GET /api/v1/signals/:id/config
+// Returns the strategy config snapshot the signal was generated with, so its conditions
+// can be reproduced after the live config has changed
+func (h *SignalHandler) GetSignalConfig(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	signalID := c.Param("id")
+
+	signal, err := h.signalRepo.GetByID(c.Request.Context(), signalID)
+	if err != nil {
+		reqLog.Error("Failed to get signal", zap.String("signal_id", signalID), zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve signal").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	if signal == nil {
+		apiErr := apierrors.NewNotFoundError("Signal not found")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	// Signals generated before snapshots were recorded have none
+	snapshot := signal.ConfigSnapshot
+	if snapshot == nil {
+		snapshot = map[string]interface{}{}
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "success", snapshot)
+}
+
 // GetSignalTracking handles GET /api/v1/signals/:id/tracking
 func (h *SignalHandler) GetSignalTracking(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
 	signalID := c.Param("id")
 	ctx := c.Request.Context()
 
 	trackings, err := h.signalRepo.GetAllTracking(ctx, signalID)
 	if err != nil {
-		h.logger.Error("Failed to get signal tracking", zap.String("signal_id", signalID), zap.Error(err))
-		apiErr := apierrors.NewDatabaseError("Failed to retrieve tracking data")
+		reqLog.Error("Failed to get signal tracking", zap.String("signal_id", signalID), zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve tracking data").WithCause(err)
 		utils.ErrorResponse(c, apiErr)
 		return
 	}
@@ -118,15 +284,138 @@ func (h *SignalHandler) GetSignalTracking(c *gin.Context) {
 	utils.SuccessResponse(c, http.StatusOK, "success", response)
 }
 
+// GetSignalOutcome handles GET /api/v1/signals/:id/outcome
+func (h *SignalHandler) GetSignalOutcome(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	signalID := c.Param("id")
+	ctx := c.Request.Context()
+
+	outcome, err := h.signalRepo.GetOutcome(ctx, signalID)
+	if err != nil {
+		reqLog.Error("Failed to get signal outcome", zap.String("signal_id", signalID), zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve outcome").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	if outcome == nil {
+		apiErr := apierrors.NewNotFoundError("Outcome not found")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	response := serializer.ToSignalOutcomeResponse(outcome)
+	utils.SuccessResponse(c, http.StatusOK, "success", response)
+}
+
+// AddSignalNote handles POST /api/v1/signals/:id/notes
+// Attaches a free-text review note to an existing signal
+func (h *SignalHandler) AddSignalNote(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	var req dto.SignalNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apiErr := apierrors.NewValidationError("Invalid request body", err.Error())
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	signalID := c.Param("id")
+	ctx := c.Request.Context()
+
+	note := entity.NewSignalNote(signalID, req.Author, req.Text)
+	if err := note.Validate(); err != nil {
+		apiErr := apierrors.NewValidationError("Invalid note", err.Error())
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	signal, err := h.signalRepo.GetByID(ctx, signalID)
+	if err != nil {
+		reqLog.Error("Failed to get signal", zap.String("signal_id", signalID), zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve signal").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	if signal == nil {
+		apiErr := apierrors.NewNotFoundError("Signal not found")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	if err := h.signalRepo.AddNote(ctx, note); err != nil {
+		reqLog.Error("Failed to add signal note", zap.String("signal_id", signalID), zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to save note").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusCreated, "success", serializer.ToSignalNoteResponse(note))
+}
+
+// GetSignalNotes handles GET /api/v1/signals/:id/notes
+// Returns the signal's review notes, oldest first
+func (h *SignalHandler) GetSignalNotes(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	signalID := c.Param("id")
+	ctx := c.Request.Context()
+
+	signal, err := h.signalRepo.GetByID(ctx, signalID)
+	if err != nil {
+		reqLog.Error("Failed to get signal", zap.String("signal_id", signalID), zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve signal").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	if signal == nil {
+		apiErr := apierrors.NewNotFoundError("Signal not found")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	notes, err := h.signalRepo.GetNotes(ctx, signalID)
+	if err != nil {
+		reqLog.Error("Failed to get signal notes", zap.String("signal_id", signalID), zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve notes").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "success", serializer.ToSignalNoteListResponse(notes))
+}
+
+// GetTrackingSummary handles GET /api/v1/signals/tracking/summary
+// Returns the live unrealized PnL of every TRACKING signal plus aggregate totals
+func (h *SignalHandler) GetTrackingSummary(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	summary, err := h.tracker.GetOpenPositions(c.Request.Context())
+	if err != nil {
+		reqLog.Error("Failed to get open positions", zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve tracking signals").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	response := serializer.ToTrackingSummaryResponse(summary)
+	utils.SuccessResponse(c, http.StatusOK, "success", response)
+}
+
 // GetSignalKlines handles GET /api/v1/signals/:id/klines
 func (h *SignalHandler) GetSignalKlines(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
 	signalID := c.Param("id")
 	ctx := c.Request.Context()
 
 	klines, err := h.signalRepo.GetKlineTrackingBySignal(ctx, signalID)
 	if err != nil {
-		h.logger.Error("Failed to get signal klines", zap.String("signal_id", signalID), zap.Error(err))
-		apiErr := apierrors.NewDatabaseError("Failed to retrieve kline data")
+		reqLog.Error("Failed to get signal klines", zap.String("signal_id", signalID), zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve kline data").WithCause(err)
 		utils.ErrorResponse(c, apiErr)
 		return
 	}
@@ -135,14 +424,78 @@ func (h *SignalHandler) GetSignalKlines(c *gin.Context) {
 	utils.SuccessResponse(c, http.StatusOK, "success", response)
 }
 
+// BackfillSignalKlines handles POST /api/v1/signals/:id/klines/backfill
+// Re-fetches the signal's klines and creates the missing kline tracking records
+func (h *SignalHandler) BackfillSignalKlines(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	signalID := c.Param("id")
+
+	result, err := h.tracker.BackfillSignalKlines(c.Request.Context(), signalID)
+	if err != nil {
+		reqLog.Error("Failed to backfill signal klines", zap.String("signal_id", signalID), zap.Error(err))
+		apiErr := apierrors.NewServiceError("Failed to backfill kline tracking").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	if result == nil {
+		apiErr := apierrors.NewNotFoundError("Signal not found")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "success", serializer.ToKlineBackfillResponse(result))
+}
+
+// BackfillKlines handles POST /api/v1/signals/klines/backfill
+// Creates the missing kline tracking records of all signals generated in a time range
+func (h *SignalHandler) BackfillKlines(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	var req dto.KlineBackfillRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apiErr := apierrors.NewValidationError("Invalid query parameters", err.Error())
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	end := time.Now()
+	if req.EndTime != nil {
+		end = *req.EndTime
+	}
+
+	if !req.StartTime.Before(end) {
+		apiErr := apierrors.NewValidationError("Invalid time range", "start_time must be before end_time")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	result, err := h.tracker.BackfillKlines(c.Request.Context(), *req.StartTime, end)
+	if err != nil {
+		reqLog.Error("Failed to backfill klines",
+			zap.Time("start_time", *req.StartTime),
+			zap.Time("end_time", end),
+			zap.Error(err),
+		)
+		apiErr := apierrors.NewServiceError("Failed to backfill kline tracking").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "success", serializer.ToKlineBackfillResponse(result))
+}
+
 // GetActiveSignals handles GET /api/v1/signals/active
 func (h *SignalHandler) GetActiveSignals(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
 	ctx := c.Request.Context()
 
 	signals, err := h.signalRepo.GetActiveSignals(ctx)
 	if err != nil {
-		h.logger.Error("Failed to get active signals", zap.Error(err))
-		apiErr := apierrors.NewDatabaseError("Failed to retrieve active signals")
+		reqLog.Error("Failed to get active signals", zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve active signals").WithCause(err)
 		utils.ErrorResponse(c, apiErr)
 		return
 	}
@@ -3,13 +3,16 @@ package handler
 import (
 	"context"
 	"net/http"
+	"sort"
 	"time"
 
 	"ContractAnalysis/internal/domain/entity"
 	"ContractAnalysis/internal/domain/repository"
 	"ContractAnalysis/internal/infrastructure/logger"
 	"ContractAnalysis/internal/presentation/api/dto"
+	"ContractAnalysis/internal/presentation/api/middleware"
 	"ContractAnalysis/internal/presentation/api/serializer"
+	"ContractAnalysis/internal/usecase"
 	apierrors "ContractAnalysis/pkg/errors"
 	"ContractAnalysis/pkg/utils"
 
@@ -18,31 +21,43 @@ import (
 	"go.uber.org/zap"
 )
 
+// Close time ranges of the outcome timeseries, bounding how many outcomes a request aggregates
+const (
+	defaultTimeseriesRange = 90 * 24 * time.Hour  // Used when start_time is omitted
+	maxTimeseriesRange     = 730 * 24 * time.Hour // Longest range a single request may cover
+)
+
 // StatisticsHandler handles statistics-related requests
 type StatisticsHandler struct {
 	statisticsRepo repository.StatisticsRepository
+	alertRepo      repository.StatisticsAlertRepository
 	signalRepo     repository.SignalRepository
+	calculator     *usecase.StatisticsCalculator
 	logger         *logger.Logger
 }
 
 // NewStatisticsHandler creates a new statistics handler
-func NewStatisticsHandler(statsRepo repository.StatisticsRepository, signalRepo repository.SignalRepository, log *logger.Logger) *StatisticsHandler {
+func NewStatisticsHandler(statsRepo repository.StatisticsRepository, alertRepo repository.StatisticsAlertRepository, signalRepo repository.SignalRepository, calculator *usecase.StatisticsCalculator, log *logger.Logger) *StatisticsHandler {
 	return &StatisticsHandler{
 		statisticsRepo: statsRepo,
+		alertRepo:      alertRepo,
 		signalRepo:     signalRepo,
+		calculator:     calculator,
 		logger:         log,
 	}
 }
 
 // GetOverview handles GET /api/v1/statistics/overview
 func (h *StatisticsHandler) GetOverview(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
 	ctx := c.Request.Context()
 
 	// Calculate overview statistics
-	overview, err := h.calculateOverviewStatistics(ctx)
+	overview, err := h.calculateOverviewStatistics(ctx, reqLog)
 	if err != nil {
-		h.logger.Error("Failed to calculate overview statistics", zap.Error(err))
-		apiErr := apierrors.NewDatabaseError("Failed to retrieve overview statistics")
+		reqLog.Error("Failed to calculate overview statistics", zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve overview statistics").WithCause(err)
 		utils.ErrorResponse(c, apiErr)
 		return
 	}
@@ -50,8 +65,48 @@ func (h *StatisticsHandler) GetOverview(c *gin.Context) {
 	utils.SuccessResponse(c, http.StatusOK, "success", overview)
 }
 
+// GetSummary handles GET /api/v1/statistics/summary
+// Returns the overview metrics together with the full strategy breakdown and the best and
+// worst symbols for any period (default 24h), so the dashboard needs a single call
+func (h *StatisticsHandler) GetSummary(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	var req dto.StatisticsSummaryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apiErr := apierrors.NewValidationError("Invalid query parameters", err.Error())
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	if !validPeriod(c, req.Period) {
+		return
+	}
+
+	period := req.Period
+	if period == "" {
+		period = "24h"
+	}
+
+	symbolLimit := req.SymbolLimit
+	if symbolLimit == 0 {
+		symbolLimit = 5
+	}
+
+	summary, err := h.calculateSummaryStatistics(c.Request.Context(), period, symbolLimit)
+	if err != nil {
+		reqLog.Error("Failed to calculate summary statistics", zap.String("period", period), zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve summary statistics").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "success", summary)
+}
+
 // GetStrategies handles GET /api/v1/statistics/strategies
 func (h *StatisticsHandler) GetStrategies(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
 	var req dto.StatisticsRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
 		apiErr := apierrors.NewValidationError("Invalid query parameters", err.Error())
@@ -59,6 +114,10 @@ func (h *StatisticsHandler) GetStrategies(c *gin.Context) {
 		return
 	}
 
+	if !validPeriod(c, req.Period) {
+		return
+	}
+
 	ctx := c.Request.Context()
 
 	// Default period to "all" if not specified
@@ -76,8 +135,8 @@ func (h *StatisticsHandler) GetStrategies(c *gin.Context) {
 	// Get statistics for the period, with optional strategy filter
 	stats, err := h.statisticsRepo.GetByPeriodAndStrategy(ctx, period, strategyFilter)
 	if err != nil {
-		h.logger.Error("Failed to get strategy statistics", zap.String("period", period), zap.Error(err), zap.Stringp("strategy", strategyFilter))
-		apiErr := apierrors.NewDatabaseError("Failed to retrieve statistics")
+		reqLog.Error("Failed to get strategy statistics", zap.String("period", period), zap.Error(err), zap.Stringp("strategy", strategyFilter))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve statistics").WithCause(err)
 		utils.ErrorResponse(c, apiErr)
 		return
 	}
@@ -89,6 +148,8 @@ func (h *StatisticsHandler) GetStrategies(c *gin.Context) {
 
 // GetSymbols handles GET /api/v1/statistics/symbols
 func (h *StatisticsHandler) GetSymbols(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
 	var req dto.StatisticsRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
 		apiErr := apierrors.NewValidationError("Invalid query parameters", err.Error())
@@ -96,6 +157,10 @@ func (h *StatisticsHandler) GetSymbols(c *gin.Context) {
 		return
 	}
 
+	if !validPeriod(c, req.Period) {
+		return
+	}
+
 	ctx := c.Request.Context()
 
 	// Default period to "all" if not specified
@@ -107,8 +172,8 @@ func (h *StatisticsHandler) GetSymbols(c *gin.Context) {
 	// Get statistics for the period
 	stats, err := h.statisticsRepo.GetByPeriod(ctx, period)
 	if err != nil {
-		h.logger.Error("Failed to get symbol statistics", zap.String("period", period), zap.Error(err))
-		apiErr := apierrors.NewDatabaseError("Failed to retrieve statistics")
+		reqLog.Error("Failed to get symbol statistics", zap.String("period", period), zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve statistics").WithCause(err)
 		utils.ErrorResponse(c, apiErr)
 		return
 	}
@@ -128,6 +193,8 @@ func (h *StatisticsHandler) GetSymbols(c *gin.Context) {
 
 // GetHistory handles GET /api/v1/statistics/history
 func (h *StatisticsHandler) GetHistory(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
 	var req dto.StatisticsHistoryRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
 		apiErr := apierrors.NewValidationError("Invalid request parameters", err.Error())
@@ -157,8 +224,8 @@ func (h *StatisticsHandler) GetHistory(c *gin.Context) {
 	}
 
 	var symbolFilter *string
-	if req.Symbol != "" {
-		symbolFilter = &req.Symbol
+	if symbol := utils.NormalizeSymbol(req.Symbol); symbol != "" {
+		symbolFilter = &symbol
 	}
 
 	// Get historical statistics
@@ -171,11 +238,11 @@ func (h *StatisticsHandler) GetHistory(c *gin.Context) {
 	)
 
 	if err != nil {
-		h.logger.Error("Failed to get historical statistics",
+		reqLog.Error("Failed to get historical statistics",
 			zap.Time("start_time", *req.StartTime),
 			zap.Time("end_time", *req.EndTime),
 			zap.Error(err))
-		apiErr := apierrors.NewDatabaseError("Failed to retrieve historical statistics")
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve historical statistics").WithCause(err)
 		utils.ErrorResponse(c, apiErr)
 		return
 	}
@@ -185,8 +252,119 @@ func (h *StatisticsHandler) GetHistory(c *gin.Context) {
 	utils.SuccessResponse(c, http.StatusOK, "success", responses)
 }
 
+// GetTimeseries handles GET /api/v1/statistics/timeseries
+// Returns closed signal counts, win rate and net return per day or week
+// Without start_time the last 90 days up to end_time (default now) are returned
+func (h *StatisticsHandler) GetTimeseries(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	var req dto.StatisticsTimeseriesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apiErr := apierrors.NewValidationError("Invalid request parameters", err.Error())
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	end := time.Now()
+	if req.EndTime != nil {
+		end = *req.EndTime
+	}
+	start := end.Add(-defaultTimeseriesRange)
+	if req.StartTime != nil {
+		start = *req.StartTime
+	}
+
+	if end.Before(start) {
+		apiErr := apierrors.NewBadRequestError("end_time must be after start_time", "")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+	if end.Sub(start) > maxTimeseriesRange {
+		apiErr := apierrors.NewValidationError("Invalid time range", "the time range must not exceed 730 days")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	period := req.Period
+	if period == "" {
+		period = repository.OutcomeBucketDaily
+	}
+
+	var strategyFilter *string
+	if req.StrategyName != "" {
+		strategyFilter = &req.StrategyName
+	}
+
+	buckets, err := h.signalRepo.GetOutcomeTimeseries(c.Request.Context(), period, strategyFilter, &start, &end)
+	if err != nil {
+		reqLog.Error("Failed to get outcome timeseries",
+			zap.String("strategy", req.StrategyName),
+			zap.String("period", period),
+			zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve statistics timeseries").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "success", serializer.ToStatisticsTimeseriesResponse(req.StrategyName, period, buckets))
+}
+
+// GetAlerts handles GET /api/v1/statistics/alerts
+// Returns the significant statistics changes detected by the statistics monitor, newest first
+func (h *StatisticsHandler) GetAlerts(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	var req dto.StatisticsAlertRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apiErr := apierrors.NewValidationError("Invalid query parameters", err.Error())
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	if !validPeriod(c, req.Period) {
+		return
+	}
+
+	pagination, apiErr := utils.ParsePaginationParams(c)
+	if apiErr != nil {
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	// Optional filters
+	var strategyFilter *string
+	if req.StrategyName != "" {
+		strategyFilter = &req.StrategyName
+	}
+
+	var periodFilter *string
+	if req.Period != "" {
+		periodFilter = &req.Period
+	}
+
+	alerts, total, err := h.alertRepo.GetRecent(c.Request.Context(), strategyFilter, periodFilter, pagination.Offset, pagination.Limit)
+	if err != nil {
+		reqLog.Error("Failed to get statistics alerts",
+			zap.String("strategy", req.StrategyName),
+			zap.String("period", req.Period),
+			zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve statistics alerts").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	response := make([]*dto.StatisticsAlertResponse, 0, len(alerts))
+	for _, alert := range alerts {
+		response = append(response, serializer.ToStatisticsAlertResponse(alert))
+	}
+
+	utils.PaginatedSuccessResponse(c, http.StatusOK, "success", response, pagination.Page, pagination.Limit, total)
+}
+
 // CompareStrategies handles GET /api/v1/statistics/compare
 func (h *StatisticsHandler) CompareStrategies(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
 	var req dto.StrategyCompareRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
 		apiErr := apierrors.NewValidationError("Invalid query parameters", err.Error())
@@ -194,6 +372,10 @@ func (h *StatisticsHandler) CompareStrategies(c *gin.Context) {
 		return
 	}
 
+	if !validPeriod(c, req.Period) {
+		return
+	}
+
 	ctx := c.Request.Context()
 
 	// Initialize comparison metrics
@@ -214,7 +396,7 @@ func (h *StatisticsHandler) CompareStrategies(c *gin.Context) {
 		// Get statistics for this strategy
 		stats, err := h.statisticsRepo.GetByPeriodAndStrategy(ctx, req.Period, &strategyName)
 		if err != nil {
-			h.logger.Error("Failed to get strategy statistics",
+			reqLog.Error("Failed to get strategy statistics",
 				zap.String("strategy", strategyName),
 				zap.Error(err))
 			continue
@@ -230,7 +412,7 @@ func (h *StatisticsHandler) CompareStrategies(c *gin.Context) {
 		}
 
 		if overallStat == nil {
-			h.logger.Warn("No overall statistics found for strategy",
+			reqLog.Warn("No overall statistics found for strategy",
 				zap.String("strategy", strategyName),
 				zap.String("period", req.Period))
 			continue
@@ -294,8 +476,52 @@ func (h *StatisticsHandler) CompareStrategies(c *gin.Context) {
 	utils.SuccessResponse(c, http.StatusOK, "success", response)
 }
 
+// RecalculateStatistics handles POST /api/v1/statistics/recalculate
+// Synchronously recomputes and saves statistics for one strategy, period and optional symbol
+func (h *StatisticsHandler) RecalculateStatistics(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	var req dto.StatisticsRecalculateRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apiErr := apierrors.NewValidationError("Invalid query parameters", err.Error())
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	if !validPeriod(c, req.Period) {
+		return
+	}
+
+	var symbolFilter *string
+	if symbol := utils.NormalizeSymbol(req.Symbol); symbol != "" {
+		symbolFilter = &symbol
+	}
+
+	stats, err := h.calculator.Recalculate(c.Request.Context(), req.StrategyName, symbolFilter, req.Period)
+	if err != nil {
+		reqLog.Error("Failed to recalculate statistics",
+			zap.String("strategy", req.StrategyName),
+			zap.Stringp("symbol", symbolFilter),
+			zap.String("period", req.Period),
+			zap.Error(err),
+		)
+		apiErr := apierrors.NewDatabaseError("Failed to recalculate statistics").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	if stats == nil {
+		apiErr := apierrors.NewNotFoundError("No signals found for the requested strategy and period")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "success", serializer.ToStatisticsResponse(stats))
+}
+
 // calculateOverviewStatistics calculates overview statistics for dashboard
-func (h *StatisticsHandler) calculateOverviewStatistics(ctx context.Context) (*dto.OverviewStatisticsResponse, error) {
+// Metrics come from the 24h statistics, falling back to "all" before the first 24h run
+func (h *StatisticsHandler) calculateOverviewStatistics(ctx context.Context, log *logger.Logger) (*dto.OverviewStatisticsResponse, error) {
 	now := time.Now()
 	todayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
 
@@ -311,43 +537,28 @@ func (h *StatisticsHandler) calculateOverviewStatistics(ctx context.Context) (*d
 		return nil, err
 	}
 
-	// Calculate status distribution from all signals today
-	statusDistribution := &dto.SignalStatusDistribution{}
-	for _, signal := range todaySignals {
-		switch signal.Status {
-		case entity.SignalStatusPending:
-			statusDistribution.Pending++
-		case entity.SignalStatusConfirmed:
-			statusDistribution.Confirmed++
-		case entity.SignalStatusTracking:
-			statusDistribution.Tracking++
-		case entity.SignalStatusClosed:
-			statusDistribution.Closed++
-		case entity.SignalStatusInvalidated:
-			statusDistribution.Invalidated++
-		}
-	}
-
 	// Get 24h statistics from the statistics table
 	stats24h, err := h.statisticsRepo.GetByPeriod(ctx, "24h")
 	if err != nil {
-		h.logger.Error("Failed to get 24h statistics", zap.Error(err))
+		log.Error("Failed to get 24h statistics", zap.Error(err))
 		return nil, err
 	}
 
-	h.logger.Info("Retrieved 24h statistics", zap.Int("count", len(stats24h)))
+	log.Info("Retrieved 24h statistics", zap.Int("count", len(stats24h)))
 
 	// If no 24h data, try to get "all" period as fallback
 	if len(stats24h) == 0 {
-		h.logger.Warn("No 24h statistics found, trying 'all' period as fallback")
+		log.Warn("No 24h statistics found, trying 'all' period as fallback")
 		stats24h, err = h.statisticsRepo.GetByPeriod(ctx, "all")
 		if err != nil {
-			h.logger.Error("Failed to get 'all' statistics", zap.Error(err))
+			log.Error("Failed to get 'all' statistics", zap.Error(err))
 			return nil, err
 		}
-		h.logger.Info("Retrieved 'all' statistics as fallback", zap.Int("count", len(stats24h)))
+		log.Info("Retrieved 'all' statistics as fallback", zap.Int("count", len(stats24h)))
 	}
 
+	aggregate := aggregatePeriodStatistics(stats24h)
+
 	// Initialize response with defaults
 	zeroStr := "0"
 	response := &dto.OverviewStatisticsResponse{
@@ -355,187 +566,264 @@ func (h *StatisticsHandler) calculateOverviewStatistics(ctx context.Context) (*d
 		ActiveSignals:       len(activeSignals),
 		OverallWinRate24h:   &zeroStr,
 		AvgReturnPct24h:     &zeroStr,
-		StrategyBreakdown:   []dto.StrategyPerformance24h{},
+		StrategyBreakdown:   aggregate.strategyBreakdown,
 		TopPerformingPair:   "-",
 		WorstPerformingPair: "-",
-		StatusDistribution:  statusDistribution,
+		StatusDistribution:  signalStatusDistribution(todaySignals),
 	}
 
-	// Calculate overall 24h metrics from statistics
-	if len(stats24h) > 0 {
-		// Strategy aggregation structure
-		type StrategyAggregation struct {
-			TotalSignals      int
-			ProfitableSignals int
-			LosingSignals     int
-			TotalReturn       decimal.Decimal
-		}
+	if aggregate.overallWinRate != nil {
+		response.OverallWinRate24h = aggregate.overallWinRate
+		response.AvgReturnPct24h = aggregate.avgReturnPct
+	}
 
-		strategyMap := make(map[string]*StrategyAggregation)
-		pairReturns := make(map[string]decimal.Decimal)
-		pairCounts := make(map[string]int)
+	if len(aggregate.symbols) > 0 {
+		response.TopPerformingPair = aggregate.symbols[0].Symbol
+		response.WorstPerformingPair = aggregate.symbols[len(aggregate.symbols)-1].Symbol
+	}
 
-		h.logger.Info("Processing statistics for strategy breakdown", zap.Int("stat_count", len(stats24h)))
+	log.Info("Overview statistics calculated",
+		zap.Int("today_signals", response.TotalSignalsToday),
+		zap.Int("active_signals", response.ActiveSignals),
+		zap.Int("strategies", len(response.StrategyBreakdown)),
+		zap.String("top_pair", response.TopPerformingPair),
+		zap.String("worst_pair", response.WorstPerformingPair))
 
-		// First pass: Aggregate by strategy (only process strategy-level stats where Symbol is nil)
-		for _, stat := range stats24h {
-			signalCount := stat.ProfitableSignals + stat.LosingSignals
+	return response, nil
+}
 
-			if signalCount == 0 {
-				continue
-			}
+// calculateSummaryStatistics calculates the overview metrics, full strategy-level statistics
+// and symbol ranking for a period label (e.g. "24h", "7d", "all")
+// Periods without calculated statistics yield zero metrics and empty breakdowns
+func (h *StatisticsHandler) calculateSummaryStatistics(ctx context.Context, period string, symbolLimit int) (*dto.StatisticsSummaryResponse, error) {
+	duration, err := utils.ParsePeriodLabel(period)
+	if err != nil {
+		return nil, err
+	}
 
-			// Process strategy-level statistics (Symbol == nil)
-			if stat.Symbol == nil {
-				strategyName := stat.StrategyName
+	now := time.Now()
+	periodStart := time.Time{}
+	if duration > 0 {
+		periodStart = now.Add(-duration)
+	}
 
-				if _, exists := strategyMap[strategyName]; !exists {
-					strategyMap[strategyName] = &StrategyAggregation{
-						TotalReturn: decimal.Zero,
-					}
-				}
+	periodSignals, err := h.signalRepo.GetSignalsInTimeRange(ctx, periodStart, now)
+	if err != nil {
+		return nil, err
+	}
 
-				agg := strategyMap[strategyName]
-				agg.TotalSignals += signalCount
-				agg.ProfitableSignals += stat.ProfitableSignals
-				agg.LosingSignals += stat.LosingSignals
+	activeSignals, err := h.signalRepo.GetActiveSignals(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-				// Calculate weighted return for this strategy
-				if stat.AvgProfitPct != nil && stat.AvgLossPct != nil {
-					profitWeight := decimal.NewFromInt(int64(stat.ProfitableSignals))
-					lossWeight := decimal.NewFromInt(int64(stat.LosingSignals))
+	stats, err := h.statisticsRepo.GetByPeriod(ctx, period)
+	if err != nil {
+		return nil, err
+	}
 
-					profitContribution := stat.AvgProfitPct.Mul(profitWeight)
-					lossContribution := stat.AvgLossPct.Mul(lossWeight).Neg()
+	aggregate := aggregatePeriodStatistics(stats)
 
-					agg.TotalReturn = agg.TotalReturn.Add(profitContribution).Add(lossContribution)
-				}
-			}
+	strategyStats := make([]*repository.StrategyStatistics, 0)
+	for _, stat := range stats {
+		if stat.Symbol == nil {
+			strategyStats = append(strategyStats, stat)
+		}
+	}
+
+	zeroStr := "0"
+	response := &dto.StatisticsSummaryResponse{
+		Period:             period,
+		TotalSignals:       len(periodSignals),
+		ActiveSignals:      len(activeSignals),
+		OverallWinRate:     &zeroStr,
+		AvgReturnPct:       &zeroStr,
+		StatusDistribution: signalStatusDistribution(periodSignals),
+		StrategyBreakdown:  aggregate.strategyBreakdown,
+		Strategies:         serializer.ToStatisticsListResponse(strategyStats),
+		TopSymbols:         []dto.SymbolPerformance{},
+		WorstSymbols:       []dto.SymbolPerformance{},
+	}
+
+	if aggregate.overallWinRate != nil {
+		response.OverallWinRate = aggregate.overallWinRate
+		response.AvgReturnPct = aggregate.avgReturnPct
+	}
 
-			// Track per-symbol performance (for top/worst pairs)
-			if stat.Symbol != nil {
-				symbol := *stat.Symbol
+	// Best and worst symbols, each best/worst first
+	count := min(symbolLimit, len(aggregate.symbols))
+	response.TopSymbols = append(response.TopSymbols, aggregate.symbols[:count]...)
+	for i := len(aggregate.symbols) - 1; i >= len(aggregate.symbols)-count; i-- {
+		response.WorstSymbols = append(response.WorstSymbols, aggregate.symbols[i])
+	}
 
-				if stat.AvgProfitPct != nil && stat.AvgLossPct != nil {
-					profitWeight := decimal.NewFromInt(int64(stat.ProfitableSignals))
-					lossWeight := decimal.NewFromInt(int64(stat.LosingSignals))
+	return response, nil
+}
 
-					profitContribution := stat.AvgProfitPct.Mul(profitWeight)
-					lossContribution := stat.AvgLossPct.Mul(lossWeight).Neg()
+// periodAggregate represents the strategy breakdown and symbol ranking derived from
+// one period's statistics rows
+type periodAggregate struct {
+	strategyBreakdown []dto.StrategyPerformance24h // Sorted by strategy name
+	overallWinRate    *string                      // Nil when no signal closed with a result
+	avgReturnPct      *string                      // Nil when no signal closed with a result
+	symbols           []dto.SymbolPerformance      // Best average return first
+}
 
-					symbolReturn := profitContribution.Add(lossContribution)
-					pairReturns[symbol] = pairReturns[symbol].Add(symbolReturn)
-					pairCounts[symbol] += signalCount
-				}
-			}
+// aggregatePeriodStatistics combines the strategy-level rows into a per-strategy breakdown
+// and overall metrics, and ranks symbols by average return from the symbol-level rows
+// Returns are weighted by the number of profitable and losing signals behind each row
+func aggregatePeriodStatistics(stats []*repository.StrategyStatistics) *periodAggregate {
+	type strategyAggregation struct {
+		totalSignals      int
+		profitableSignals int
+		losingSignals     int
+		totalReturn       decimal.Decimal
+	}
+
+	strategyMap := make(map[string]*strategyAggregation)
+	pairReturns := make(map[string]decimal.Decimal)
+	pairCounts := make(map[string]int)
+
+	for _, stat := range stats {
+		signalCount := stat.ProfitableSignals + stat.LosingSignals
+		if signalCount == 0 {
+			continue
 		}
 
-		// Second pass: Build strategy breakdown and calculate global metrics
-		strategyBreakdown := make([]dto.StrategyPerformance24h, 0, len(strategyMap))
-		var globalTotalSignals, globalProfitable int
-		var globalTotalReturn decimal.Decimal
-
-		for strategyName, agg := range strategyMap {
-			perf := dto.StrategyPerformance24h{
-				StrategyName:    strategyName,
-				SignalCount:     agg.TotalSignals,
-				ProfitableCount: agg.ProfitableSignals,
-				LosingCount:     agg.LosingSignals,
+		// Net return contributed by the row, if it has profit/loss averages
+		var rowReturn *decimal.Decimal
+		if stat.AvgProfitPct != nil && stat.AvgLossPct != nil {
+			profitContribution := stat.AvgProfitPct.Mul(decimal.NewFromInt(int64(stat.ProfitableSignals)))
+			lossContribution := stat.AvgLossPct.Mul(decimal.NewFromInt(int64(stat.LosingSignals))).Neg()
+			r := profitContribution.Add(lossContribution)
+			rowReturn = &r
+		}
+
+		// Strategy-level rows feed the breakdown, symbol-level rows the pair ranking
+		if stat.Symbol == nil {
+			agg, exists := strategyMap[stat.StrategyName]
+			if !exists {
+				agg = &strategyAggregation{}
+				strategyMap[stat.StrategyName] = agg
 			}
 
-			// Calculate win rate for this strategy
-			if agg.TotalSignals > 0 {
-				winRate := decimal.NewFromInt(int64(agg.ProfitableSignals)).
-					Div(decimal.NewFromInt(int64(agg.TotalSignals))).
-					Mul(decimal.NewFromInt(100))
-				winRateStr := winRate.StringFixed(2)
-				perf.WinRate = &winRateStr
-
-				// Calculate average return for this strategy
-				avgReturn := agg.TotalReturn.Div(decimal.NewFromInt(int64(agg.TotalSignals)))
-				avgReturnStr := avgReturn.StringFixed(2)
-				perf.AvgReturnPct = &avgReturnStr
+			agg.totalSignals += signalCount
+			agg.profitableSignals += stat.ProfitableSignals
+			agg.losingSignals += stat.LosingSignals
+			if rowReturn != nil {
+				agg.totalReturn = agg.totalReturn.Add(*rowReturn)
 			}
+			continue
+		}
 
-			strategyBreakdown = append(strategyBreakdown, perf)
+		if rowReturn != nil {
+			pairReturns[*stat.Symbol] = pairReturns[*stat.Symbol].Add(*rowReturn)
+			pairCounts[*stat.Symbol] += signalCount
+		}
+	}
 
-			// Accumulate to global metrics
-			globalTotalSignals += agg.TotalSignals
-			globalProfitable += agg.ProfitableSignals
-			globalTotalReturn = globalTotalReturn.Add(agg.TotalReturn)
+	result := &periodAggregate{
+		strategyBreakdown: make([]dto.StrategyPerformance24h, 0, len(strategyMap)),
+		symbols:           make([]dto.SymbolPerformance, 0, len(pairReturns)),
+	}
 
-			h.logger.Info("Strategy breakdown calculated",
-				zap.String("strategy", strategyName),
-				zap.Int("signals", agg.TotalSignals),
-				zap.Int("profitable", agg.ProfitableSignals))
+	var globalTotalSignals, globalProfitable int
+	var globalTotalReturn decimal.Decimal
+
+	for strategyName, agg := range strategyMap {
+		perf := dto.StrategyPerformance24h{
+			StrategyName:    strategyName,
+			SignalCount:     agg.totalSignals,
+			ProfitableCount: agg.profitableSignals,
+			LosingCount:     agg.losingSignals,
 		}
 
-		response.StrategyBreakdown = strategyBreakdown
-
-		// Calculate global win rate (from strategy aggregates)
-		if globalTotalSignals > 0 {
-			winRate := decimal.NewFromInt(int64(globalProfitable)).
-				Div(decimal.NewFromInt(int64(globalTotalSignals))).
-				Mul(decimal.NewFromInt(100))
-			winRateStr := winRate.StringFixed(2)
-			response.OverallWinRate24h = &winRateStr
-
-			// Calculate global average return
-			avgReturn := globalTotalReturn.Div(decimal.NewFromInt(int64(globalTotalSignals)))
-			avgReturnStr := avgReturn.StringFixed(2)
-			response.AvgReturnPct24h = &avgReturnStr
-
-			h.logger.Info("Global metrics calculated",
-				zap.Int("total_signals", globalTotalSignals),
-				zap.Int("profitable", globalProfitable),
-				zap.String("win_rate", winRateStr),
-				zap.String("avg_return", avgReturnStr))
-		} else {
-			h.logger.Warn("No signals to calculate global metrics")
+		winRate := decimal.NewFromInt(int64(agg.profitableSignals)).
+			Div(decimal.NewFromInt(int64(agg.totalSignals))).
+			Mul(decimal.NewFromInt(100))
+		winRateStr := winRate.StringFixed(2)
+		perf.WinRate = &winRateStr
+
+		avgReturn := agg.totalReturn.Div(decimal.NewFromInt(int64(agg.totalSignals)))
+		avgReturnStr := avgReturn.StringFixed(2)
+		perf.AvgReturnPct = &avgReturnStr
+
+		result.strategyBreakdown = append(result.strategyBreakdown, perf)
+
+		globalTotalSignals += agg.totalSignals
+		globalProfitable += agg.profitableSignals
+		globalTotalReturn = globalTotalReturn.Add(agg.totalReturn)
+	}
+
+	sort.Slice(result.strategyBreakdown, func(i, j int) bool {
+		return result.strategyBreakdown[i].StrategyName < result.strategyBreakdown[j].StrategyName
+	})
+
+	if globalTotalSignals > 0 {
+		winRate := decimal.NewFromInt(int64(globalProfitable)).
+			Div(decimal.NewFromInt(int64(globalTotalSignals))).
+			Mul(decimal.NewFromInt(100))
+		winRateStr := winRate.StringFixed(2)
+		result.overallWinRate = &winRateStr
+
+		avgReturn := globalTotalReturn.Div(decimal.NewFromInt(int64(globalTotalSignals)))
+		avgReturnStr := avgReturn.StringFixed(2)
+		result.avgReturnPct = &avgReturnStr
+	}
+
+	avgReturns := make(map[string]decimal.Decimal, len(pairReturns))
+	for symbol, totalReturn := range pairReturns {
+		avgReturn := totalReturn.Div(decimal.NewFromInt(int64(pairCounts[symbol])))
+		avgReturns[symbol] = avgReturn
+		result.symbols = append(result.symbols, dto.SymbolPerformance{
+			Symbol:       symbol,
+			SignalCount:  pairCounts[symbol],
+			AvgReturnPct: avgReturn.StringFixed(2),
+		})
+	}
+
+	// Ties are broken by symbol so the ranking is stable across requests
+	sort.Slice(result.symbols, func(i, j int) bool {
+		ri, rj := avgReturns[result.symbols[i].Symbol], avgReturns[result.symbols[j].Symbol]
+		if !ri.Equal(rj) {
+			return ri.GreaterThan(rj)
 		}
+		return result.symbols[i].Symbol < result.symbols[j].Symbol
+	})
 
-		// Find top and worst performing pairs
-		if len(pairReturns) > 0 {
-			var topPair, worstPair string
-			var topReturn, worstReturn decimal.Decimal
-			first := true
-
-			for symbol, totalRet := range pairReturns {
-				avgRet := totalRet.Div(decimal.NewFromInt(int64(pairCounts[symbol])))
-
-				if first {
-					topPair = symbol
-					worstPair = symbol
-					topReturn = avgRet
-					worstReturn = avgRet
-					first = false
-				} else {
-					if avgRet.GreaterThan(topReturn) {
-						topPair = symbol
-						topReturn = avgRet
-					}
-					if avgRet.LessThan(worstReturn) {
-						worstPair = symbol
-						worstReturn = avgRet
-					}
-				}
-			}
+	return result
+}
 
-			response.TopPerformingPair = topPair
-			response.WorstPerformingPair = worstPair
-			h.logger.Info("Calculated top/worst pairs",
-				zap.String("top", topPair),
-				zap.String("worst", worstPair))
+// signalStatusDistribution counts signals by status
+func signalStatusDistribution(signals []*entity.Signal) *dto.SignalStatusDistribution {
+	distribution := &dto.SignalStatusDistribution{}
+	for _, signal := range signals {
+		switch signal.Status {
+		case entity.SignalStatusPending:
+			distribution.Pending++
+		case entity.SignalStatusConfirmed:
+			distribution.Confirmed++
+		case entity.SignalStatusTracking:
+			distribution.Tracking++
+		case entity.SignalStatusClosed:
+			distribution.Closed++
+		case entity.SignalStatusInvalidated:
+			distribution.Invalidated++
 		}
-	} else {
-		h.logger.Warn("No statistics data available for overview calculation")
 	}
+	return distribution
+}
 
-	h.logger.Info("Overview statistics calculated",
-		zap.Int("today_signals", response.TotalSignalsToday),
-		zap.Int("active_signals", response.ActiveSignals),
-		zap.Bool("has_win_rate", response.OverallWinRate24h != nil),
-		zap.Bool("has_avg_return", response.AvgReturnPct24h != nil))
-
-	return response, nil
+// validPeriod reports whether period is empty or a valid statistics period label
+// (e.g. "24h", "7d", "all"), responding with a validation error otherwise
+func validPeriod(c *gin.Context, period string) bool {
+	if period == "" {
+		return true
+	}
+	if _, err := utils.ParsePeriodLabel(period); err != nil {
+		apiErr := apierrors.NewValidationError("Invalid period", err.Error())
+		utils.ErrorResponse(c, apiErr)
+		return false
+	}
+	return true
 }
@@ -0,0 +1,305 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"ContractAnalysis/internal/domain/entity"
+	"ContractAnalysis/internal/domain/repository"
+	"ContractAnalysis/internal/infrastructure/logger"
+	"ContractAnalysis/internal/presentation/api/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+// statsRow builds a statistics row; a nil symbol makes it strategy-level
+func statsRow(strategyName string, symbol *string, profitable, losing int, avgProfit, avgLoss *float64) *repository.StrategyStatistics {
+	stat := &repository.StrategyStatistics{
+		StrategyName:      strategyName,
+		Symbol:            symbol,
+		ProfitableSignals: profitable,
+		LosingSignals:     losing,
+	}
+	if avgProfit != nil {
+		d := decimal.NewFromFloat(*avgProfit)
+		stat.AvgProfitPct = &d
+	}
+	if avgLoss != nil {
+		d := decimal.NewFromFloat(*avgLoss)
+		stat.AvgLossPct = &d
+	}
+	return stat
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestAggregatePeriodStatistics(t *testing.T) {
+	tests := []struct {
+		name          string
+		stats         []*repository.StrategyStatistics
+		wantBreakdown []dto.StrategyPerformance24h
+		wantWinRate   *string
+		wantAvgReturn *string
+		wantSymbols   []dto.SymbolPerformance
+	}{
+		{
+			name:          "no rows",
+			wantBreakdown: []dto.StrategyPerformance24h{},
+			wantSymbols:   []dto.SymbolPerformance{},
+		},
+		{
+			name: "strategy rows",
+			stats: []*repository.StrategyStatistics{
+				statsRow("WhaleStrategy", nil, 1, 1, ptr(4.0), ptr(2.0)),
+				statsRow("MinorityStrategy", nil, 3, 1, ptr(2.0), ptr(1.0)),
+			},
+			wantBreakdown: []dto.StrategyPerformance24h{
+				{StrategyName: "MinorityStrategy", SignalCount: 4, ProfitableCount: 3, LosingCount: 1, WinRate: ptr("75.00"), AvgReturnPct: ptr("1.25")},
+				{StrategyName: "WhaleStrategy", SignalCount: 2, ProfitableCount: 1, LosingCount: 1, WinRate: ptr("50.00"), AvgReturnPct: ptr("1.00")},
+			},
+			wantWinRate:   ptr("66.67"),
+			wantAvgReturn: ptr("1.17"),
+			wantSymbols:   []dto.SymbolPerformance{},
+		},
+		{
+			name: "mixed strategy and symbol rows",
+			stats: []*repository.StrategyStatistics{
+				statsRow("MinorityStrategy", ptr("BTCUSDT"), 2, 0, ptr(3.0), ptr(0.0)),
+				statsRow("MinorityStrategy", nil, 3, 1, ptr(2.0), ptr(1.0)),
+				statsRow("MinorityStrategy", ptr("ETHUSDT"), 0, 2, ptr(0.0), ptr(1.5)),
+				statsRow("WhaleStrategy", ptr("BTCUSDT"), 1, 1, ptr(1.0), ptr(3.0)),
+				statsRow("WhaleStrategy", ptr("SOLUSDT"), 1, 0, ptr(1.0), ptr(0.0)),
+			},
+			wantBreakdown: []dto.StrategyPerformance24h{
+				{StrategyName: "MinorityStrategy", SignalCount: 4, ProfitableCount: 3, LosingCount: 1, WinRate: ptr("75.00"), AvgReturnPct: ptr("1.25")},
+			},
+			wantWinRate:   ptr("75.00"),
+			wantAvgReturn: ptr("1.25"),
+			// BTCUSDT combines both strategies' rows: (6 - 2) / 4; ties rank by symbol
+			wantSymbols: []dto.SymbolPerformance{
+				{Symbol: "BTCUSDT", SignalCount: 4, AvgReturnPct: "1.00"},
+				{Symbol: "SOLUSDT", SignalCount: 1, AvgReturnPct: "1.00"},
+				{Symbol: "ETHUSDT", SignalCount: 2, AvgReturnPct: "-1.50"},
+			},
+		},
+		{
+			name: "rows without results",
+			stats: []*repository.StrategyStatistics{
+				statsRow("MinorityStrategy", nil, 0, 0, ptr(2.0), ptr(1.0)),
+				statsRow("WhaleStrategy", nil, 1, 1, nil, nil),
+				statsRow("WhaleStrategy", ptr("BTCUSDT"), 1, 1, nil, nil),
+			},
+			wantBreakdown: []dto.StrategyPerformance24h{
+				{StrategyName: "WhaleStrategy", SignalCount: 2, ProfitableCount: 1, LosingCount: 1, WinRate: ptr("50.00"), AvgReturnPct: ptr("0.00")},
+			},
+			wantWinRate:   ptr("50.00"),
+			wantAvgReturn: ptr("0.00"),
+			wantSymbols:   []dto.SymbolPerformance{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := aggregatePeriodStatistics(tt.stats)
+
+			if !reflect.DeepEqual(got.strategyBreakdown, tt.wantBreakdown) {
+				t.Errorf("strategyBreakdown = %+v, want %+v", got.strategyBreakdown, tt.wantBreakdown)
+			}
+			if !reflect.DeepEqual(got.overallWinRate, tt.wantWinRate) {
+				t.Errorf("overallWinRate = %v, want %v", deref(got.overallWinRate), deref(tt.wantWinRate))
+			}
+			if !reflect.DeepEqual(got.avgReturnPct, tt.wantAvgReturn) {
+				t.Errorf("avgReturnPct = %v, want %v", deref(got.avgReturnPct), deref(tt.wantAvgReturn))
+			}
+			if !reflect.DeepEqual(got.symbols, tt.wantSymbols) {
+				t.Errorf("symbols = %+v, want %+v", got.symbols, tt.wantSymbols)
+			}
+		})
+	}
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return "<nil>"
+	}
+	return *s
+}
+
+// fakeStatisticsRepo serves statistics rows by period label
+type fakeStatisticsRepo struct {
+	repository.StatisticsRepository
+
+	byPeriod map[string][]*repository.StrategyStatistics
+}
+
+func (r *fakeStatisticsRepo) GetByPeriod(ctx context.Context, periodLabel string) ([]*repository.StrategyStatistics, error) {
+	return r.byPeriod[periodLabel], nil
+}
+
+// fakeSignalRepo serves a fixed set of signals
+type fakeSignalRepo struct {
+	repository.SignalRepository
+
+	signals []*entity.Signal
+}
+
+func (r *fakeSignalRepo) GetSignalsInTimeRange(ctx context.Context, start, end time.Time) ([]*entity.Signal, error) {
+	var signals []*entity.Signal
+	for _, s := range r.signals {
+		if !s.GeneratedAt.Before(start) && !s.GeneratedAt.After(end) {
+			signals = append(signals, s)
+		}
+	}
+	return signals, nil
+}
+
+func (r *fakeSignalRepo) GetActiveSignals(ctx context.Context) ([]*entity.Signal, error) {
+	var signals []*entity.Signal
+	for _, s := range r.signals {
+		if s.Status == entity.SignalStatusPending || s.Status == entity.SignalStatusConfirmed || s.Status == entity.SignalStatusTracking {
+			signals = append(signals, s)
+		}
+	}
+	return signals, nil
+}
+
+func symbols(perf []dto.SymbolPerformance) []string {
+	names := make([]string, len(perf))
+	for i, p := range perf {
+		names[i] = p.Symbol
+	}
+	return names
+}
+
+func TestCalculateSummaryStatistics(t *testing.T) {
+	now := time.Now()
+	signalRepo := &fakeSignalRepo{signals: []*entity.Signal{
+		{SignalID: "1", GeneratedAt: now.Add(-2 * 24 * time.Hour), Status: entity.SignalStatusTracking},
+		{SignalID: "2", GeneratedAt: now.Add(-10 * 24 * time.Hour), Status: entity.SignalStatusClosed},
+		{SignalID: "3", GeneratedAt: now.Add(-20 * 24 * time.Hour), Status: entity.SignalStatusClosed},
+	}}
+	statsRepo := &fakeStatisticsRepo{byPeriod: map[string][]*repository.StrategyStatistics{
+		"7d": {
+			statsRow("MinorityStrategy", nil, 2, 1, ptr(2.0), ptr(1.0)),
+			statsRow("MinorityStrategy", ptr("BTCUSDT"), 1, 0, ptr(3.0), ptr(0.0)),
+			statsRow("MinorityStrategy", ptr("ETHUSDT"), 1, 1, ptr(1.0), ptr(1.0)),
+			statsRow("MinorityStrategy", ptr("SOLUSDT"), 0, 1, ptr(0.0), ptr(2.0)),
+		},
+		"30d": {
+			statsRow("MinorityStrategy", nil, 4, 2, ptr(2.0), ptr(1.0)),
+			statsRow("MinorityStrategy", ptr("BTCUSDT"), 1, 1, ptr(3.0), ptr(4.0)),
+			statsRow("MinorityStrategy", ptr("ETHUSDT"), 2, 0, ptr(1.0), ptr(0.0)),
+			statsRow("MinorityStrategy", ptr("SOLUSDT"), 1, 1, ptr(1.0), ptr(2.0)),
+			statsRow("MinorityStrategy", ptr("XRPUSDT"), 1, 0, ptr(5.0), ptr(0.0)),
+		},
+	}}
+	h := NewStatisticsHandler(statsRepo, nil, signalRepo, nil, nil)
+
+	tests := []struct {
+		name        string
+		period      string
+		symbolLimit int
+		wantSignals int
+		wantTop     []string
+		wantWorst   []string
+		wantWinRate string
+	}{
+		{"7d, limit below symbol count", "7d", 2, 1, []string{"BTCUSDT", "ETHUSDT"}, []string{"SOLUSDT", "ETHUSDT"}, "66.67"},
+		{"7d, limit above symbol count", "7d", 5, 1, []string{"BTCUSDT", "ETHUSDT", "SOLUSDT"}, []string{"SOLUSDT", "ETHUSDT", "BTCUSDT"}, "66.67"},
+		{"7d, zero limit", "7d", 0, 1, []string{}, []string{}, "66.67"},
+		{"30d", "30d", 2, 3, []string{"XRPUSDT", "ETHUSDT"}, []string{"SOLUSDT", "BTCUSDT"}, "66.67"},
+		{"period without statistics", "24h", 3, 0, []string{}, []string{}, "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := h.calculateSummaryStatistics(context.Background(), tt.period, tt.symbolLimit)
+			if err != nil {
+				t.Fatalf("calculateSummaryStatistics: %v", err)
+			}
+
+			if resp.TotalSignals != tt.wantSignals {
+				t.Errorf("TotalSignals = %d, want %d", resp.TotalSignals, tt.wantSignals)
+			}
+			if resp.ActiveSignals != 1 {
+				t.Errorf("ActiveSignals = %d, want 1", resp.ActiveSignals)
+			}
+			if got := symbols(resp.TopSymbols); !reflect.DeepEqual(got, tt.wantTop) {
+				t.Errorf("TopSymbols = %v, want %v", got, tt.wantTop)
+			}
+			if got := symbols(resp.WorstSymbols); !reflect.DeepEqual(got, tt.wantWorst) {
+				t.Errorf("WorstSymbols = %v, want %v", got, tt.wantWorst)
+			}
+			if got := deref(resp.OverallWinRate); got != tt.wantWinRate {
+				t.Errorf("OverallWinRate = %s, want %s", got, tt.wantWinRate)
+			}
+		})
+	}
+
+	if _, err := h.calculateSummaryStatistics(context.Background(), "7x", 3); err == nil {
+		t.Error("invalid period: got no error")
+	}
+}
+
+// timeseriesSignalRepo records the close time range the outcome timeseries was queried with
+type timeseriesSignalRepo struct {
+	repository.SignalRepository
+	start, end *time.Time
+}
+
+func (r *timeseriesSignalRepo) GetOutcomeTimeseries(ctx context.Context, bucket string, strategyName *string, start, end *time.Time) ([]*repository.OutcomeBucket, error) {
+	r.start, r.end = start, end
+	return nil, nil
+}
+
+func TestGetTimeseriesBoundsTimeRange(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantRange  time.Duration // Queried range when the request succeeds
+	}{
+		{"no range defaults to 90 days", "", http.StatusOK, defaultTimeseriesRange},
+		{"only end_time", "?end_time=2024-06-01T00:00:00Z", http.StatusOK, defaultTimeseriesRange},
+		{"explicit range", "?start_time=2024-01-01T00:00:00Z&end_time=2024-03-01T00:00:00Z", http.StatusOK, 60 * 24 * time.Hour},
+		{"range too long", "?start_time=2020-01-01T00:00:00Z&end_time=2024-03-01T00:00:00Z", http.StatusUnprocessableEntity, 0},
+		{"reversed range", "?start_time=2024-03-01T00:00:00Z&end_time=2024-01-01T00:00:00Z", http.StatusBadRequest, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &timeseriesSignalRepo{}
+			h := NewStatisticsHandler(nil, nil, repo, nil, logger.GetGlobal())
+			router := gin.New()
+			router.GET("/timeseries", h.GetTimeseries)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/timeseries"+tt.query, nil))
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.wantStatus, w.Body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				if repo.start != nil {
+					t.Error("rejected request queried the repository")
+				}
+				return
+			}
+			if repo.start == nil || repo.end == nil {
+				t.Fatal("queried without a close time range")
+			}
+			if got := repo.end.Sub(*repo.start); got != tt.wantRange {
+				t.Errorf("queried range = %s, want %s", got, tt.wantRange)
+			}
+		})
+	}
+}
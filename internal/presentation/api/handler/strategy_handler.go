@@ -1,22 +1,37 @@
 package handler
 
 import (
+	"net/http"
+
+	"ContractAnalysis/config"
+	"ContractAnalysis/internal/domain/repository"
 	"ContractAnalysis/internal/domain/service" // Add this import
+	"ContractAnalysis/internal/infrastructure/logger"
 	"ContractAnalysis/internal/presentation/api/dto"
+	"ContractAnalysis/internal/presentation/api/middleware"
+	"ContractAnalysis/internal/presentation/api/serializer"
+	apierrors "ContractAnalysis/pkg/errors"
 	"ContractAnalysis/pkg/utils"
 
 	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
 )
 
 // StrategyHandler handles strategy related requests
 type StrategyHandler struct {
 	strategies []service.Strategy // Change from config.StrategiesConfig
+	configs    config.StrategiesConfig
+	signalRepo repository.SignalRepository
+	logger     *logger.Logger
 }
 
 // NewStrategyHandler creates a new strategy handler
-func NewStrategyHandler(strategies []service.Strategy) *StrategyHandler { // Change parameter type
+func NewStrategyHandler(strategies []service.Strategy, configs config.StrategiesConfig, signalRepo repository.SignalRepository, log *logger.Logger) *StrategyHandler { // Change parameter type
 	return &StrategyHandler{
 		strategies: strategies, // Assign the slice
+		configs:    configs,
+		signalRepo: signalRepo,
+		logger:     log,
 	}
 }
 
@@ -29,9 +44,105 @@ func (h *StrategyHandler) GetStrategies(c *gin.Context) {
 			Key:         s.Key(),  // Use s.Key()
 			Name:        s.Name(), // Use s.Name()
 			Enabled:     s.IsEnabled(),
-			Description: s.Name(), // Assuming description is the name for now, or might need another field in Strategy interface
+			Description: s.Description(),
+			Parameters:  s.Parameters(),
 		})
 	}
 
 	utils.SuccessResponse(c, 200, "Strategies fetched successfully", strategyResponses)
 }
+
+// GetStrategySchema handles GET /api/v1/strategies/:key/schema
+// Returns the tunable parameters of a strategy with their current values, bounds and descriptions
+func (h *StrategyHandler) GetStrategySchema(c *gin.Context) {
+	key := c.Param("key")
+
+	strategy := h.findStrategy(key)
+	if strategy == nil {
+		apiErr := apierrors.NewNotFoundError("Strategy not found")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	// Strategies are built from their config, so every strategy has one under its name
+	cfg, ok := h.configs.ByName(strategy.Name())
+	if !ok {
+		apiErr := apierrors.NewNotFoundError("Strategy configuration not found")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "success", serializer.ToStrategySchemaResponse(strategy, config.ParameterSchemas(cfg)))
+}
+
+// GetStrategySignals handles GET /api/v1/strategies/:key/signals
+// Returns paginated signals (with outcomes) of an enabled strategy
+func (h *StrategyHandler) GetStrategySignals(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	key := c.Param("key")
+	if !h.isEnabledStrategy(key) {
+		apiErr := apierrors.NewNotFoundError("Strategy not found")
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	var req dto.SignalListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apiErr := apierrors.NewValidationError("Invalid query parameters", err.Error())
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	pagination, apiErr := utils.ParsePaginationParams(c)
+	if apiErr != nil {
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	// The strategy comes from the path, any strategy_name query parameter is ignored
+	filters := repository.SignalFilterParams{
+		Status:       req.Status,
+		Symbol:       utils.NormalizeSymbol(req.Symbol),
+		StrategyName: key,
+		Type:         req.Type,
+		Outcome:      req.Outcome,
+		StartTime:    req.StartTime,
+		EndTime:      req.EndTime,
+	}
+
+	signalsWithOutcomes, total, err := h.signalRepo.GetSignalsWithOutcomes(c.Request.Context(), filters, pagination.Offset, pagination.Limit)
+	if err != nil {
+		reqLog.Error("Failed to get strategy signals", zap.String("strategy", key), zap.Error(err))
+		apiErr := apierrors.NewDatabaseError("Failed to retrieve signals").WithCause(err)
+		utils.ErrorResponse(c, apiErr)
+		return
+	}
+
+	response := make([]*dto.SignalResponse, 0, len(signalsWithOutcomes))
+	for _, swo := range signalsWithOutcomes {
+		response = append(response, serializer.ToSignalResponseWithOutcome(swo.Signal, swo.Outcome))
+	}
+
+	utils.PaginatedSuccessResponse(c, http.StatusOK, "success", response, pagination.Page, pagination.Limit, total)
+}
+
+// isEnabledStrategy checks if key belongs to an enabled strategy
+func (h *StrategyHandler) isEnabledStrategy(key string) bool {
+	for _, s := range h.strategies {
+		if s.Key() == key && s.IsEnabled() {
+			return true
+		}
+	}
+	return false
+}
+
+// findStrategy returns the strategy with the given key, enabled or not, or nil if there is none
+func (h *StrategyHandler) findStrategy(key string) service.Strategy {
+	for _, s := range h.strategies {
+		if s.Key() == key {
+			return s
+		}
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"ContractAnalysis/config"
+	"ContractAnalysis/internal/infrastructure/logger"
+	"ContractAnalysis/internal/infrastructure/notification"
+	"ContractAnalysis/internal/presentation/api/middleware"
+	"ContractAnalysis/internal/presentation/api/serializer"
+	apierrors "ContractAnalysis/pkg/errors"
+	"ContractAnalysis/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+// wsWriteTimeout bounds how long a single websocket write may block
+const wsWriteTimeout = 10 * time.Second
+
+// WebSocketHandler pushes notifications to connected websocket clients
+type WebSocketHandler struct {
+	broker   *notification.Broker
+	config   config.WebSocketConfig
+	upgrader websocket.Upgrader
+	logger   *logger.Logger
+}
+
+// NewWebSocketHandler creates a new websocket handler
+func NewWebSocketHandler(broker *notification.Broker, cfg config.WebSocketConfig, log *logger.Logger) *WebSocketHandler {
+	h := &WebSocketHandler{
+		broker: broker,
+		config: cfg,
+		logger: log,
+	}
+
+	h.upgrader = websocket.Upgrader{
+		ReadBufferSize:  1024,
+		WriteBufferSize: 1024,
+		CheckOrigin:     h.checkOrigin,
+	}
+
+	return h
+}
+
+// Stream handles GET /api/v1/ws
+// Upgrades the connection and streams new signals and outcomes until the client disconnects
+func (h *WebSocketHandler) Stream(c *gin.Context) {
+	reqLog := middleware.RequestLogger(c, h.logger)
+
+	if h.broker == nil || !h.broker.IsEnabled() {
+		utils.ErrorResponse(c, apierrors.NewServiceUnavailableError("WebSocket push is disabled"))
+		return
+	}
+
+	conn, err := h.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// Upgrade already wrote an HTTP error response
+		reqLog.WithError(err).Warn("Failed to upgrade websocket connection")
+		return
+	}
+	defer conn.Close()
+
+	notifications, unsubscribe := h.broker.Subscribe()
+	defer unsubscribe()
+
+	reqLog.Info("WebSocket client connected",
+		zap.String("client_ip", c.ClientIP()),
+		zap.Int("subscribers", h.broker.SubscriberCount()),
+	)
+
+	// The read loop handles pong/close frames and detects disconnects
+	done := make(chan struct{})
+	go h.readLoop(conn, done)
+
+	ticker := time.NewTicker(h.config.PingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			reqLog.Info("WebSocket client disconnected", zap.String("client_ip", c.ClientIP()))
+			return
+
+		case n, ok := <-notifications:
+			if !ok {
+				return
+			}
+
+			_ = conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+			if err := conn.WriteJSON(serializer.ToNotificationMessage(n)); err != nil {
+				reqLog.WithError(err).Warn("Failed to write websocket message")
+				return
+			}
+
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteTimeout)); err != nil {
+				reqLog.WithError(err).Debug("Failed to ping websocket client")
+				return
+			}
+		}
+	}
+}
+
+// readLoop consumes client frames so pong and close control frames are processed
+// Closes done when the connection fails or the pong deadline passes
+func (h *WebSocketHandler) readLoop(conn *websocket.Conn, done chan struct{}) {
+	defer close(done)
+
+	_ = conn.SetReadDeadline(time.Now().Add(h.config.PongTimeout))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(h.config.PongTimeout))
+	})
+
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+// checkOrigin allows the configured origins, or any origin if none are configured
+func (h *WebSocketHandler) checkOrigin(r *http.Request) bool {
+	if len(h.config.AllowedOrigins) == 0 {
+		return true
+	}
+
+	origin := r.Header.Get("Origin")
+	for _, allowed := range h.config.AllowedOrigins {
+		if origin == allowed {
+			return true
+		}
+	}
+	return false
+}
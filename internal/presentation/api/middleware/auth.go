@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"crypto/subtle"
+
+	"ContractAnalysis/internal/infrastructure/logger"
+	apierrors "ContractAnalysis/pkg/errors"
+	"ContractAnalysis/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// APIKeyHeader is the header carrying the client API key
+const APIKeyHeader = "X-API-Key"
+
+// APIKeyAuth returns a middleware that requires a valid X-API-Key header
+// Requests to skipPaths (matched against the route path) are not checked
+func APIKeyAuth(apiKeys []string, log *logger.Logger, skipPaths ...string) gin.HandlerFunc {
+	skip := make(map[string]bool, len(skipPaths))
+	for _, path := range skipPaths {
+		skip[path] = true
+	}
+
+	return func(c *gin.Context) {
+		if skip[c.FullPath()] {
+			c.Next()
+			return
+		}
+
+		key := c.GetHeader(APIKeyHeader)
+		if key == "" || !isValidAPIKey(key, apiKeys) {
+			RequestLogger(c, log).Warn("Unauthorized API request",
+				zap.String("path", c.Request.URL.Path),
+				zap.String("client_ip", c.ClientIP()),
+				zap.Bool("key_provided", key != ""),
+			)
+
+			utils.ErrorResponse(c, apierrors.NewUnauthorizedError("Missing or invalid API key"))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// isValidAPIKey checks the key against the configured keys in constant time
+func isValidAPIKey(key string, apiKeys []string) bool {
+	valid := false
+	for _, candidate := range apiKeys {
+		if subtle.ConstantTimeCompare([]byte(key), []byte(candidate)) == 1 {
+			valid = true
+		}
+	}
+	return valid
+}
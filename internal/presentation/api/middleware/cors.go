@@ -3,6 +3,8 @@ package middleware
 import (
 	"time"
 
+	"ContractAnalysis/pkg/utils"
+
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 )
@@ -31,9 +33,13 @@ func CORS() gin.HandlerFunc {
 			"Accept-Encoding",
 			"Authorization",
 			"X-Requested-With",
+			RequestIDHeader,
+			APIKeyHeader,
+			utils.DecimalFormatHeader,
 		},
 		ExposeHeaders: []string{
 			"Content-Length",
+			RequestIDHeader,
 		},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
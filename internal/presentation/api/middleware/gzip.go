@@ -0,0 +1,168 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Gzip returns a middleware that gzip-compresses responses of at least minSize bytes
+// for clients sending Accept-Encoding: gzip
+// Smaller responses are sent as is, since compressing them costs more than it saves.
+// A response is committed to compression as soon as the handler flushes, so streamed
+// responses keep streaming. WebSocket upgrades are passed through untouched
+func Gzip(minSize int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if isWebSocketUpgrade(c.Request) {
+			c.Next()
+			return
+		}
+
+		c.Writer.Header().Add("Vary", "Accept-Encoding")
+		if !acceptsGzip(c.Request) {
+			c.Next()
+			return
+		}
+
+		w := &gzipWriter{ResponseWriter: c.Writer, minSize: minSize}
+		c.Writer = w
+		defer func() {
+			// On a panic the buffered output is dropped so the recovery middleware
+			// can answer on the original writer
+			if !w.finished {
+				w.discard()
+			}
+			c.Writer = w.ResponseWriter
+		}()
+
+		c.Next()
+
+		w.finish()
+	}
+}
+
+// acceptsGzip reports whether the client accepts gzip-encoded responses
+func acceptsGzip(r *http.Request) bool {
+	for _, encoding := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(encoding), ";")
+		if !strings.EqualFold(strings.TrimSpace(name), "gzip") {
+			continue
+		}
+		// gzip;q=0 explicitly refuses the encoding
+		return strings.ReplaceAll(params, " ", "") != "q=0"
+	}
+	return false
+}
+
+// isWebSocketUpgrade reports whether the request asks to switch to the WebSocket protocol
+func isWebSocketUpgrade(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket")
+}
+
+// gzipWriter buffers the response until it reaches minSize bytes, then switches to
+// gzip; responses that end below minSize are written uncompressed
+type gzipWriter struct {
+	gin.ResponseWriter
+	minSize  int
+	buf      []byte
+	gz       *gzip.Writer
+	decided  bool // Whether the response has been committed to plain or gzip output
+	finished bool
+}
+
+// Write implements io.Writer
+func (w *gzipWriter) Write(data []byte) (int, error) {
+	if w.gz != nil {
+		return w.gz.Write(data)
+	}
+	if w.decided {
+		return w.ResponseWriter.Write(data)
+	}
+
+	w.buf = append(w.buf, data...)
+	if len(w.buf) >= w.minSize {
+		if err := w.startGzip(); err != nil {
+			return 0, err
+		}
+	}
+	return len(data), nil
+}
+
+// WriteString implements io.StringWriter
+func (w *gzipWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// Flush sends the buffered output to the client, committing the response to gzip
+func (w *gzipWriter) Flush() {
+	if !w.decided {
+		if err := w.startGzip(); err != nil {
+			return
+		}
+	}
+	if w.gz != nil {
+		_ = w.gz.Flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+// startGzip commits the response to gzip and compresses the buffered output
+// Responses the handler already encoded itself are written as is
+func (w *gzipWriter) startGzip() error {
+	header := w.Header()
+	if header.Get("Content-Encoding") != "" {
+		return w.startPlain()
+	}
+
+	header.Set("Content-Encoding", "gzip")
+	header.Del("Content-Length")
+
+	w.decided = true
+	w.gz = gzip.NewWriter(w.ResponseWriter)
+
+	buf := w.buf
+	w.buf = nil
+	_, err := w.gz.Write(buf)
+	return err
+}
+
+// startPlain commits the response to uncompressed output and writes the buffered output
+func (w *gzipWriter) startPlain() error {
+	w.decided = true
+
+	buf := w.buf
+	w.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+	_, err := w.ResponseWriter.Write(buf)
+	return err
+}
+
+// finish writes out whatever the handler left buffered and closes the gzip stream
+func (w *gzipWriter) finish() {
+	w.finished = true
+
+	if !w.decided {
+		_ = w.startPlain()
+	}
+	if w.gz != nil {
+		_ = w.gz.Close()
+	}
+}
+
+// discard drops the buffered output, undoing the gzip headers if nothing was sent yet
+// and otherwise closing the gzip stream so the partial response stays decodable
+func (w *gzipWriter) discard() {
+	w.buf = nil
+	if w.gz == nil {
+		return
+	}
+	if !w.ResponseWriter.Written() {
+		w.Header().Del("Content-Encoding")
+		return
+	}
+	_ = w.gz.Close()
+}
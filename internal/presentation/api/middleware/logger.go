@@ -12,6 +12,7 @@ import (
 // Logger returns a logger middleware
 func Logger(log *logger.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		reqLog := RequestLogger(c, log)
 		start := time.Now()
 		path := c.Request.URL.Path
 		query := c.Request.URL.RawQuery
@@ -36,17 +37,17 @@ func Logger(log *logger.Logger) gin.HandlerFunc {
 		if len(c.Errors) > 0 {
 			// Log errors if any
 			for _, e := range c.Errors.Errors() {
-				log.Error("Request error", zap.String("error", e))
+				reqLog.Error("Request error", zap.String("error", e))
 			}
 		}
 
 		// Log based on status code
 		if statusCode >= 500 {
-			log.Error("Server error", fields...)
+			reqLog.Error("Server error", fields...)
 		} else if statusCode >= 400 {
-			log.Warn("Client error", fields...)
+			reqLog.Warn("Client error", fields...)
 		} else {
-			log.Info("Request processed", fields...)
+			reqLog.Info("Request processed", fields...)
 		}
 	}
 }
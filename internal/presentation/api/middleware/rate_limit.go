@@ -0,0 +1,113 @@
+package middleware
+
+import (
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"ContractAnalysis/internal/infrastructure/logger"
+	apierrors "ContractAnalysis/pkg/errors"
+	"ContractAnalysis/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// rateLimitCleanupInterval is how often idle client buckets are dropped
+const rateLimitCleanupInterval = 10 * time.Minute
+
+// tokenBucket tracks the remaining request allowance of a single client
+type tokenBucket struct {
+	tokens   float64
+	lastSeen time.Time
+}
+
+// ipRateLimiter is a per-client-IP token bucket rate limiter
+type ipRateLimiter struct {
+	mu          sync.Mutex
+	buckets     map[string]*tokenBucket
+	ratePerSec  float64
+	burst       float64
+	lastCleanup time.Time
+}
+
+// allow consumes a token for the client and reports whether the request may proceed
+// When denied, it also returns how long until the next token is available
+func (l *ipRateLimiter) allow(clientIP string, now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.cleanup(now)
+
+	bucket, ok := l.buckets[clientIP]
+	if !ok {
+		bucket = &tokenBucket{tokens: l.burst, lastSeen: now}
+		l.buckets[clientIP] = bucket
+	}
+
+	// Refill based on time elapsed since the last request
+	elapsed := now.Sub(bucket.lastSeen).Seconds()
+	bucket.tokens = math.Min(l.burst, bucket.tokens+elapsed*l.ratePerSec)
+	bucket.lastSeen = now
+
+	if bucket.tokens < 1 {
+		wait := time.Duration((1 - bucket.tokens) / l.ratePerSec * float64(time.Second))
+		return false, wait
+	}
+
+	bucket.tokens--
+	return true, 0
+}
+
+// cleanup drops buckets of clients that have been idle long enough to be full again
+func (l *ipRateLimiter) cleanup(now time.Time) {
+	if now.Sub(l.lastCleanup) < rateLimitCleanupInterval {
+		return
+	}
+	l.lastCleanup = now
+
+	for ip, bucket := range l.buckets {
+		if now.Sub(bucket.lastSeen) >= rateLimitCleanupInterval {
+			delete(l.buckets, ip)
+		}
+	}
+}
+
+// RateLimit returns a per-client-IP rate limiting middleware
+// Requests to skipPaths (matched against the route path) are not limited
+func RateLimit(requestsPerMinute, burst int, log *logger.Logger, skipPaths ...string) gin.HandlerFunc {
+	limiter := &ipRateLimiter{
+		buckets:     make(map[string]*tokenBucket),
+		ratePerSec:  float64(requestsPerMinute) / 60,
+		burst:       float64(burst),
+		lastCleanup: time.Now(),
+	}
+
+	skip := make(map[string]bool, len(skipPaths))
+	for _, path := range skipPaths {
+		skip[path] = true
+	}
+
+	return func(c *gin.Context) {
+		if skip[c.FullPath()] {
+			c.Next()
+			return
+		}
+
+		allowed, retryAfter := limiter.allow(c.ClientIP(), time.Now())
+		if !allowed {
+			RequestLogger(c, log).Warn("Rate limit exceeded",
+				zap.String("path", c.Request.URL.Path),
+				zap.String("client_ip", c.ClientIP()),
+			)
+
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			utils.ErrorResponse(c, apierrors.NewTooManyRequestsError("Rate limit exceeded, please retry later"))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
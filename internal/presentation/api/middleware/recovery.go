@@ -1,29 +1,35 @@
 package middleware
 
 import (
+	"runtime/debug"
+
+	"ContractAnalysis/internal/infrastructure/logger"
 	apierrors "ContractAnalysis/pkg/errors"
 	"ContractAnalysis/pkg/utils"
-	"ContractAnalysis/internal/infrastructure/logger"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
 )
 
 // Recovery returns a recovery middleware
+// Panics are logged with their stack and answered with a generic INTERNAL_ERROR response
 func Recovery(log *logger.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		defer func() {
 			if err := recover(); err != nil {
 				// Log the panic
-				log.Error("Panic recovered",
+				RequestLogger(c, log).Error("Panic recovered",
 					zap.Any("error", err),
 					zap.String("path", c.Request.URL.Path),
 					zap.String("method", c.Request.Method),
+					zap.ByteString("stack", debug.Stack()),
 				)
 
-				// Return error response
-				apiErr := apierrors.NewInternalServerError("Internal server error")
-				utils.ErrorResponse(c, apiErr)
+				// Return error response unless the handler already started writing one
+				if !c.Writer.Written() {
+					apiErr := apierrors.NewInternalServerError("Internal server error")
+					utils.ErrorResponse(c, apiErr)
+				}
 
 				c.Abort()
 			}
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"ContractAnalysis/internal/infrastructure/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	// RequestIDHeader is the HTTP header carrying the request ID
+	RequestIDHeader = "X-Request-ID"
+
+	requestIDKey     = "request_id"
+	requestLoggerKey = "request_logger"
+
+	// maxRequestIDLength limits client-supplied request IDs written to logs
+	maxRequestIDLength = 128
+)
+
+// RequestID returns a middleware that assigns a request ID to every request
+// An incoming X-Request-ID header is reused, otherwise a new UUID is generated.
+// The ID is echoed back in the response header and a request-scoped logger is
+// stored in the context for handlers to use
+func RequestID(log *logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if requestID == "" || len(requestID) > maxRequestIDLength {
+			requestID = uuid.New().String()
+		}
+
+		c.Set(requestIDKey, requestID)
+		c.Set(requestLoggerKey, log.WithRequestID(requestID))
+		c.Header(RequestIDHeader, requestID)
+
+		c.Next()
+	}
+}
+
+// GetRequestID returns the request ID assigned to the current request
+func GetRequestID(c *gin.Context) string {
+	return c.GetString(requestIDKey)
+}
+
+// RequestLogger returns the request-scoped logger, or fallback if none is set
+func RequestLogger(c *gin.Context, fallback *logger.Logger) *logger.Logger {
+	if value, exists := c.Get(requestLoggerKey); exists {
+		if log, ok := value.(*logger.Logger); ok {
+			return log
+		}
+	}
+	return fallback
+}
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ContractAnalysis/internal/infrastructure/logger"
+	apierrors "ContractAnalysis/pkg/errors"
+	"ContractAnalysis/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// Timeout returns a middleware that bounds the request context with a deadline, so that
+// repository and Binance calls made with c.Request.Context() give up instead of holding
+// the connection until the server's write timeout drops it mid-response
+// Requests still unanswered when the deadline passes get a 503. WebSocket upgrades are
+// passed through untouched, since the stream outlives any request deadline
+func Timeout(timeout time.Duration, log *logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if isWebSocketUpgrade(c.Request) {
+			c.Next()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+
+		if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return
+		}
+
+		RequestLogger(c, log).Warn("Request deadline exceeded",
+			zap.String("path", c.Request.URL.Path),
+			zap.Duration("timeout", timeout),
+		)
+
+		if !c.Writer.Written() {
+			utils.ErrorResponse(c, apierrors.NewServiceUnavailableError("Request timed out, please retry later"))
+			c.Abort()
+		}
+	}
+}
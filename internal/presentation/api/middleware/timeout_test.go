@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"ContractAnalysis/internal/infrastructure/logger"
+	apierrors "ContractAnalysis/pkg/errors"
+	"ContractAnalysis/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestTimeoutDatabaseErrorIsServiceUnavailable(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(Timeout(10*time.Millisecond, logger.GetGlobal()))
+	router.GET("/slow", func(c *gin.Context) {
+		// A repository call giving up at the deadline
+		<-c.Request.Context().Done()
+		utils.ErrorResponse(c, apierrors.NewDatabaseError("Failed to retrieve signals").WithCause(c.Request.Context().Err()))
+	})
+	router.GET("/unanswered", func(c *gin.Context) {
+		<-c.Request.Context().Done()
+	})
+
+	for _, path := range []string{"/slow", "/unanswered"} {
+		t.Run(path, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+			if w.Code != http.StatusServiceUnavailable {
+				t.Errorf("status = %d, want %d", w.Code, http.StatusServiceUnavailable)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"strings"
+	"time"
+
+	apierrors "ContractAnalysis/pkg/errors"
+	"ContractAnalysis/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DisplayTimezone returns a middleware that lets clients choose the timezone response
+// timestamps are rendered in with ?tz=<IANA name>, or ?tz=local for the configured
+// application timezone. Responses stay in UTC when the parameter is absent
+func DisplayTimezone(appLocation *time.Location) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tz := c.Query(utils.TimezoneQuery)
+		if tz == "" {
+			c.Next()
+			return
+		}
+
+		var loc *time.Location
+		if strings.EqualFold(tz, utils.TimezoneLocal) {
+			loc = appLocation
+		} else {
+			var err error
+			loc, err = time.LoadLocation(tz)
+			if err != nil {
+				utils.ErrorResponse(c, apierrors.NewValidationError("Invalid tz parameter", "tz must be an IANA timezone name (e.g. Asia/Shanghai) or \"local\""))
+				c.Abort()
+				return
+			}
+		}
+
+		utils.SetDisplayLocation(c, loc)
+		c.Next()
+	}
+}
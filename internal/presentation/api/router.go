@@ -4,57 +4,176 @@ import (
 	"ContractAnalysis/internal/infrastructure/logger"
 	"ContractAnalysis/internal/presentation/api/handler"
 	"ContractAnalysis/internal/presentation/api/middleware"
+	apierrors "ContractAnalysis/pkg/errors"
+	"ContractAnalysis/pkg/utils"
 
 	"github.com/gin-gonic/gin"
 )
 
+// healthPath is the health check route, exempt from auth and rate limiting
+const healthPath = "/api/v1/health"
+
 // SetupRouter sets up the HTTP router
-func SetupRouter(deps Dependencies, log *logger.Logger, version string) *gin.Engine {
+func SetupRouter(cfg ServerConfig, deps Dependencies, log *logger.Logger, version string) *gin.Engine {
 	// Set Gin to release mode in production
 	gin.SetMode(gin.ReleaseMode)
 
 	router := gin.New()
 
+	// The client IP keys the rate limits, so X-Forwarded-For is only honoured from known proxies
+	if err := router.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		log.WithError(err).Error("Invalid trusted proxies, ignoring X-Forwarded-For")
+		_ = router.SetTrustedProxies(nil)
+	}
+
 	// Global middleware
+	router.Use(middleware.RequestID(log))
 	router.Use(middleware.Recovery(log))
 	router.Use(middleware.Logger(log))
 	router.Use(middleware.CORS())
+	router.Use(middleware.DisplayTimezone(cfg.Location))
+	// Registered before Gzip so that it sees whether a response was actually sent
+	if cfg.HandlerTimeout > 0 {
+		router.Use(middleware.Timeout(cfg.HandlerTimeout, log))
+	}
+	if cfg.CompressionEnabled {
+		router.Use(middleware.Gzip(cfg.CompressionMinSize))
+	}
+
+	// The health check stays reachable for probes without a key or rate limit
+	if cfg.RateLimitEnabled {
+		router.Use(middleware.RateLimit(cfg.RateLimitRequestsPerMinute, cfg.RateLimitBurst, log, healthPath))
+	}
+	if cfg.AuthEnabled {
+		router.Use(middleware.APIKeyAuth(cfg.APIKeys, log, healthPath))
+	}
 
 	// Initialize handlers
-	healthHandler := handler.NewHealthHandler(version)
-	signalHandler := handler.NewSignalHandler(deps.SignalRepo, log)
-	statisticsHandler := handler.NewStatisticsHandler(deps.StatsRepo, deps.SignalRepo, log)
-	strategyHandler := handler.NewStrategyHandler(deps.Strategies)
+	healthHandler := handler.NewHealthHandler(version, deps.BinanceBreaker)
+	signalHandler := handler.NewSignalHandler(deps.SignalRepo, deps.Tracker, log)
+	statisticsHandler := handler.NewStatisticsHandler(deps.StatsRepo, deps.StatsAlertRepo, deps.SignalRepo, deps.StatsCalculator, log)
+	strategyHandler := handler.NewStrategyHandler(deps.Strategies, deps.StrategiesConfig, deps.SignalRepo, log)
+	analysisHandler := handler.NewAnalysisHandler(deps.Analyzer, deps.Collector, deps.SymbolValidator, log)
+	paperHandler := handler.NewPaperHandler(deps.PaperEquityRepo, log)
+	pairHandler := handler.NewPairHandler(deps.TradingPairRepo, deps.Collector, deps.Analyzer, log)
+	wsHandler := handler.NewWebSocketHandler(deps.Broker, deps.WebSocketConfig, log)
+	adminHandler := handler.NewAdminHandler(deps.Analyzer, deps.StatsCalculator, log)
+	marketDataHandler := handler.NewMarketDataHandler(deps.BinanceClient, deps.MarketDataRepo, deps.SymbolValidator, log)
 
 	// API v1 routes
 	v1 := router.Group("/api/v1")
 	{
 		// Health check
 		v1.GET("/health", healthHandler.Check)
+		v1.GET("/version", healthHandler.Version)
 
 		// Strategies meta
 		v1.GET("/strategies", strategyHandler.GetStrategies)
+		v1.GET("/strategies/:key/signals", strategyHandler.GetStrategySignals)
+		v1.GET("/strategies/:key/schema", strategyHandler.GetStrategySchema)
+
+		// Paper trading routes
+		paper := v1.Group("/paper")
+		{
+			paper.GET("/equity", paperHandler.GetEquity)
+		}
+
+		// Trading pair routes
+		pairs := v1.Group("/pairs")
+		{
+			pairs.GET("", pairHandler.GetPairs)
+			pairs.PUT("/:symbol", pairHandler.UpdatePair)
+			pairs.POST("/:symbol/activate", pairHandler.ActivatePair)
+		}
+
+		// Data collection health
+		v1.GET("/collection/status", pairHandler.GetCollectionStatus)
+
+		// Real-time push of new signals and outcomes
+		v1.GET("/ws", wsHandler.Stream)
+
+		// Analysis routes
+		v1.GET("/analyze/:symbol", analysisHandler.AnalyzeSymbol)
+		v1.POST("/analyze/:symbol",
+			middleware.RateLimit(cfg.RefreshRateLimitPerMinute, cfg.RefreshRateLimitBurst, log),
+			analysisHandler.RunSymbolAnalysis,
+		)
+
+		// Market data fetched straight from Binance, bypassing storage
+		v1.GET("/market-data/:symbol/live",
+			middleware.RateLimit(cfg.RefreshRateLimitPerMinute, cfg.RefreshRateLimitBurst, log),
+			marketDataHandler.GetLiveMarketData,
+		)
+
+		// Cross-strategy agreement on the latest data of a symbol
+		v1.GET("/consensus", analysisHandler.GetConsensus)
 
 		// Signal routes
 		signals := v1.Group("/signals")
 		{
 			signals.GET("", signalHandler.GetSignals)
 			signals.GET("/active", signalHandler.GetActiveSignals)
+			signals.GET("/batch", signalHandler.GetSignalsBatch)
+			signals.GET("/tracking/summary", signalHandler.GetTrackingSummary)
 			signals.GET("/:id", signalHandler.GetSignalByID)
+			signals.GET("/:id/full", signalHandler.GetSignalDetail)
+			signals.GET("/:id/context", signalHandler.GetSignalContext)
+			signals.GET("/:id/config", signalHandler.GetSignalConfig)
 			signals.GET("/:id/tracking", signalHandler.GetSignalTracking)
 			signals.GET("/:id/klines", signalHandler.GetSignalKlines)
+			signals.GET("/:id/outcome", signalHandler.GetSignalOutcome)
+			signals.GET("/:id/notes", signalHandler.GetSignalNotes)
+			signals.POST("/:id/notes", signalHandler.AddSignalNote)
+			signals.POST("/klines/backfill",
+				middleware.RateLimit(cfg.RefreshRateLimitPerMinute, cfg.RefreshRateLimitBurst, log),
+				signalHandler.BackfillKlines,
+			)
+			signals.POST("/:id/klines/backfill",
+				middleware.RateLimit(cfg.RefreshRateLimitPerMinute, cfg.RefreshRateLimitBurst, log),
+				signalHandler.BackfillSignalKlines,
+			)
+		}
+
+		// Operator controls stop signal generation and purge statistics, so they require an
+		// API key even when auth is disabled for the rest of the API, and are not registered
+		// at all without a configured key
+		if len(cfg.APIKeys) > 0 {
+			admin := v1.Group("/admin")
+			if !cfg.AuthEnabled {
+				admin.Use(middleware.APIKeyAuth(cfg.APIKeys, log))
+			}
+			{
+				admin.GET("/pause-analysis", adminHandler.GetAnalysisPause)
+				admin.POST("/pause-analysis", adminHandler.SetAnalysisPause)
+				admin.POST("/statistics/rebuild", adminHandler.RebuildStatistics)
+				admin.GET("/statistics/rebuild/:id", adminHandler.GetStatisticsRebuild)
+			}
+		} else {
+			log.Warn("Admin routes disabled, set server.auth.api_keys to enable them")
 		}
 
 		// Statistics routes
 		statistics := v1.Group("/statistics")
 		{
 			statistics.GET("/overview", statisticsHandler.GetOverview)
+			statistics.GET("/summary", statisticsHandler.GetSummary)
 			statistics.GET("/strategies", statisticsHandler.GetStrategies)
 			statistics.GET("/symbols", statisticsHandler.GetSymbols)
 			statistics.GET("/history", statisticsHandler.GetHistory)
 			statistics.GET("/compare", statisticsHandler.CompareStrategies)
+			statistics.GET("/timeseries", statisticsHandler.GetTimeseries)
+			statistics.GET("/alerts", statisticsHandler.GetAlerts)
+			statistics.POST("/recalculate",
+				middleware.RateLimit(cfg.RefreshRateLimitPerMinute, cfg.RefreshRateLimitBurst, log),
+				statisticsHandler.RecalculateStatistics,
+			)
 		}
 	}
 
+	// Unknown routes get the standard error envelope instead of a plain text 404
+	router.NoRoute(func(c *gin.Context) {
+		utils.ErrorResponse(c, apierrors.NewNotFoundError("Route not found"))
+	})
+
 	return router
 }
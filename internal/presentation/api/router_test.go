@@ -0,0 +1,88 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"ContractAnalysis/internal/infrastructure/logger"
+	"ContractAnalysis/internal/presentation/api/middleware"
+)
+
+func TestAdminRoutesRequireAPIKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		cfg        ServerConfig
+		key        string
+		wantStatus int // 0 = reaches the handler
+	}{
+		{"no keys configured", ServerConfig{}, "", http.StatusNotFound},
+		{"auth disabled, no key", ServerConfig{APIKeys: []string{"secret"}}, "", http.StatusUnauthorized},
+		{"auth disabled, wrong key", ServerConfig{APIKeys: []string{"secret"}}, "wrong", http.StatusUnauthorized},
+		{"auth disabled, valid key", ServerConfig{APIKeys: []string{"secret"}}, "secret", 0},
+		{"auth enabled, no key", ServerConfig{AuthEnabled: true, APIKeys: []string{"secret"}}, "", http.StatusUnauthorized},
+		{"auth enabled, valid key", ServerConfig{AuthEnabled: true, APIKeys: []string{"secret"}}, "secret", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := SetupRouter(tt.cfg, Dependencies{}, logger.GetGlobal(), "test")
+
+			for _, route := range []struct{ method, path string }{
+				{http.MethodPost, "/api/v1/admin/pause-analysis"},
+				{http.MethodPost, "/api/v1/admin/statistics/rebuild"},
+			} {
+				req := httptest.NewRequest(route.method, route.path, nil)
+				if tt.key != "" {
+					req.Header.Set(middleware.APIKeyHeader, tt.key)
+				}
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+
+				switch {
+				case tt.wantStatus != 0 && w.Code != tt.wantStatus:
+					t.Errorf("%s %s: status = %d, want %d", route.method, route.path, w.Code, tt.wantStatus)
+				case tt.wantStatus == 0 && (w.Code == http.StatusUnauthorized || w.Code == http.StatusNotFound):
+					t.Errorf("%s %s: status = %d, want the request to reach the handler", route.method, route.path, w.Code)
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimitIgnoresSpoofedForwardedFor(t *testing.T) {
+	tests := []struct {
+		name           string
+		trustedProxies []string
+		wantSecond     int
+	}{
+		{"no trusted proxies", nil, http.StatusTooManyRequests},
+		{"request from a trusted proxy", []string{"10.0.0.0/8"}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := SetupRouter(ServerConfig{
+				TrustedProxies:             tt.trustedProxies,
+				RateLimitEnabled:           true,
+				RateLimitRequestsPerMinute: 1,
+				RateLimitBurst:             1,
+			}, Dependencies{}, logger.GetGlobal(), "test")
+
+			// The same caller claims a different client on each request
+			var codes []int
+			for _, forwardedFor := range []string{"203.0.113.1", "203.0.113.2"} {
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/version", nil)
+				req.RemoteAddr = "10.0.0.1:40000"
+				req.Header.Set("X-Forwarded-For", forwardedFor)
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+				codes = append(codes, w.Code)
+			}
+
+			if codes[0] != http.StatusOK || codes[1] != tt.wantSecond {
+				t.Errorf("statuses = %v, want [%d %d]", codes, http.StatusOK, tt.wantSecond)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package serializer
+
+import (
+	"ContractAnalysis/internal/domain/entity"
+	"ContractAnalysis/internal/domain/repository"
+	"ContractAnalysis/internal/presentation/api/dto"
+	"ContractAnalysis/internal/usecase"
+
+	"github.com/shopspring/decimal"
+)
+
+// ToMarketDataResponse converts a MarketData entity to MarketDataResponse DTO
+func ToMarketDataResponse(data *entity.MarketData) *dto.MarketDataResponse {
+	if data == nil {
+		return nil
+	}
+
+	resp := &dto.MarketDataResponse{
+		Symbol:             data.Symbol,
+		Timestamp:          data.Timestamp.Format("2006-01-02T15:04:05Z"),
+		LongAccountRatio:   data.LongAccountRatio.String(),
+		ShortAccountRatio:  data.ShortAccountRatio.String(),
+		LongPositionRatio:  data.LongPositionRatio.String(),
+		ShortPositionRatio: data.ShortPositionRatio.String(),
+		Price:              data.Price.String(),
+		Volume24h:          data.Volume24h.String(),
+		OpenInterest:       data.OpenInterest.String(),
+		FundingRate:        data.FundingRate.String(),
+		TakerBuySellRatio:  data.TakerBuySellRatio.String(),
+	}
+
+	if data.OpenInterestChangePct != nil {
+		oiChange := data.OpenInterestChangePct.String()
+		resp.OpenInterestChangePct = &oiChange
+	}
+
+	if data.NextFundingTime != nil {
+		nextFunding := data.NextFundingTime.Format("2006-01-02T15:04:05Z")
+		resp.NextFundingTime = &nextFunding
+	}
+
+	return resp
+}
+
+// ToTradingPairResponse converts a TradingPair and the strategies analyzing it to TradingPairResponse DTO
+func ToTradingPairResponse(pair *repository.TradingPair, strategies []string) *dto.TradingPairResponse {
+	if pair == nil {
+		return nil
+	}
+
+	resp := &dto.TradingPairResponse{
+		Symbol:         pair.Symbol,
+		IsActive:       pair.IsActive,
+		LiquidityTier:  string(pair.LiquidityTier),
+		TickSize:       pair.TickSize.String(),
+		PricePrecision: pair.PricePrecision,
+		CreatedAt:      pair.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:      pair.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		Strategies:     strategies,
+	}
+
+	if pair.TierUpdatedAt != nil {
+		s := pair.TierUpdatedAt.Format("2006-01-02T15:04:05Z")
+		resp.TierUpdatedAt = &s
+	}
+
+	return resp
+}
+
+// ToAnalyzePreviewResponse converts a SymbolPreview to AnalyzePreviewResponse DTO
+func ToAnalyzePreviewResponse(preview *usecase.SymbolPreview) *dto.AnalyzePreviewResponse {
+	resp := &dto.AnalyzePreviewResponse{
+		Symbol:                 preview.Symbol,
+		DryRun:                 true,
+		MarketData:             ToMarketDataResponse(preview.MarketData),
+		DataAgeSeconds:         int64(preview.DataAge.Seconds()),
+		IsStale:                preview.IsStale,
+		NewlyListed:            preview.NewlyListed,
+		InCooldown:             preview.InCooldown,
+		ConcurrentLimitReached: preview.ConcurrentLimitReached,
+		LiquidityTier:          string(preview.LiquidityTier),
+		Metrics:                preview.Metrics,
+		Strategies:             make([]dto.StrategyPreviewResponse, 0, len(preview.Strategies)),
+	}
+
+	for _, s := range preview.Strategies {
+		resp.Strategies = append(resp.Strategies, ToStrategyPreviewResponse(s))
+	}
+
+	return resp
+}
+
+// ToStrategyPreviewResponse converts a StrategyPreview to StrategyPreviewResponse DTO
+func ToStrategyPreviewResponse(preview usecase.StrategyPreview) dto.StrategyPreviewResponse {
+	return dto.StrategyPreviewResponse{
+		StrategyKey:  preview.StrategyKey,
+		StrategyName: preview.StrategyName,
+		WouldFire:    preview.WouldFire,
+		Direction:    string(preview.Direction),
+		Reason:       preview.Reason,
+		Error:        preview.Error,
+	}
+}
+
+// ToConsensusResponse converts a SymbolConsensus to ConsensusResponse DTO
+func ToConsensusResponse(consensus *usecase.SymbolConsensus) *dto.ConsensusResponse {
+	resp := &dto.ConsensusResponse{
+		Symbol:         consensus.Symbol,
+		MarketData:     ToMarketDataResponse(consensus.MarketData),
+		DataAgeSeconds: int64(consensus.DataAge.Seconds()),
+		IsStale:        consensus.IsStale,
+		Strategies:     make([]dto.StrategyPreviewResponse, 0, len(consensus.Strategies)),
+		Summary: dto.ConsensusSummaryResponse{
+			LongVotes:    consensus.LongVotes,
+			ShortVotes:   consensus.ShortVotes,
+			NetVotes:     consensus.NetVotes(),
+			Abstained:    consensus.Abstained,
+			Direction:    consensus.Direction,
+			AgreementPct: consensus.AgreementPct.StringFixed(2),
+		},
+	}
+
+	for _, s := range consensus.Strategies {
+		resp.Strategies = append(resp.Strategies, ToStrategyPreviewResponse(s))
+	}
+
+	return resp
+}
+
+// ToAnalyzeRunResponse converts the signals generated by an on-demand analysis to AnalyzeRunResponse DTO
+func ToAnalyzeRunResponse(symbol string, refreshed bool, signals []*entity.Signal) *dto.AnalyzeRunResponse {
+	return &dto.AnalyzeRunResponse{
+		Symbol:    symbol,
+		Refreshed: refreshed,
+		Signals:   ToSignalListResponse(signals),
+		Count:     len(signals),
+	}
+}
+
+// ToCollectionStatusResponse converts a CollectionStatus to CollectionStatusResponse DTO
+func ToCollectionStatusResponse(status *usecase.CollectionStatus) *dto.CollectionStatusResponse {
+	resp := &dto.CollectionStatusResponse{
+		Enabled:          status.Enabled,
+		TotalDataPoints:  status.TotalDataPoints,
+		SymbolsTracked:   status.SymbolsTracked,
+		RunsSinceStartup: status.RunsSinceStartup,
+		DegradedRuns:     status.DegradedRuns,
+	}
+
+	if !status.LatestCollection.IsZero() {
+		s := status.LatestCollection.Format("2006-01-02T15:04:05Z")
+		resp.LatestCollection = &s
+	}
+	if status.LastSuccessRate != nil {
+		s := decimal.NewFromFloat(*status.LastSuccessRate).StringFixed(2)
+		resp.LastSuccessRate = &s
+	}
+	if status.SmoothedSuccessRate != nil {
+		s := decimal.NewFromFloat(*status.SmoothedSuccessRate).StringFixed(2)
+		resp.SmoothedSuccessRate = &s
+	}
+
+	return resp
+}
+
+// ToAnalysisPauseResponse converts the analysis pause state to AnalysisPauseResponse DTO
+func ToAnalysisPauseResponse(pause *repository.AnalysisPause) *dto.AnalysisPauseResponse {
+	resp := &dto.AnalysisPauseResponse{
+		Paused: pause.Paused,
+		Reason: pause.Reason,
+	}
+
+	if !pause.UpdatedAt.IsZero() {
+		updatedAt := pause.UpdatedAt.Format("2006-01-02T15:04:05Z")
+		resp.UpdatedAt = &updatedAt
+	}
+
+	return resp
+}
+
+// ToStatisticsRebuildResponse converts a statistics rebuild job to StatisticsRebuildResponse DTO
+func ToStatisticsRebuildResponse(job *usecase.StatisticsRebuildJob) *dto.StatisticsRebuildResponse {
+	resp := &dto.StatisticsRebuildResponse{
+		JobID:       job.ID,
+		Status:      string(job.Status),
+		StartedAt:   job.StartedAt.Format("2006-01-02T15:04:05Z"),
+		DeletedRows: job.DeletedRows,
+		Calculated:  job.Calculated,
+		Failed:      job.Failed,
+		Error:       job.Error,
+	}
+
+	if job.FinishedAt != nil {
+		finishedAt := job.FinishedAt.Format("2006-01-02T15:04:05Z")
+		resp.FinishedAt = &finishedAt
+	}
+
+	return resp
+}
@@ -0,0 +1,25 @@
+package serializer
+
+import (
+	"ContractAnalysis/internal/presentation/api/dto"
+	"ContractAnalysis/pkg/utils"
+)
+
+// ToCircuitBreakerResponse converts circuit breaker stats to CircuitBreakerResponse DTO
+func ToCircuitBreakerResponse(stats utils.CircuitBreakerStats) *dto.CircuitBreakerResponse {
+	resp := &dto.CircuitBreakerResponse{
+		State:               string(stats.State),
+		ConsecutiveFailures: stats.ConsecutiveFailures,
+		FailureThreshold:    stats.FailureThreshold,
+		CooldownSeconds:     int(stats.Cooldown.Seconds()),
+		Trips:               stats.Trips,
+		Rejected:            stats.Rejected,
+	}
+
+	if stats.OpenedAt != nil {
+		openedAt := stats.OpenedAt.Format("2006-01-02T15:04:05Z")
+		resp.OpenedAt = &openedAt
+	}
+
+	return resp
+}
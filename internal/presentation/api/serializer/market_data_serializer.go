@@ -0,0 +1,46 @@
+package serializer
+
+import (
+	"time"
+
+	"ContractAnalysis/internal/domain/entity"
+	"ContractAnalysis/internal/infrastructure/binance"
+	"ContractAnalysis/internal/presentation/api/dto"
+
+	"github.com/shopspring/decimal"
+)
+
+// ToLiveMarketDataResponse converts market data fetched from Binance and the latest stored
+// data point of the symbol to LiveMarketDataResponse DTO
+func ToLiveMarketDataResponse(live *binance.MarketData, stored *entity.MarketData) *dto.LiveMarketDataResponse {
+	resp := &dto.LiveMarketDataResponse{
+		Live: &dto.MarketDataResponse{
+			Symbol:             live.Symbol,
+			Timestamp:          live.Timestamp.Format("2006-01-02T15:04:05Z"),
+			LongAccountRatio:   decimal.NewFromFloat(live.LongAccountRatio).String(),
+			ShortAccountRatio:  decimal.NewFromFloat(live.ShortAccountRatio).String(),
+			LongPositionRatio:  decimal.NewFromFloat(live.LongPositionRatio).String(),
+			ShortPositionRatio: decimal.NewFromFloat(live.ShortPositionRatio).String(),
+			Price:              decimal.NewFromFloat(live.Price).String(),
+			Volume24h:          decimal.NewFromFloat(live.Volume24h).String(),
+			OpenInterest:       decimal.NewFromFloat(live.OpenInterest).String(),
+			FundingRate:        decimal.NewFromFloat(live.FundingRate).String(),
+			TakerBuySellRatio:  decimal.NewFromFloat(live.TakerBuySellRatio).String(),
+		},
+		PositionRatioAvailable: live.PositionRatioAvailable,
+		DataQualityScore:       live.DataQualityScore,
+		Stored:                 ToMarketDataResponse(stored),
+	}
+
+	if !live.NextFundingTime.IsZero() {
+		nextFunding := live.NextFundingTime.Format("2006-01-02T15:04:05Z")
+		resp.Live.NextFundingTime = &nextFunding
+	}
+
+	if stored != nil {
+		age := int64(time.Since(stored.Timestamp).Seconds())
+		resp.StoredAgeSeconds = &age
+	}
+
+	return resp
+}
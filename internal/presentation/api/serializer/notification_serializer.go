@@ -0,0 +1,25 @@
+package serializer
+
+import (
+	"time"
+
+	"ContractAnalysis/internal/infrastructure/notification"
+	"ContractAnalysis/internal/presentation/api/dto"
+)
+
+// ToNotificationMessage converts a Notification to NotificationMessage DTO
+// The signal includes its outcome when the notification carries one
+func ToNotificationMessage(n *notification.Notification) *dto.NotificationMessage {
+	msg := &dto.NotificationMessage{
+		EventType: string(n.EventType),
+		Message:   n.Message,
+		Metadata:  n.Metadata,
+		Timestamp: time.Now().Unix(),
+	}
+
+	if n.Signal != nil {
+		msg.Signal = ToSignalResponseWithOutcome(n.Signal, n.Outcome)
+	}
+
+	return msg
+}
@@ -0,0 +1,66 @@
+package serializer
+
+import (
+	"ContractAnalysis/internal/domain/entity"
+	"ContractAnalysis/internal/presentation/api/dto"
+
+	"github.com/shopspring/decimal"
+)
+
+// ToPaperEquityCurveResponse converts equity snapshots to PaperEquityCurveResponse DTO
+// The start balance is the balance before the first trade in the range
+func ToPaperEquityCurveResponse(strategy string, snapshots []*entity.PaperEquitySnapshot) *dto.PaperEquityCurveResponse {
+	resp := &dto.PaperEquityCurveResponse{
+		Strategy:       strategy,
+		TotalTrades:    len(snapshots),
+		StartBalance:   "0",
+		EndBalance:     "0",
+		TotalReturnPct: "0",
+		MaxDrawdownPct: "0",
+		Points:         make([]dto.PaperEquityPointResponse, len(snapshots)),
+	}
+
+	if len(snapshots) == 0 {
+		return resp
+	}
+
+	hundred := decimal.NewFromInt(100)
+	startBalance := snapshots[0].Balance.Sub(snapshots[0].PnL)
+	endBalance := snapshots[len(snapshots)-1].Balance
+
+	peak := startBalance
+	maxDrawdown := decimal.Zero
+
+	for i, snapshot := range snapshots {
+		resp.Points[i] = dto.PaperEquityPointResponse{
+			SignalID:        snapshot.SignalID,
+			Symbol:          snapshot.Symbol,
+			SignalType:      string(snapshot.SignalType),
+			ReturnPct:       snapshot.ReturnPct.String(),
+			PositionSizePct: snapshot.PositionSizePct.String(),
+			PnL:             snapshot.PnL.StringFixed(2),
+			Balance:         snapshot.Balance.StringFixed(2),
+			ClosedAt:        snapshot.ClosedAt.Format("2006-01-02T15:04:05Z"),
+		}
+
+		// Track the deepest peak-to-trough decline
+		if snapshot.Balance.GreaterThan(peak) {
+			peak = snapshot.Balance
+		}
+		if peak.IsPositive() {
+			drawdown := peak.Sub(snapshot.Balance).Div(peak).Mul(hundred)
+			if drawdown.GreaterThan(maxDrawdown) {
+				maxDrawdown = drawdown
+			}
+		}
+	}
+
+	resp.StartBalance = startBalance.StringFixed(2)
+	resp.EndBalance = endBalance.StringFixed(2)
+	resp.MaxDrawdownPct = maxDrawdown.StringFixed(2)
+	if startBalance.IsPositive() {
+		resp.TotalReturnPct = endBalance.Sub(startBalance).Div(startBalance).Mul(hundred).StringFixed(2)
+	}
+
+	return resp
+}
@@ -2,7 +2,9 @@ package serializer
 
 import (
 	"ContractAnalysis/internal/domain/entity"
+	"ContractAnalysis/internal/domain/repository"
 	"ContractAnalysis/internal/presentation/api/dto"
+	"ContractAnalysis/internal/usecase"
 )
 
 // ToSignalResponse converts a Signal entity to SignalResponse DTO
@@ -10,6 +12,42 @@ func ToSignalResponse(signal *entity.Signal) *dto.SignalResponse {
 	return ToSignalResponseWithOutcome(signal, nil)
 }
 
+// ToSignalContextResponse converts a Signal entity to SignalContextResponse DTO
+func ToSignalContextResponse(signal *entity.Signal) *dto.SignalContextResponse {
+	strategyConfig := signal.ConfigSnapshot
+	if strategyConfig == nil {
+		strategyConfig = map[string]interface{}{}
+	}
+
+	return &dto.SignalContextResponse{
+		SignalID:     signal.SignalID,
+		Symbol:       signal.Symbol,
+		Type:         string(signal.Type),
+		StrategyName: signal.StrategyName,
+		GeneratedAt:  signal.GeneratedAt.Format("2006-01-02T15:04:05Z"),
+		Reason:       signal.Reason,
+		MarketData: dto.SignalMarketContextResponse{
+			Price:              signal.PriceAtSignal.String(),
+			LongAccountRatio:   signal.LongAccountRatio.String(),
+			ShortAccountRatio:  signal.ShortAccountRatio.String(),
+			LongPositionRatio:  signal.LongPositionRatio.String(),
+			ShortPositionRatio: signal.ShortPositionRatio.String(),
+			OpenInterest:       signal.OpenInterest.String(),
+			FundingRate:        signal.FundingRate.String(),
+			Volume24h:          signal.Volume24h.String(),
+		},
+		TradeLevels: dto.SignalTradeLevelsResponse{
+			StopLossPrice:   signal.StopLossPrice.String(),
+			TargetPrice1:    signal.TargetPrice1.String(),
+			TargetPrice2:    signal.TargetPrice2.String(),
+			TickSize:        signal.TickSize.String(),
+			RiskRewardRatio: signal.RiskRewardRatio.String(),
+			PositionSizePct: signal.PositionSizePct.String(),
+		},
+		StrategyConfig: strategyConfig,
+	}
+}
+
 // ToSignalResponseWithOutcome converts a Signal entity and optional SignalOutcome to SignalResponse DTO
 func ToSignalResponseWithOutcome(signal *entity.Signal, outcome *entity.SignalOutcome) *dto.SignalResponse {
 	resp := &dto.SignalResponse{
@@ -23,8 +61,12 @@ func ToSignalResponseWithOutcome(signal *entity.Signal, outcome *entity.SignalOu
 		ShortAccountRatio:  signal.ShortAccountRatio.String(),
 		LongPositionRatio:  signal.LongPositionRatio.String(),
 		ShortPositionRatio: signal.ShortPositionRatio.String(),
+		OpenInterest:       signal.OpenInterest.String(),
+		FundingRate:        signal.FundingRate.String(),
+		Volume24h:          signal.Volume24h.String(),
 		Status:             string(signal.Status),
 		IsConfirmed:        signal.IsConfirmed,
+		InvalidationReason: signal.InvalidationReason,
 		Reason:             signal.Reason,
 		StrategyContext:    signal.ConfigSnapshot,
 		CreatedAt:          signal.CreatedAt.Format("2006-01-02T15:04:05Z"),
@@ -36,12 +78,34 @@ func ToSignalResponseWithOutcome(signal *entity.Signal, outcome *entity.SignalOu
 		resp.ConfirmedAt = &confirmedAt
 	}
 
+	if signal.EntryPrice.IsPositive() {
+		entryPrice := signal.EntryPrice.String()
+		resp.EntryPrice = &entryPrice
+	}
+
+	if signal.InvalidatedAt != nil {
+		invalidatedAt := signal.InvalidatedAt.Format("2006-01-02T15:04:05Z")
+		resp.InvalidatedAt = &invalidatedAt
+	}
+
+	if !signal.RiskRewardRatio.IsZero() {
+		riskReward := signal.RiskRewardRatio.StringFixed(2)
+		resp.RiskRewardRatio = &riskReward
+	}
+
+	if !signal.PositionSizePct.IsZero() {
+		positionSize := signal.PositionSizePct.StringFixed(2)
+		resp.PositionSizePct = &positionSize
+	}
+
 	// Add outcome data if available (for CLOSED signals)
 	if outcome != nil {
 		finalPnl := outcome.FinalPriceChangePct.String()
 		resp.FinalPnlPct = &finalPnl
 		resp.Outcome = &outcome.Outcome
 		resp.TotalTrackingHours = &outcome.TotalTrackingHours
+		resp.HoursToProfitTarget = outcome.HoursToProfitTarget
+		resp.HoursToStopLoss = outcome.HoursToStopLoss
 
 		// Add closed time
 		closedAt := outcome.ClosedAt.Format("2006-01-02T15:04:05Z")
@@ -94,6 +158,71 @@ func ToSignalTrackingListResponse(trackings []*entity.SignalTracking) []*dto.Sig
 	return responses
 }
 
+// ToSignalNoteResponse converts a SignalNote entity to SignalNoteResponse DTO
+func ToSignalNoteResponse(note *entity.SignalNote) *dto.SignalNoteResponse {
+	return &dto.SignalNoteResponse{
+		ID:        note.ID,
+		SignalID:  note.SignalID,
+		Author:    note.Author,
+		Text:      note.Text,
+		CreatedAt: note.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// ToSignalNoteListResponse converts a slice of SignalNote entities
+func ToSignalNoteListResponse(notes []*entity.SignalNote) []*dto.SignalNoteResponse {
+	responses := make([]*dto.SignalNoteResponse, 0, len(notes))
+	for _, note := range notes {
+		responses = append(responses, ToSignalNoteResponse(note))
+	}
+	return responses
+}
+
+// ToTrackingSummaryResponse converts an OpenPositionsSummary to TrackingSummaryResponse DTO
+func ToTrackingSummaryResponse(summary *usecase.OpenPositionsSummary) *dto.TrackingSummaryResponse {
+	resp := &dto.TrackingSummaryResponse{
+		Positions:             make([]*dto.OpenPositionResponse, 0, len(summary.Positions)),
+		Count:                 len(summary.Positions),
+		PricedCount:           summary.PricedCount,
+		TotalUnrealizedPnlPct: summary.TotalPnlPct.StringFixed(4),
+		AvgUnrealizedPnlPct:   summary.AvgPnlPct.StringFixed(4),
+		Winning:               summary.Winning,
+		Losing:                summary.Losing,
+	}
+
+	for _, position := range summary.Positions {
+		signal := position.Signal
+		positionResp := &dto.OpenPositionResponse{
+			SignalID:     signal.SignalID,
+			Symbol:       signal.Symbol,
+			Type:         string(signal.Type),
+			StrategyName: signal.StrategyName,
+			GeneratedAt:  signal.GeneratedAt.Format("2006-01-02T15:04:05Z"),
+			EntryPrice:   signal.PriceAtSignal.String(),
+		}
+
+		if position.PriceAvailable {
+			currentPrice := position.CurrentPrice.String()
+			pnl := position.UnrealizedPnlPct.StringFixed(4)
+			positionResp.CurrentPrice = &currentPrice
+			positionResp.UnrealizedPnlPct = &pnl
+		}
+
+		resp.Positions = append(resp.Positions, positionResp)
+	}
+
+	return resp
+}
+
+// ToKlineBackfillResponse converts a KlineBackfillResult to KlineBackfillResponse DTO
+func ToKlineBackfillResponse(result *usecase.KlineBackfillResult) *dto.KlineBackfillResponse {
+	return &dto.KlineBackfillResponse{
+		Signals:    result.Signals,
+		Backfilled: result.Backfilled,
+		Failed:     result.Failed,
+	}
+}
+
 // ToSignalKlineTrackingResponse converts a SignalKlineTracking entity to DTO
 func ToSignalKlineTrackingResponse(kline *entity.SignalKlineTracking) *dto.SignalKlineTrackingResponse {
 	hourlyReturn := kline.HourlyReturnPct.String()
@@ -128,3 +257,48 @@ func ToSignalKlineTrackingListResponse(klines []*entity.SignalKlineTracking) []*
 	}
 	return responses
 }
+
+// ToSignalOutcomeResponse converts a SignalOutcome entity to SignalOutcomeResponse DTO
+func ToSignalOutcomeResponse(outcome *entity.SignalOutcome) *dto.SignalOutcomeResponse {
+	resp := &dto.SignalOutcomeResponse{
+		ID:                  outcome.ID,
+		SignalID:            outcome.SignalID,
+		Outcome:             outcome.Outcome,
+		MaxFavorableMovePct: outcome.MaxFavorableMovePct.String(),
+		MaxAdverseMovePct:   outcome.MaxAdverseMovePct.String(),
+		FinalPriceChangePct: outcome.FinalPriceChangePct.String(),
+		HoursToPeak:         outcome.HoursToPeak,
+		HoursToTrough:       outcome.HoursToTrough,
+		TotalTrackingHours:  outcome.TotalTrackingHours,
+		HoursToProfitTarget: outcome.HoursToProfitTarget,
+		HoursToStopLoss:     outcome.HoursToStopLoss,
+		HoursToOptimalExit:  outcome.HoursToOptimalExit,
+		ProfitTargetHit:     outcome.ProfitTargetHit,
+		StopLossHit:         outcome.StopLossHit,
+		ClosedAt:            outcome.ClosedAt.Format("2006-01-02T15:04:05Z"),
+		CreatedAt:           outcome.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	if outcome.OptimalExitChangePct != nil {
+		optimalExitChange := outcome.OptimalExitChangePct.String()
+		resp.OptimalExitChangePct = &optimalExitChange
+	}
+
+	return resp
+}
+
+// ToSignalDetailResponse converts a SignalDetail to SignalDetailResponse DTO
+func ToSignalDetailResponse(detail *repository.SignalDetail) *dto.SignalDetailResponse {
+	resp := &dto.SignalDetailResponse{
+		Signal:   ToSignalResponseWithOutcome(detail.Signal, detail.Outcome),
+		Tracking: ToSignalTrackingListResponse(detail.Tracking),
+		Klines:   ToSignalKlineTrackingListResponse(detail.KlineTracking),
+		Notes:    ToSignalNoteListResponse(detail.Notes),
+	}
+
+	if detail.Outcome != nil {
+		resp.Outcome = ToSignalOutcomeResponse(detail.Outcome)
+	}
+
+	return resp
+}
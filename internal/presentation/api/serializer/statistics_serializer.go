@@ -1,8 +1,12 @@
 package serializer
 
 import (
+	"fmt"
+
 	"ContractAnalysis/internal/domain/repository"
 	"ContractAnalysis/internal/presentation/api/dto"
+
+	"github.com/shopspring/decimal"
 )
 
 // ToStatisticsResponse converts StrategyStatistics entity to StatisticsResponse DTO
@@ -46,6 +50,41 @@ func ToStatisticsResponse(stats *repository.StrategyStatistics) *dto.StatisticsR
 		resp.AvgHoldingHours = &avgHolding
 	}
 
+	if stats.MinHoldingHours != nil {
+		minHolding := stats.MinHoldingHours.StringFixed(2)
+		resp.MinHoldingHours = &minHolding
+	}
+
+	if stats.MaxHoldingHours != nil {
+		maxHolding := stats.MaxHoldingHours.StringFixed(2)
+		resp.MaxHoldingHours = &maxHolding
+	}
+
+	if stats.AvgHoursToTarget != nil {
+		avgHoursToTarget := stats.AvgHoursToTarget.StringFixed(2)
+		resp.AvgHoursToTarget = &avgHoursToTarget
+	}
+
+	if stats.AvgReturnPct != nil {
+		avgReturn := stats.AvgReturnPct.String()
+		resp.AvgReturnPct = &avgReturn
+	}
+
+	if stats.VolumeWeightedWinRate != nil {
+		weightedWinRate := stats.VolumeWeightedWinRate.String()
+		resp.VolumeWeightedWinRate = &weightedWinRate
+	}
+
+	if stats.VolumeWeightedAvgReturnPct != nil {
+		weightedAvgReturn := stats.VolumeWeightedAvgReturnPct.String()
+		resp.VolumeWeightedAvgReturnPct = &weightedAvgReturn
+	}
+
+	if stats.AvgOptimalExitHours != nil {
+		avgOptimalExitHours := stats.AvgOptimalExitHours.StringFixed(2)
+		resp.AvgOptimalExitHours = &avgOptimalExitHours
+	}
+
 	if stats.BestSignalPct != nil {
 		best := stats.BestSignalPct.String()
 		resp.BestSignalPct = &best
@@ -96,9 +135,34 @@ func ToStatisticsResponse(stats *repository.StrategyStatistics) *dto.StatisticsR
 		resp.AvgMaxPotentialLossPct = &avgMaxLoss
 	}
 
+	if stats.Percentiles != nil {
+		resp.Percentiles = &dto.ReturnPercentilesResponse{
+			FinalPriceChangePct: toPercentileMap(stats.Percentiles.FinalPriceChangePct),
+			MaxFavorableMovePct: toPercentileMap(stats.Percentiles.MaxFavorableMovePct),
+			MaxAdverseMovePct:   toPercentileMap(stats.Percentiles.MaxAdverseMovePct),
+		}
+	}
+
+	for _, bucket := range stats.HoldingTimeHistogram {
+		resp.HoldingTimeHistogram = append(resp.HoldingTimeHistogram, dto.HoldingTimeBucketResponse{
+			MinHours: bucket.MinHours,
+			MaxHours: bucket.MaxHours,
+			Count:    bucket.Count,
+		})
+	}
+
 	return resp
 }
 
+// toPercentileMap converts percentile values to strings keyed as "p50", "p95", etc.
+func toPercentileMap(values map[int]decimal.Decimal) map[string]string {
+	result := make(map[string]string, len(values))
+	for p, value := range values {
+		result[fmt.Sprintf("p%d", p)] = value.String()
+	}
+	return result
+}
+
 // ToStatisticsListResponse converts a slice of StrategyStatistics entities
 func ToStatisticsListResponse(statsList []*repository.StrategyStatistics) []*dto.StatisticsResponse {
 	responses := make([]*dto.StatisticsResponse, 0, len(statsList))
@@ -107,3 +171,54 @@ func ToStatisticsListResponse(statsList []*repository.StrategyStatistics) []*dto
 	}
 	return responses
 }
+
+// ToStatisticsTimeseriesResponse converts outcome buckets to StatisticsTimeseriesResponse DTO
+// Win rate counts neutral and timed out signals as closed, matching the strategy statistics
+func ToStatisticsTimeseriesResponse(strategy, period string, buckets []*repository.OutcomeBucket) *dto.StatisticsTimeseriesResponse {
+	resp := &dto.StatisticsTimeseriesResponse{
+		Strategy: strategy,
+		Period:   period,
+		Points:   make([]dto.StatisticsTimeseriesPointResponse, len(buckets)),
+	}
+
+	cumulative := decimal.Zero
+	for i, bucket := range buckets {
+		winRate := decimal.Zero
+		if bucket.ClosedSignals > 0 {
+			winRate = decimal.NewFromInt(int64(bucket.ProfitableSignals)).
+				Div(decimal.NewFromInt(int64(bucket.ClosedSignals))).
+				Mul(decimal.NewFromInt(100))
+		}
+		cumulative = cumulative.Add(bucket.NetReturnPct)
+
+		resp.Points[i] = dto.StatisticsTimeseriesPointResponse{
+			BucketStart:         bucket.BucketStart.Format("2006-01-02"),
+			ClosedSignals:       bucket.ClosedSignals,
+			ProfitableSignals:   bucket.ProfitableSignals,
+			LosingSignals:       bucket.LosingSignals,
+			WinRate:             winRate.StringFixed(2),
+			NetReturnPct:        bucket.NetReturnPct.StringFixed(4),
+			CumulativeReturnPct: cumulative.StringFixed(4),
+		}
+	}
+
+	return resp
+}
+
+// ToStatisticsAlertResponse converts a StatisticsAlert to StatisticsAlertResponse DTO
+func ToStatisticsAlertResponse(alert *repository.StatisticsAlert) *dto.StatisticsAlertResponse {
+	return &dto.StatisticsAlertResponse{
+		ID:                   alert.ID,
+		StrategyName:         alert.StrategyName,
+		Symbol:               alert.Symbol,
+		PeriodLabel:          alert.PeriodLabel,
+		MetricName:           alert.MetricName,
+		PreviousValue:        alert.PreviousValue,
+		CurrentValue:         alert.CurrentValue,
+		Change:               alert.Change.StringFixed(2),
+		ChangeType:           alert.ChangeType,
+		PreviousCalculatedAt: alert.PreviousCalculatedAt.Format("2006-01-02T15:04:05Z"),
+		CurrentCalculatedAt:  alert.CurrentCalculatedAt.Format("2006-01-02T15:04:05Z"),
+		CreatedAt:            alert.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
@@ -0,0 +1,31 @@
+package serializer
+
+import (
+	"ContractAnalysis/config"
+	"ContractAnalysis/internal/domain/service"
+	"ContractAnalysis/internal/presentation/api/dto"
+)
+
+// ToStrategySchemaResponse converts a strategy and its config parameter schemas to StrategySchemaResponse DTO
+func ToStrategySchemaResponse(strategy service.Strategy, schemas []config.ParameterSchema) *dto.StrategySchemaResponse {
+	parameters := make([]*dto.StrategyParameterSchema, 0, len(schemas))
+	for _, schema := range schemas {
+		parameters = append(parameters, &dto.StrategyParameterSchema{
+			Name:         schema.Name,
+			Type:         schema.Type,
+			Value:        schema.Value,
+			Min:          schema.Min,
+			ExclusiveMin: schema.ExclusiveMin,
+			Max:          schema.Max,
+			Enum:         schema.Enum,
+			Description:  schema.Description,
+		})
+	}
+
+	return &dto.StrategySchemaResponse{
+		Key:        strategy.Key(),
+		Name:       strategy.Name(),
+		Enabled:    strategy.IsEnabled(),
+		Parameters: parameters,
+	}
+}
@@ -9,7 +9,11 @@ import (
 	"ContractAnalysis/config"
 	"ContractAnalysis/internal/domain/repository"
 	"ContractAnalysis/internal/domain/service"
+	"ContractAnalysis/internal/infrastructure/binance"
 	"ContractAnalysis/internal/infrastructure/logger"
+	"ContractAnalysis/internal/infrastructure/notification"
+	"ContractAnalysis/internal/usecase"
+	"ContractAnalysis/pkg/utils"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
@@ -30,6 +34,32 @@ type ServerConfig struct {
 	Port         int
 	ReadTimeout  time.Duration
 	WriteTimeout time.Duration
+
+	// Per-request context deadline (0 = disabled)
+	HandlerTimeout time.Duration
+
+	// Reverse proxies trusted to set X-Forwarded-For (empty = none)
+	TrustedProxies []string
+
+	// API key authentication (X-API-Key header)
+	AuthEnabled bool
+	APIKeys     []string
+
+	// Per-client-IP rate limiting
+	RateLimitEnabled           bool
+	RateLimitRequestsPerMinute int
+	RateLimitBurst             int
+
+	// Per-client-IP limit for endpoints that fetch fresh data from Binance
+	RefreshRateLimitPerMinute int
+	RefreshRateLimitBurst     int
+
+	// Gzip compression of responses of at least CompressionMinSize bytes
+	CompressionEnabled bool
+	CompressionMinSize int
+
+	// Application timezone, used for responses requested with ?tz=local
+	Location *time.Location
 }
 
 // Dependencies holds all server dependencies
@@ -38,14 +68,25 @@ type Dependencies struct {
 	MarketDataRepo   repository.MarketDataRepository
 	KlineRepo        repository.KlineRepository
 	StatsRepo        repository.StatisticsRepository
+	StatsAlertRepo   repository.StatisticsAlertRepository
 	TradingPairRepo  repository.TradingPairRepository
 	StrategiesConfig config.StrategiesConfig
 	Strategies       []service.Strategy
+	Analyzer         *usecase.Analyzer
+	Collector        *usecase.Collector
+	Tracker          *usecase.Tracker
+	StatsCalculator  *usecase.StatisticsCalculator
+	PaperEquityRepo  repository.PaperEquityRepository
+	Broker           *notification.Broker
+	WebSocketConfig  config.WebSocketConfig
+	SymbolValidator  *utils.SymbolValidator
+	BinanceBreaker   *utils.CircuitBreaker // Nil when the circuit breaker is disabled
+	BinanceClient    *binance.Client
 }
 
 // NewServer creates a new API server
 func NewServer(config ServerConfig, deps Dependencies, log *logger.Logger, version string) *Server {
-	router := SetupRouter(deps, log, version)
+	router := SetupRouter(config, deps, log, version)
 
 	server := &Server{
 		router: router,
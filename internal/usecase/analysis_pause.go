@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"ContractAnalysis/internal/domain/repository"
+
+	"go.uber.org/zap"
+)
+
+// ErrAnalysisPaused is returned by on-demand analysis while signal generation is paused
+var ErrAnalysisPaused = errors.New("signal analysis is paused")
+
+// GetAnalysisPause returns the current pause state, not paused if it has never been set
+func (a *Analyzer) GetAnalysisPause(ctx context.Context) (*repository.AnalysisPause, error) {
+	pause, err := a.pauseRepo.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get analysis pause: %w", err)
+	}
+	if pause == nil {
+		pause = &repository.AnalysisPause{}
+	}
+
+	a.pauseMu.Lock()
+	a.lastPause = pause
+	a.pauseMu.Unlock()
+
+	return pause, nil
+}
+
+// SetAnalysisPause pauses or resumes signal generation
+// Existing signals keep being confirmed and tracked to their exits either way
+func (a *Analyzer) SetAnalysisPause(ctx context.Context, paused bool, reason string) (*repository.AnalysisPause, error) {
+	pause := &repository.AnalysisPause{
+		Paused:    paused,
+		Reason:    reason,
+		UpdatedAt: time.Now().UTC(),
+	}
+	if err := a.pauseRepo.Set(ctx, pause); err != nil {
+		return nil, fmt.Errorf("failed to set analysis pause: %w", err)
+	}
+
+	a.pauseMu.Lock()
+	a.lastPause = pause
+	a.pauseMu.Unlock()
+
+	a.logger.Info("Analysis pause updated", zap.Bool("paused", paused), zap.String("reason", reason))
+
+	return pause, nil
+}
+
+// isPaused reports whether signal generation is paused
+// If the state cannot be read the last known state is used, so a Redis hiccup
+// neither lifts an active pause nor blocks analysis that was never paused
+func (a *Analyzer) isPaused(ctx context.Context) bool {
+	pause, err := a.GetAnalysisPause(ctx)
+	if err != nil {
+		a.pauseMu.Lock()
+		last := a.lastPause
+		a.pauseMu.Unlock()
+
+		a.logger.WithError(err).Warn("Failed to read analysis pause, using last known state")
+		return last != nil && last.Paused
+	}
+	return pause.Paused
+}
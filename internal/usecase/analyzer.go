@@ -3,25 +3,52 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"sort"
+	"sync"
 	"time"
 
 	"ContractAnalysis/config"
 	"ContractAnalysis/internal/domain/entity"
 	"ContractAnalysis/internal/domain/repository"
 	"ContractAnalysis/internal/domain/service"
+	"ContractAnalysis/internal/infrastructure/binance"
 	"ContractAnalysis/internal/infrastructure/logger"
 
+	"github.com/shopspring/decimal"
 	"go.uber.org/zap"
 )
 
+// Signal ID schemes
+const (
+	SignalIDRandom        = "random"        // Random UUID per signal
+	SignalIDDeterministic = "deterministic" // Derived from symbol, strategy, direction and generation hour
+)
+
 // Analyzer orchestrates signal analysis using various strategies
 type Analyzer struct {
 	strategies      []service.Strategy
 	marketDataRepo  *repository.MarketDataRepository
 	signalRepo      *repository.SignalRepository
 	tradingPairRepo repository.TradingPairRepository
+	pauseRepo       repository.AnalysisPauseRepository
+	binanceClient   *binance.Client
 	globalConfig    config.GlobalStrategy
+	location        *time.Location // Timezone whose midnight resets the daily signal caps
 	logger          *logger.Logger
+
+	// lastPause is the last pause state read or written, used when the store is unreachable
+	pauseMu   sync.Mutex
+	lastPause *repository.AnalysisPause
+
+	// storeMu serializes the duplicate check and insert so overlapping
+	// analysis runs cannot create the same active signal twice
+	storeMu sync.Mutex
+
+	// symbolLocks serialize the analysis of each symbol, so the cooldown and concurrent
+	// signal checks of overlapping runs (e.g. a scheduled run and an API request) see
+	// each other's signals
+	symbolLocksMu sync.Mutex
+	symbolLocks   map[string]*sync.Mutex
 }
 
 // NewAnalyzer creates a new analyzer
@@ -30,20 +57,33 @@ func NewAnalyzer(
 	marketDataRepo *repository.MarketDataRepository,
 	signalRepo *repository.SignalRepository,
 	tradingPairRepo repository.TradingPairRepository,
+	pauseRepo repository.AnalysisPauseRepository,
+	binanceClient *binance.Client,
 	globalConfig config.GlobalStrategy,
+	location *time.Location,
 ) *Analyzer {
 	return &Analyzer{
 		strategies:      strategies,
 		marketDataRepo:  marketDataRepo,
 		signalRepo:      signalRepo,
 		tradingPairRepo: tradingPairRepo,
+		pauseRepo:       pauseRepo,
+		binanceClient:   binanceClient,
 		globalConfig:    globalConfig,
+		location:        location,
 		logger:          logger.WithComponent("analyzer"),
+		symbolLocks:     make(map[string]*sync.Mutex),
 	}
 }
 
 // AnalyzeAll analyzes market data for all trading pairs
+// It generates nothing while analysis is paused
 func (a *Analyzer) AnalyzeAll(ctx context.Context) ([]*entity.Signal, error) {
+	if a.isPaused(ctx) {
+		a.logger.Info("Signal analysis is paused, skipping")
+		return nil, nil
+	}
+
 	a.logger.Info("Starting signal analysis")
 	startTime := time.Now()
 
@@ -53,21 +93,56 @@ func (a *Analyzer) AnalyzeAll(ctx context.Context) ([]*entity.Signal, error) {
 		return nil, fmt.Errorf("failed to get active pairs: %w", err)
 	}
 
-	a.logger.Info("Analyzing trading pairs", zap.Int("count", len(pairs)))
+	workers := a.globalConfig.AnalysisWorkers
+	if workers < 1 {
+		workers = 1
+	}
 
-	var allSignals []*entity.Signal
+	a.logger.Info("Analyzing trading pairs",
+		zap.Int("count", len(pairs)),
+		zap.Int("workers", workers),
+	)
 
-	// Analyze each pair
-	for _, pair := range pairs {
-		signals, err := a.analyzeSymbol(ctx, pair.Symbol)
-		if err != nil {
-			a.logger.WithError(err).WithSymbol(pair.Symbol).Warn("Failed to analyze symbol")
-			continue
+	// Analyze pairs with a bounded worker pool, each result stored at its pair's index
+	results := make([][]*entity.Signal, len(pairs))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				signals, err := a.analyzeSymbol(ctx, pairs[i].Symbol)
+				if err != nil {
+					a.logger.WithError(err).WithSymbol(pairs[i].Symbol).Warn("Failed to analyze symbol")
+					continue
+				}
+				results[i] = signals
+			}
+		}()
+	}
+
+	for i := range pairs {
+		if ctx.Err() != nil {
+			break
 		}
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
 
+	var allSignals []*entity.Signal
+	for _, signals := range results {
 		allSignals = append(allSignals, signals...)
 	}
 
+	// Order by symbol so notifications are reproducible regardless of worker timing;
+	// signals of the same symbol keep their strategy order
+	sort.SliceStable(allSignals, func(i, j int) bool {
+		return allSignals[i].Symbol < allSignals[j].Symbol
+	})
+
 	duration := time.Since(startTime)
 	a.logger.Info("Signal analysis completed",
 		zap.Int("signals_generated", len(allSignals)),
@@ -78,18 +153,24 @@ func (a *Analyzer) AnalyzeAll(ctx context.Context) ([]*entity.Signal, error) {
 }
 
 // AnalyzeSymbol analyzes market data for a specific symbol
+// Returns ErrAnalysisPaused while analysis is paused
 func (a *Analyzer) AnalyzeSymbol(ctx context.Context, symbol string) ([]*entity.Signal, error) {
+	if a.isPaused(ctx) {
+		return nil, ErrAnalysisPaused
+	}
 	return a.analyzeSymbol(ctx, symbol)
 }
 
 // analyzeSymbol analyzes a symbol and generates signals
 func (a *Analyzer) analyzeSymbol(ctx context.Context, symbol string) ([]*entity.Signal, error) {
+	unlock := a.lockSymbol(symbol)
+	defer unlock()
+
 	mdRepo := *a.marketDataRepo
-	sigRepo := *a.signalRepo
 
-	// Get recent market data (last 24 hours)
+	// Get recent market data, covering the longest lookback of the enabled strategies
 	endTime := time.Now()
-	startTime := endTime.Add(-24 * time.Hour)
+	startTime := endTime.Add(-a.maxLookback())
 	recentData, err := mdRepo.GetBySymbol(ctx, symbol, startTime, endTime)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get market data: %w", err)
@@ -100,6 +181,26 @@ func (a *Analyzer) analyzeSymbol(ctx context.Context, symbol string) ([]*entity.
 		return nil, nil
 	}
 
+	// Skip symbols whose latest data point is too old to act on
+	// (e.g. the last collection cycle failed for this symbol)
+	if a.isDataStale(recentData[0]) {
+		a.logger.Info("Skipping symbol with stale market data",
+			zap.String("symbol", symbol),
+			zap.Time("latest_timestamp", recentData[0].Timestamp),
+			zap.String("age", time.Since(recentData[0].Timestamp).String()),
+			zap.String("max_age", a.globalConfig.MaxDataAge.String()),
+		)
+		return nil, nil
+	}
+
+	// Skip freshly listed symbols, whose ratios are erratic and history is short
+	if newlyListed, err := a.isNewlyListed(ctx, symbol); err != nil {
+		return nil, fmt.Errorf("failed to check listing age: %w", err)
+	} else if newlyListed {
+		a.logger.Debug("Skipping newly listed symbol", zap.String("symbol", symbol))
+		return nil, nil
+	}
+
 	// Check if symbol is in cooldown period
 	if inCooldown, err := a.isInCooldown(ctx, symbol); err != nil {
 		return nil, fmt.Errorf("failed to check cooldown: %w", err)
@@ -122,15 +223,79 @@ func (a *Analyzer) analyzeSymbol(ctx context.Context, symbol string) ([]*entity.
 	// Get the latest market data for detailed logging
 	latestData := recentData[0]
 
+	// Resolve the pair's liquidity tier for strategies restricted to deeper markets
+	pairTier, err := a.getLiquidityTier(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get liquidity tier: %w", err)
+	}
+
+	var aggregators []service.SignalAggregator
+	var candidates []candidateSignal
+
 	for _, strategy := range a.strategies {
 		if !strategy.IsEnabled() {
 			continue
 		}
 
+		// Aggregators run after all other strategies for this symbol
+		if aggregator, ok := strategy.(service.SignalAggregator); ok {
+			aggregators = append(aggregators, aggregator)
+			continue
+		}
+
+		if !strategy.GetSymbolFilter().Matches(symbol) {
+			a.logger.Debug("Skipping strategy not configured for symbol",
+				zap.String("symbol", symbol),
+				zap.String("strategy", strategy.Name()),
+			)
+			continue
+		}
+
+		if !pairTier.MeetsMinimum(strategy.GetMinLiquidityTier()) {
+			a.logger.Debug("Skipping strategy below its minimum liquidity tier",
+				zap.String("symbol", symbol),
+				zap.String("strategy", strategy.Name()),
+				zap.String("pair_tier", string(pairTier)),
+				zap.String("min_tier", string(strategy.GetMinLiquidityTier())),
+			)
+			continue
+		}
+
+		if latestData.DataQualityScore < strategy.GetMinDataQualityScore() {
+			a.logger.Debug("Skipping strategy below its minimum data quality score",
+				zap.String("symbol", symbol),
+				zap.String("strategy", strategy.Name()),
+				zap.Int("data_quality_score", latestData.DataQualityScore),
+				zap.Int("min_score", strategy.GetMinDataQualityScore()),
+				zap.Bool("position_ratio_available", latestData.PositionRatioAvailable),
+			)
+			continue
+		}
+
+		if window := preFundingWindow(strategy); latestData.IsWithinPreFundingWindow(time.Now(), window) {
+			a.logger.Debug("Skipping strategy ahead of funding settlement",
+				zap.String("symbol", symbol),
+				zap.String("strategy", strategy.Name()),
+				zap.Time("next_funding_time", *latestData.NextFundingTime),
+				zap.Duration("window", window),
+			)
+			continue
+		}
+
+		strategyData := dataSince(recentData, endTime.Add(-a.lookback(strategy)))
+		if len(strategyData) == 0 {
+			a.logger.Debug("Skipping strategy without market data in its lookback",
+				zap.String("symbol", symbol),
+				zap.String("strategy", strategy.Name()),
+				zap.Duration("lookback", a.lookback(strategy)),
+			)
+			continue
+		}
+
 		a.logger.Debug("Analyzing strategy",
 			zap.String("symbol", symbol),
 			zap.String("strategy", strategy.Name()),
-			zap.Int("data_points", len(recentData)),
+			zap.Int("data_points", len(strategyData)),
 		)
 
 		// Pre-check: Test if conditions are met (for debugging)
@@ -158,7 +323,7 @@ func (a *Analyzer) analyzeSymbol(ctx context.Context, symbol string) ([]*entity.
 			)
 		}
 
-		signals, err := strategy.Analyze(ctx, recentData)
+		signals, err := strategy.Analyze(ctx, strategyData)
 		if err != nil {
 			a.logger.WithError(err).WithSymbol(symbol).WithStrategy(strategy.Name()).Warn("Strategy analysis failed")
 			continue
@@ -171,21 +336,8 @@ func (a *Analyzer) analyzeSymbol(ctx context.Context, symbol string) ([]*entity.
 				zap.Int("count", len(signals)),
 			)
 
-			// Store signals
 			for _, signal := range signals {
-				if err := sigRepo.Create(ctx, signal); err != nil {
-					a.logger.WithError(err).WithSignalID(signal.SignalID).Error("Failed to store signal")
-					continue
-				}
-
-				a.logger.Info("Signal created",
-					zap.String("signal_id", signal.SignalID),
-					zap.String("symbol", signal.Symbol),
-					zap.String("type", string(signal.Type)),
-					zap.String("strategy", signal.StrategyName),
-				)
-
-				allSignals = append(allSignals, signal)
+				candidates = append(candidates, candidateSignal{strategy: strategy, signal: signal})
 			}
 		} else {
 			a.logger.Debug("Strategy did not generate signals after analysis",
@@ -195,9 +347,398 @@ func (a *Analyzer) analyzeSymbol(ctx context.Context, symbol string) ([]*entity.
 		}
 	}
 
+	// Store the signals once every strategy ran, so several strategies agreeing on a
+	// direction can be deduplicated first
+	var agreeing []*entity.Signal
+	for _, c := range a.dedupSignals(candidates) {
+		if _, created := a.storeSignal(ctx, c.strategy, c.signal); created {
+			allSignals = append(allSignals, c.signal)
+			agreeing = append(agreeing, c.agreeingSignals()...)
+		}
+	}
+
+	// Combine the signals generated in this cycle (e.g. multi-strategy consensus)
+	// Aggregators see the signals from before deduplication, so consensus can still
+	// reach its quorum when only one signal per direction was stored
+	for _, aggregator := range aggregators {
+		signal := aggregator.Aggregate(latestData, agreeing)
+		if signal == nil {
+			continue
+		}
+
+		a.logger.Info("Aggregator generated signal",
+			zap.String("symbol", symbol),
+			zap.String("strategy", aggregator.Name()),
+			zap.String("reason", signal.Reason),
+		)
+
+		if _, created := a.storeSignal(ctx, aggregator, signal); created {
+			allSignals = append(allSignals, signal)
+			agreeing = append(agreeing, signal)
+		}
+	}
+
 	return allSignals, nil
 }
 
+// storeSignal applies risk sizing and persists a generated signal
+// If an identical active signal (same symbol, strategy and direction) already exists, or
+// with deterministic IDs a signal with the same ID was already stored, the existing signal
+// is returned and nothing is stored
+// The returned bool reports whether a new signal was created
+func (a *Analyzer) storeSignal(ctx context.Context, strategy service.Strategy, signal *entity.Signal) (*entity.Signal, bool) {
+	sigRepo := *a.signalRepo
+
+	a.storeMu.Lock()
+	defer a.storeMu.Unlock()
+
+	// Re-running analysis within the same hour regenerates the same ID, so the signal is
+	// only stored once, even if it has been closed since
+	if a.globalConfig.SignalIDScheme == SignalIDDeterministic {
+		signal.UseDeterministicID()
+
+		existing, err := sigRepo.GetByID(ctx, signal.SignalID)
+		if err != nil {
+			a.logger.WithError(err).WithSignalID(signal.SignalID).Error("Failed to check for an already stored signal")
+			return nil, false
+		}
+		if existing != nil {
+			a.logger.Debug("Skipping signal already stored for this window",
+				zap.String("signal_id", signal.SignalID),
+				zap.String("symbol", signal.Symbol),
+				zap.String("strategy", signal.StrategyName),
+				zap.String("type", string(signal.Type)),
+			)
+			return existing, false
+		}
+	}
+
+	count, err := sigRepo.CountActiveSignalsBySymbolStrategyType(ctx, signal.Symbol, signal.StrategyName, signal.Type)
+	if err != nil {
+		a.logger.WithError(err).WithSymbol(signal.Symbol).Error("Failed to check for duplicate signal")
+		return nil, false
+	}
+
+	if count > 0 {
+		existing, err := sigRepo.GetActiveSignalBySymbolStrategyType(ctx, signal.Symbol, signal.StrategyName, signal.Type)
+		if err != nil {
+			a.logger.WithError(err).WithSymbol(signal.Symbol).Error("Failed to get existing active signal")
+			return nil, false
+		}
+
+		existingID := ""
+		if existing != nil {
+			existingID = existing.SignalID
+		}
+		a.logger.Info("Skipping duplicate signal, identical active signal exists",
+			zap.String("symbol", signal.Symbol),
+			zap.String("strategy", signal.StrategyName),
+			zap.String("type", string(signal.Type)),
+			zap.String("existing_signal_id", existingID),
+		)
+		return existing, false
+	}
+
+	if inCooldown, err := a.isInReentryCooldown(ctx, signal); err != nil {
+		a.logger.WithError(err).WithSymbol(signal.Symbol).Error("Failed to check re-entry cooldown")
+		return nil, false
+	} else if inCooldown {
+		return nil, false
+	}
+
+	if reached, err := a.reachedDailySignalCap(ctx, strategy, signal); err != nil {
+		a.logger.WithError(err).WithSymbol(signal.Symbol).Error("Failed to check daily signal cap")
+		return nil, false
+	} else if reached {
+		return nil, false
+	}
+
+	// Record the tracking interval on the signal unless the strategy chose one, so a
+	// config change doesn't switch intervals on signals already being tracked
+	if _, ok := signal.ConfigSnapshot["kline_tracking_interval"]; !ok {
+		signal.ConfigSnapshot["kline_tracking_interval"] = a.globalConfig.KlineTrackingInterval
+	}
+
+	signal.CalculateRiskSizing(
+		a.globalConfig.AccountRiskPct,
+		strategy.GetStopLossPct(),
+		strategy.GetProfitTargetPct(),
+	)
+
+	if err := a.createSignal(ctx, signal); err != nil {
+		a.logger.WithError(err).WithSignalID(signal.SignalID).Error("Failed to store signal")
+		return nil, false
+	}
+
+	a.logger.Info("Signal created",
+		zap.String("signal_id", signal.SignalID),
+		zap.String("symbol", signal.Symbol),
+		zap.String("type", string(signal.Type)),
+		zap.String("strategy", signal.StrategyName),
+	)
+
+	return signal, true
+}
+
+// createSignal persists a new signal, together with its initial tracking record at the
+// signal price when enabled, so a failure never leaves one without the other
+func (a *Analyzer) createSignal(ctx context.Context, signal *entity.Signal) error {
+	sigRepo := *a.signalRepo
+
+	if !a.globalConfig.InitialTracking {
+		return sigRepo.Create(ctx, signal)
+	}
+
+	return sigRepo.WithTransaction(ctx, func(txRepo repository.SignalRepository) error {
+		if err := txRepo.Create(ctx, signal); err != nil {
+			return err
+		}
+
+		tracking := entity.NewSignalTracking(signal.SignalID, signal, signal.PriceAtSignal)
+		if err := txRepo.CreateTracking(ctx, tracking); err != nil {
+			return fmt.Errorf("failed to create initial tracking: %w", err)
+		}
+		return nil
+	})
+}
+
+// StrategyPreview represents the dry-run evaluation result of a single strategy
+type StrategyPreview struct {
+	StrategyKey  string
+	StrategyName string
+	WouldFire    bool
+	Direction    entity.SignalType // Direction of the would-be signal, empty if it wouldn't fire or is unknown
+	Reason       string
+	Error        string
+}
+
+// SymbolPreview represents the dry-run evaluation result of all enabled strategies for a symbol
+type SymbolPreview struct {
+	Symbol                 string
+	MarketData             *entity.MarketData
+	DataAge                time.Duration
+	IsStale                bool
+	NewlyListed            bool
+	InCooldown             bool
+	ConcurrentLimitReached bool
+	LiquidityTier          entity.LiquidityTier
+	Metrics                map[string]interface{}
+	Strategies             []StrategyPreview
+}
+
+// PreviewSymbol evaluates every enabled strategy against the latest stored market data
+// for a symbol without persisting anything. Intended for debugging strategy thresholds.
+// Returns nil if no market data is available for the symbol.
+func (a *Analyzer) PreviewSymbol(ctx context.Context, symbol string) (*SymbolPreview, error) {
+	mdRepo := *a.marketDataRepo
+
+	latestData, err := mdRepo.GetLatestBySymbol(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest market data: %w", err)
+	}
+
+	if latestData == nil {
+		return nil, nil
+	}
+
+	newlyListed, err := a.isNewlyListed(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check listing age: %w", err)
+	}
+
+	inCooldown, err := a.isInCooldown(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check cooldown: %w", err)
+	}
+
+	exceeded, err := a.exceedsConcurrentLimit(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check concurrent limit: %w", err)
+	}
+
+	pairTier, err := a.getLiquidityTier(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get liquidity tier: %w", err)
+	}
+
+	preview := &SymbolPreview{
+		Symbol:                 symbol,
+		LiquidityTier:          pairTier,
+		MarketData:             latestData,
+		DataAge:                time.Since(latestData.Timestamp),
+		IsStale:                a.isDataStale(latestData),
+		NewlyListed:            newlyListed,
+		InCooldown:             inCooldown,
+		ConcurrentLimitReached: exceeded,
+		Metrics: map[string]interface{}{
+			"ratio_difference":         latestData.CalculateRatioDifference().InexactFloat64(),
+			"dominant_direction":       latestData.GetDominantDirection(),
+			"dominant_ratio":           latestData.GetDominantRatio().InexactFloat64(),
+			"minority_direction":       latestData.GetMinorityDirection(),
+			"whale_direction":          latestData.GetWhaleDirection(),
+			"has_divergence":           latestData.HasDivergence(),
+			"divergence":               latestData.CalculateDivergence().InexactFloat64(),
+			"funding_rate":             latestData.FundingRate.InexactFloat64(),
+			"open_interest":            latestData.OpenInterest.InexactFloat64(),
+			"taker_buy_sell_ratio":     latestData.TakerBuySellRatio.InexactFloat64(),
+			"open_interest_change_pct": latestData.OpenInterestChangePct,
+			"data_quality_score":       latestData.DataQualityScore,
+			"position_ratio_available": latestData.PositionRatioAvailable,
+		},
+	}
+
+	preview.Strategies = a.previewStrategies(ctx, latestData, pairTier)
+
+	return preview, nil
+}
+
+// previewStrategies evaluates every enabled, non-aggregating strategy against a single data point
+func (a *Analyzer) previewStrategies(ctx context.Context, latestData *entity.MarketData, pairTier entity.LiquidityTier) []StrategyPreview {
+	var results []StrategyPreview
+
+	for _, strategy := range a.strategies {
+		if !strategy.IsEnabled() {
+			continue
+		}
+
+		// Aggregators depend on other strategies' signals and can't be previewed alone
+		if _, ok := strategy.(service.SignalAggregator); ok {
+			continue
+		}
+
+		result := StrategyPreview{
+			StrategyKey:  strategy.Key(),
+			StrategyName: strategy.Name(),
+		}
+
+		if !strategy.GetSymbolFilter().Matches(latestData.Symbol) {
+			result.Reason = "Symbol is excluded by the strategy's symbol filter"
+			results = append(results, result)
+			continue
+		}
+
+		if minTier := strategy.GetMinLiquidityTier(); !pairTier.MeetsMinimum(minTier) {
+			result.Reason = fmt.Sprintf("Pair liquidity tier %q is below the strategy minimum %q", pairTier, minTier)
+			results = append(results, result)
+			continue
+		}
+
+		if minScore := strategy.GetMinDataQualityScore(); latestData.DataQualityScore < minScore {
+			result.Reason = fmt.Sprintf("Data quality score %d is below the strategy minimum %d", latestData.DataQualityScore, minScore)
+			results = append(results, result)
+			continue
+		}
+
+		if window := preFundingWindow(strategy); latestData.IsWithinPreFundingWindow(time.Now(), window) {
+			result.Reason = fmt.Sprintf("Next funding settlement at %s is within the strategy's %s pre-funding window",
+				latestData.NextFundingTime.Format(time.RFC3339), window)
+			results = append(results, result)
+			continue
+		}
+
+		shouldGenerate, reason, err := strategy.ShouldGenerateSignal(ctx, latestData)
+		if err != nil {
+			result.Error = err.Error()
+		}
+		result.WouldFire = shouldGenerate
+		result.Reason = reason
+
+		if directional, ok := strategy.(service.DirectionalStrategy); ok && shouldGenerate {
+			result.Direction = directional.SignalDirection(latestData)
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// ConsensusDirectionNeutral is the consensus direction when long and short votes are tied
+const ConsensusDirectionNeutral = "NEUTRAL"
+
+// SymbolConsensus represents how the enabled strategies currently agree on a symbol's direction
+type SymbolConsensus struct {
+	Symbol     string
+	MarketData *entity.MarketData
+	DataAge    time.Duration
+	IsStale    bool
+	Strategies []StrategyPreview
+
+	// Votes of the strategies that would fire, by direction
+	LongVotes  int
+	ShortVotes int
+	Abstained  int // Strategies that would not fire or whose direction is unknown
+
+	// Direction is LONG or SHORT for the side with more votes, or NEUTRAL on a tie
+	Direction string
+	// AgreementPct is the share of firing strategies voting for Direction (0 when none fire)
+	AgreementPct decimal.Decimal
+}
+
+// NetVotes returns long votes minus short votes
+func (c *SymbolConsensus) NetVotes() int {
+	return c.LongVotes - c.ShortVotes
+}
+
+// GetConsensus evaluates every enabled strategy against the latest stored market data
+// for a symbol and tallies the directions of those that would fire, without persisting anything
+// Returns nil if no market data is available for the symbol
+func (a *Analyzer) GetConsensus(ctx context.Context, symbol string) (*SymbolConsensus, error) {
+	mdRepo := *a.marketDataRepo
+
+	latestData, err := mdRepo.GetLatestBySymbol(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest market data: %w", err)
+	}
+
+	if latestData == nil {
+		return nil, nil
+	}
+
+	pairTier, err := a.getLiquidityTier(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get liquidity tier: %w", err)
+	}
+
+	consensus := &SymbolConsensus{
+		Symbol:     symbol,
+		MarketData: latestData,
+		DataAge:    time.Since(latestData.Timestamp),
+		IsStale:    a.isDataStale(latestData),
+		Strategies: a.previewStrategies(ctx, latestData, pairTier),
+		Direction:  ConsensusDirectionNeutral,
+	}
+
+	for _, result := range consensus.Strategies {
+		switch result.Direction {
+		case entity.SignalTypeLong:
+			consensus.LongVotes++
+		case entity.SignalTypeShort:
+			consensus.ShortVotes++
+		default:
+			consensus.Abstained++
+		}
+	}
+
+	votes := consensus.LongVotes + consensus.ShortVotes
+	majority := consensus.LongVotes
+	switch {
+	case consensus.LongVotes > consensus.ShortVotes:
+		consensus.Direction = string(entity.SignalTypeLong)
+	case consensus.ShortVotes > consensus.LongVotes:
+		consensus.Direction = string(entity.SignalTypeShort)
+		majority = consensus.ShortVotes
+	}
+	if votes > 0 {
+		consensus.AgreementPct = decimal.NewFromInt(int64(majority)).
+			Div(decimal.NewFromInt(int64(votes))).
+			Mul(decimal.NewFromInt(100)).
+			Round(2)
+	}
+
+	return consensus, nil
+}
+
 // ValidatePendingSignals validates pending signals in confirmation period
 func (a *Analyzer) ValidatePendingSignals(ctx context.Context) error {
 	a.logger.Info("Validating pending signals")
@@ -224,6 +765,12 @@ func (a *Analyzer) ValidatePendingSignals(ctx context.Context) error {
 			continue
 		}
 
+		// Expire signals that could not be validated long after their confirmation window
+		if a.confirmationExpired(signal) {
+			a.invalidateSignal(ctx, signal, "confirmation window expired")
+			continue
+		}
+
 		// Get latest market data
 		latestData, err := mdRepo.GetLatestBySymbol(ctx, signal.Symbol)
 		if err != nil {
@@ -250,10 +797,23 @@ func (a *Analyzer) ValidatePendingSignals(ctx context.Context) error {
 			continue
 		}
 
-		// Validate confirmation (strategy-specific validation)
-		// For now, we'll just confirm the signal if it passed the confirmation period
-		// In a real implementation, you'd call strategy.ValidateConfirmation()
-		if err := signal.Confirm(); err != nil {
+		currentPrice := a.currentPrice(ctx, signal.Symbol, latestData)
+
+		// Invalidate if price already ran against the signal during confirmation
+		if exceeded, reason := a.exceedsAdverseMove(signal, currentPrice); exceeded {
+			a.invalidateSignal(ctx, signal, reason)
+			continue
+		}
+
+		// Invalidate if the strategy's entry conditions no longer hold
+		if validator, ok := strategy.(service.ConfirmationValidator); ok {
+			if valid, reason := validator.ValidateConfirmation(ctx, signal, latestData); !valid {
+				a.invalidateSignal(ctx, signal, reason)
+				continue
+			}
+		}
+
+		if err := signal.Confirm(currentPrice); err != nil {
 			a.logger.WithError(err).WithSignalID(signal.SignalID).Warn("Failed to confirm signal")
 			continue
 		}
@@ -273,6 +833,202 @@ func (a *Analyzer) ValidatePendingSignals(ctx context.Context) error {
 	return nil
 }
 
+// confirmationExpired checks if a pending signal is older than its confirmation end plus the grace period
+func (a *Analyzer) confirmationExpired(signal *entity.Signal) bool {
+	grace := a.globalConfig.ConfirmationExpiryGrace
+	if grace <= 0 {
+		return false
+	}
+	return time.Now().After(signal.ConfirmationEnd.Add(grace))
+}
+
+// invalidateSignal invalidates a pending signal with the given reason and persists it
+func (a *Analyzer) invalidateSignal(ctx context.Context, signal *entity.Signal, reason string) {
+	sigRepo := *a.signalRepo
+
+	if err := signal.Invalidate(reason); err != nil {
+		a.logger.WithError(err).WithSignalID(signal.SignalID).Warn("Failed to invalidate signal")
+		return
+	}
+
+	if err := sigRepo.Update(ctx, signal); err != nil {
+		a.logger.WithError(err).WithSignalID(signal.SignalID).Error("Failed to update signal")
+		return
+	}
+
+	a.logger.Info("Signal invalidated",
+		zap.String("signal_id", signal.SignalID),
+		zap.String("symbol", signal.Symbol),
+		zap.String("reason", reason),
+	)
+}
+
+// currentPrice fetches the current price of a symbol from Binance, falling back to
+// the latest collected price
+func (a *Analyzer) currentPrice(ctx context.Context, symbol string, latestData *entity.MarketData) decimal.Decimal {
+	if a.binanceClient == nil {
+		return latestData.Price
+	}
+
+	price, err := a.binanceClient.GetPrice(ctx, symbol)
+	if err != nil {
+		a.logger.WithError(err).WithSymbol(symbol).Warn("Failed to get current price, using latest collected price")
+		return latestData.Price
+	}
+
+	return decimal.NewFromFloat(price)
+}
+
+// exceedsAdverseMove checks if price moved against the signal by more than the configured
+// threshold since generation. Returns the invalidation reason when exceeded
+func (a *Analyzer) exceedsAdverseMove(signal *entity.Signal, currentPrice decimal.Decimal) (bool, string) {
+	if a.globalConfig.ConfirmationMaxAdverseMovePct <= 0 {
+		return false, ""
+	}
+
+	// Direction-adjusted change: negative means adverse
+	change := signal.CalculatePriceChange(currentPrice)
+	threshold := decimal.NewFromFloat(a.globalConfig.ConfirmationMaxAdverseMovePct)
+	if change.GreaterThanOrEqual(threshold.Neg()) {
+		return false, ""
+	}
+
+	return true, fmt.Sprintf("price moved %.2f%% against %s signal during confirmation (max: %.2f%%)",
+		change.Abs().InexactFloat64(),
+		signal.Type,
+		threshold.InexactFloat64(),
+	)
+}
+
+// lookback returns how far back the market data analyzed by a strategy reaches
+func (a *Analyzer) lookback(strategy service.Strategy) time.Duration {
+	hours := strategy.GetLookbackHours()
+	if hours <= 0 {
+		hours = a.globalConfig.AnalysisLookbackHours
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+// maxLookback returns the longest lookback of the enabled strategies, so a single query
+// fetches the data every strategy needs; the global lookback if no strategy is enabled
+// Aggregators work on other strategies' signals, not market data, and are left out
+func (a *Analyzer) maxLookback() time.Duration {
+	longest := time.Duration(0)
+	for _, strategy := range a.strategies {
+		if _, ok := strategy.(service.SignalAggregator); ok || !strategy.IsEnabled() {
+			continue
+		}
+		longest = max(longest, a.lookback(strategy))
+	}
+	if longest == 0 {
+		return time.Duration(a.globalConfig.AnalysisLookbackHours) * time.Hour
+	}
+	return longest
+}
+
+// dataSince returns the leading data points (ordered newest first) at or after since
+func dataSince(data []*entity.MarketData, since time.Time) []*entity.MarketData {
+	for i, point := range data {
+		if point.Timestamp.Before(since) {
+			return data[:i]
+		}
+	}
+	return data
+}
+
+// isDataStale checks if a market data point is older than the configured max age
+func (a *Analyzer) isDataStale(data *entity.MarketData) bool {
+	if a.globalConfig.MaxDataAge <= 0 {
+		return false
+	}
+
+	return time.Since(data.Timestamp) > a.globalConfig.MaxDataAge
+}
+
+// lockSymbol acquires the analysis lock of a symbol and returns its release function
+func (a *Analyzer) lockSymbol(symbol string) func() {
+	a.symbolLocksMu.Lock()
+	mu, ok := a.symbolLocks[symbol]
+	if !ok {
+		mu = &sync.Mutex{}
+		a.symbolLocks[symbol] = mu
+	}
+	a.symbolLocksMu.Unlock()
+
+	mu.Lock()
+	return mu.Unlock
+}
+
+// StrategiesForSymbol returns the keys of the enabled strategies that run on a pair of the
+// given liquidity tier, per their symbol filters and minimum tiers
+// Conditions depending on the latest market data (data quality, funding window) aren't applied
+func (a *Analyzer) StrategiesForSymbol(symbol string, tier entity.LiquidityTier) []string {
+	keys := []string{}
+	for _, strategy := range a.strategies {
+		if !strategy.IsEnabled() {
+			continue
+		}
+		if !strategy.GetSymbolFilter().Matches(symbol) || !tier.MeetsMinimum(strategy.GetMinLiquidityTier()) {
+			continue
+		}
+		keys = append(keys, strategy.Key())
+	}
+	return keys
+}
+
+// getLiquidityTier returns the stored liquidity tier of a symbol
+// Returns an unknown tier if the pair has not been classified yet
+func (a *Analyzer) getLiquidityTier(ctx context.Context, symbol string) (entity.LiquidityTier, error) {
+	pair, err := a.tradingPairRepo.GetBySymbol(ctx, symbol)
+	if err != nil {
+		return entity.LiquidityTierUnknown, err
+	}
+	if pair == nil {
+		return entity.LiquidityTierUnknown, nil
+	}
+	return pair.LiquidityTier, nil
+}
+
+// isNewlyListed checks if a symbol was listed more recently than MinListingAgeDays
+// The listing date comes from the exchange's onboard date; when that is unavailable,
+// the oldest stored market data point stands in for it
+func (a *Analyzer) isNewlyListed(ctx context.Context, symbol string) (bool, error) {
+	if a.globalConfig.MinListingAgeDays == 0 {
+		return false, nil
+	}
+
+	listedAt := a.listingDate(ctx, symbol)
+	if listedAt.IsZero() {
+		mdRepo := *a.marketDataRepo
+
+		earliest, err := mdRepo.GetEarliestBySymbol(ctx, symbol)
+		if err != nil {
+			return false, err
+		}
+		if earliest == nil {
+			return false, nil
+		}
+		listedAt = earliest.Timestamp
+	}
+
+	minAge := time.Duration(a.globalConfig.MinListingAgeDays) * 24 * time.Hour
+	return time.Since(listedAt) < minAge, nil
+}
+
+// listingDate returns the exchange onboard date of a symbol, or a zero time if unknown
+func (a *Analyzer) listingDate(ctx context.Context, symbol string) time.Time {
+	if a.binanceClient == nil {
+		return time.Time{}
+	}
+
+	listedAt, err := a.binanceClient.GetListingDate(ctx, symbol)
+	if err != nil {
+		a.logger.WithError(err).Debug("Listing date not available", zap.String("symbol", symbol))
+		return time.Time{}
+	}
+	return listedAt
+}
+
 // isInCooldown checks if a symbol is in cooldown period
 func (a *Analyzer) isInCooldown(ctx context.Context, symbol string) (bool, error) {
 	if a.globalConfig.SignalCooldownHours == 0 {
@@ -290,6 +1046,88 @@ func (a *Analyzer) isInCooldown(ctx context.Context, symbol string) (bool, error
 	return len(recentSignals) > 0, nil
 }
 
+// isInReentryCooldown checks if the previous signal for the same symbol, strategy and
+// direction closed too recently to re-enter
+func (a *Analyzer) isInReentryCooldown(ctx context.Context, signal *entity.Signal) (bool, error) {
+	if a.globalConfig.ReentryCooldownHours == 0 {
+		return false, nil
+	}
+
+	sigRepo := *a.signalRepo
+
+	latest, err := sigRepo.GetLatestClosedSignal(ctx, signal.Symbol, signal.StrategyName, signal.Type)
+	if err != nil {
+		return false, err
+	}
+	if latest == nil || latest.Outcome == nil {
+		return false, nil
+	}
+
+	cooldownEnd := latest.Outcome.ClosedAt.Add(time.Duration(a.globalConfig.ReentryCooldownHours) * time.Hour)
+	if time.Now().After(cooldownEnd) {
+		return false, nil
+	}
+
+	a.logger.Info("Skipping signal, previous signal closed within the re-entry cooldown",
+		zap.String("symbol", signal.Symbol),
+		zap.String("strategy", signal.StrategyName),
+		zap.String("type", string(signal.Type)),
+		zap.String("previous_signal_id", latest.Signal.SignalID),
+		zap.String("previous_outcome", latest.Outcome.Outcome),
+		zap.Time("cooldown_until", cooldownEnd),
+	)
+	return true, nil
+}
+
+// dailySignalCap returns how many signals a strategy may generate per day (0 = unlimited)
+// The strategy's own cap takes precedence over the global one
+func (a *Analyzer) dailySignalCap(strategy service.Strategy) int {
+	if limit := strategy.GetMaxSignalsPerDay(); limit > 0 {
+		return limit
+	}
+	return a.globalConfig.MaxSignalsPerStrategyPerDay
+}
+
+// reachedDailySignalCap checks if the strategy already generated its daily quota of signals
+// Days start at midnight in the configured timezone
+// Must be called with storeMu held, so concurrent runs can't both take the last slot
+func (a *Analyzer) reachedDailySignalCap(ctx context.Context, strategy service.Strategy, signal *entity.Signal) (bool, error) {
+	limit := a.dailySignalCap(strategy)
+	if limit == 0 {
+		return false, nil
+	}
+
+	sigRepo := *a.signalRepo
+
+	dayStart := startOfDay(time.Now(), a.location)
+	count, err := sigRepo.CountSignalsByStrategySince(ctx, signal.StrategyName, dayStart)
+	if err != nil {
+		return false, err
+	}
+	if count < limit {
+		return false, nil
+	}
+
+	a.logger.Info("Skipping signal, strategy reached its daily signal cap",
+		zap.String("symbol", signal.Symbol),
+		zap.String("strategy", signal.StrategyName),
+		zap.String("type", string(signal.Type)),
+		zap.Int("signals_today", count),
+		zap.Int("max_signals_per_day", limit),
+		zap.Time("resets_at", dayStart.AddDate(0, 0, 1)),
+	)
+	return true, nil
+}
+
+// startOfDay returns midnight of the day t falls on in loc
+func startOfDay(t time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	year, month, day := t.In(loc).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, loc)
+}
+
 // exceedsConcurrentLimit checks if symbol has reached concurrent signal limit
 func (a *Analyzer) exceedsConcurrentLimit(ctx context.Context, symbol string) (bool, error) {
 	if a.globalConfig.MaxConcurrentSignalsPerPair == 0 {
@@ -344,3 +1182,8 @@ func (a *Analyzer) GetAnalysisStatus(ctx context.Context) (map[string]interface{
 
 	return status, nil
 }
+
+// preFundingWindow returns how long before a funding settlement the strategy skips signals
+func preFundingWindow(strategy service.Strategy) time.Duration {
+	return time.Duration(strategy.GetAvoidPreFundingMinutes()) * time.Minute
+}
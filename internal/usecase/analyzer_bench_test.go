@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"ContractAnalysis/config"
+	"ContractAnalysis/internal/domain/entity"
+	"ContractAnalysis/internal/domain/repository"
+	"ContractAnalysis/internal/domain/service"
+)
+
+// analyzeAllFixture holds the repositories of an AnalyzeAll run over crowded markets
+type analyzeAllFixture struct {
+	strategies []service.Strategy
+	marketData *memMarketDataRepo
+	pairs      *memTradingPairRepo
+	cfg        config.GlobalStrategy
+}
+
+// newAnalyzeAllFixture creates symbols pairs, listed in scrambled order, whose latest data
+// points trigger both test strategies
+func newAnalyzeAllFixture(symbols int) *analyzeAllFixture {
+	f := &analyzeAllFixture{
+		strategies: []service.Strategy{
+			newTestMinorityStrategy(),
+			newNamedMinorityStrategy("Minority Strategy B"),
+		},
+		marketData: &memMarketDataRepo{bySymbol: make(map[string][]*entity.MarketData)},
+		pairs:      &memTradingPairRepo{},
+		cfg: config.GlobalStrategy{
+			AnalysisWorkers:       4,
+			AnalysisLookbackHours: 24,
+		},
+	}
+
+	now := time.Now()
+	for i := range symbols {
+		// Pair IDs run opposite to the symbol order, so workers see symbols out of order
+		symbol := fmt.Sprintf("SYM%04dUSDT", i)
+		f.pairs.pairs = append(f.pairs.pairs, &repository.TradingPair{
+			ID:       int64(symbols - i),
+			Symbol:   symbol,
+			IsActive: true,
+		})
+
+		longRatio := 75.0
+		if i%2 == 1 {
+			longRatio = 25
+		}
+		for age := 0; age < 12; age++ {
+			f.marketData.bySymbol[symbol] = append(f.marketData.bySymbol[symbol],
+				testMarketData(symbol, longRatio, now.Add(-time.Duration(age)*time.Hour-time.Minute)))
+		}
+	}
+	return f
+}
+
+// analyzer returns an analyzer over the fixture with an empty signal store
+func (f *analyzeAllFixture) analyzer() *Analyzer {
+	return newTestAnalyzer(f.strategies, newMemSignalRepo(), f.marketData, f.pairs, f.cfg, time.UTC)
+}
+
+func TestAnalyzeAllSortedBySymbol(t *testing.T) {
+	const symbols = 50
+	f := newAnalyzeAllFixture(symbols)
+
+	signals, err := f.analyzer().AnalyzeAll(context.Background())
+	if err != nil {
+		t.Fatalf("AnalyzeAll: %v", err)
+	}
+
+	if len(signals) != 2*symbols {
+		t.Fatalf("got %d signals, want %d", len(signals), 2*symbols)
+	}
+	if !sort.SliceIsSorted(signals, func(i, j int) bool { return signals[i].Symbol < signals[j].Symbol }) {
+		t.Error("signals are not sorted by symbol")
+	}
+
+	// Signals of one symbol keep the strategy order
+	for i := 0; i < len(signals); i += 2 {
+		if signals[i].Symbol != signals[i+1].Symbol ||
+			signals[i].StrategyName != f.strategies[0].Key() ||
+			signals[i+1].StrategyName != f.strategies[1].Key() {
+			t.Errorf("signals %d-%d = %s/%s, %s/%s, want both strategies in order for one symbol",
+				i, i+1, signals[i].Symbol, signals[i].StrategyName, signals[i+1].Symbol, signals[i+1].StrategyName)
+		}
+	}
+}
+
+func BenchmarkAnalyzeAll(b *testing.B) {
+	f := newAnalyzeAllFixture(200)
+	ctx := context.Background()
+
+	for b.Loop() {
+		b.StopTimer()
+		a := f.analyzer()
+		b.StartTimer()
+
+		if _, err := a.AnalyzeAll(ctx); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,269 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"ContractAnalysis/config"
+	"ContractAnalysis/internal/domain/entity"
+	"ContractAnalysis/internal/domain/repository"
+	"ContractAnalysis/internal/domain/service"
+
+	"github.com/shopspring/decimal"
+)
+
+// testMarketData returns a valid data point for symbol with the given long account ratio (%)
+func testMarketData(symbol string, longRatio float64, at time.Time) *entity.MarketData {
+	long := decimal.NewFromFloat(longRatio)
+	short := decimal.NewFromInt(100).Sub(long)
+	return &entity.MarketData{
+		Symbol:             symbol,
+		Timestamp:          at,
+		LongAccountRatio:   long,
+		ShortAccountRatio:  short,
+		LongPositionRatio:  long,
+		ShortPositionRatio: short,
+		Price:              decimal.NewFromInt(100),
+		OpenInterest:       decimal.NewFromInt(1_000_000),
+		CreatedAt:          at,
+	}
+}
+
+func testSignal(strategy service.Strategy, symbol string, signalType entity.SignalType) *entity.Signal {
+	return entity.NewSignal(symbol, signalType, strategy.Name(), testMarketData(symbol, 75, time.Now()),
+		strategy.GetConfirmationHours(), "test", map[string]interface{}{})
+}
+
+func TestStoreSignalConcurrentDuplicates(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemSignalRepo()
+	strategy := newTestMinorityStrategy()
+	a := newTestAnalyzer([]service.Strategy{strategy}, repo, &memMarketDataRepo{}, nil, config.GlobalStrategy{}, time.UTC)
+
+	const workers = 32
+	var (
+		created  atomic.Int32
+		wg       sync.WaitGroup
+		start    = make(chan struct{})
+		returned = make([]*entity.Signal, workers)
+	)
+	for i := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			stored, ok := a.storeSignal(ctx, strategy, testSignal(strategy, "BTCUSDT", entity.SignalTypeShort))
+			if ok {
+				created.Add(1)
+			}
+			returned[i] = stored
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	if got := created.Load(); got != 1 {
+		t.Fatalf("created %d signals, want 1", got)
+	}
+	active := repo.activeSignals()
+	if len(active) != 1 {
+		t.Fatalf("stored %d active signals, want 1", len(active))
+	}
+	for i, stored := range returned {
+		if stored == nil || stored.SignalID != active[0].SignalID {
+			t.Errorf("worker %d got %v, want the stored signal %s", i, stored, active[0].SignalID)
+		}
+	}
+}
+
+func TestStoreSignalAllowsOtherDirectionAndStrategy(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemSignalRepo()
+	strategy := newTestMinorityStrategy()
+	a := newTestAnalyzer([]service.Strategy{strategy}, repo, &memMarketDataRepo{}, nil, config.GlobalStrategy{}, time.UTC)
+
+	if _, ok := a.storeSignal(ctx, strategy, testSignal(strategy, "BTCUSDT", entity.SignalTypeShort)); !ok {
+		t.Fatal("first SHORT signal was not created")
+	}
+	if _, ok := a.storeSignal(ctx, strategy, testSignal(strategy, "BTCUSDT", entity.SignalTypeLong)); !ok {
+		t.Error("LONG signal was blocked by the active SHORT signal")
+	}
+	if _, ok := a.storeSignal(ctx, strategy, testSignal(strategy, "ETHUSDT", entity.SignalTypeShort)); !ok {
+		t.Error("ETHUSDT signal was blocked by the active BTCUSDT signal")
+	}
+	if _, ok := a.storeSignal(ctx, strategy, testSignal(strategy, "BTCUSDT", entity.SignalTypeShort)); ok {
+		t.Error("duplicate SHORT signal was created")
+	}
+}
+
+func TestStoreSignalInitialTracking(t *testing.T) {
+	tests := []struct {
+		name          string
+		trackingErr   error
+		wantCreated   bool
+		wantSignals   int
+		wantTrackings int
+	}{
+		{"signal and tracking stored together", nil, true, 1, 1},
+		{"failed tracking rolls back the signal", errors.New("insert failed"), false, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMemSignalRepo()
+			repo.trackingErr = tt.trackingErr
+			strategy := newTestMinorityStrategy()
+			a := newTestAnalyzer([]service.Strategy{strategy}, repo, &memMarketDataRepo{}, nil,
+				config.GlobalStrategy{InitialTracking: true}, time.UTC)
+
+			signal := testSignal(strategy, "BTCUSDT", entity.SignalTypeLong)
+			if _, created := a.storeSignal(context.Background(), strategy, signal); created != tt.wantCreated {
+				t.Fatalf("created = %v, want %v", created, tt.wantCreated)
+			}
+
+			if len(repo.signals) != tt.wantSignals || len(repo.trackings) != tt.wantTrackings {
+				t.Fatalf("stored %d signals and %d trackings, want %d and %d",
+					len(repo.signals), len(repo.trackings), tt.wantSignals, tt.wantTrackings)
+			}
+			if tt.wantTrackings > 0 {
+				tracking := repo.trackings[0]
+				if tracking.SignalID != signal.SignalID || !tracking.CurrentPrice.Equal(signal.PriceAtSignal) {
+					t.Errorf("tracking = %s at %s, want %s at the signal price %s",
+						tracking.SignalID, tracking.CurrentPrice, signal.SignalID, signal.PriceAtSignal)
+				}
+			}
+		})
+	}
+}
+
+func TestStartOfDay(t *testing.T) {
+	shanghai := time.FixedZone("UTC+8", 8*3600)
+	newYork := time.FixedZone("UTC-5", -5*3600)
+
+	tests := []struct {
+		name string
+		at   time.Time
+		loc  *time.Location
+		want time.Time
+	}{
+		{"UTC", time.Date(2024, 3, 10, 23, 59, 0, 0, time.UTC), time.UTC, time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)},
+		{"nil location is UTC", time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC), nil, time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)},
+		{"east, just before local midnight", time.Date(2024, 3, 10, 15, 59, 0, 0, time.UTC), shanghai, time.Date(2024, 3, 9, 16, 0, 0, 0, time.UTC)},
+		{"east, just after local midnight", time.Date(2024, 3, 10, 16, 1, 0, 0, time.UTC), shanghai, time.Date(2024, 3, 10, 16, 0, 0, 0, time.UTC)},
+		{"west, local day behind UTC", time.Date(2024, 3, 11, 3, 0, 0, 0, time.UTC), newYork, time.Date(2024, 3, 10, 5, 0, 0, 0, time.UTC)},
+		{"west, just after local midnight", time.Date(2024, 3, 11, 5, 0, 0, 0, time.UTC), newYork, time.Date(2024, 3, 11, 5, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := startOfDay(tt.at, tt.loc); !got.Equal(tt.want) {
+				t.Errorf("startOfDay(%s) = %s, want %s", tt.at, got.UTC(), tt.want)
+			}
+		})
+	}
+}
+
+func TestReachedDailySignalCap(t *testing.T) {
+	ctx := context.Background()
+	loc := time.FixedZone("UTC+8", 8*3600)
+	strategy := newTestMinorityStrategy()
+	repo := newMemSignalRepo()
+	a := newTestAnalyzer([]service.Strategy{strategy}, repo, &memMarketDataRepo{}, nil,
+		config.GlobalStrategy{MaxSignalsPerStrategyPerDay: 2}, loc)
+
+	dayStart := startOfDay(time.Now(), loc)
+	store := func(generatedAt time.Time) {
+		signal := testSignal(strategy, "BTCUSDT", entity.SignalTypeLong)
+		signal.GeneratedAt = generatedAt
+		signal.Status = entity.SignalStatusClosed
+		if err := repo.Create(ctx, signal); err != nil {
+			t.Fatal(err)
+		}
+	}
+	check := func(want bool) {
+		t.Helper()
+		got, err := a.reachedDailySignalCap(ctx, strategy, testSignal(strategy, "ETHUSDT", entity.SignalTypeLong))
+		if err != nil {
+			t.Fatalf("reachedDailySignalCap: %v", err)
+		}
+		if got != want {
+			t.Errorf("reachedDailySignalCap = %v, want %v", got, want)
+		}
+	}
+
+	// A signal just before local midnight belongs to the previous day
+	store(dayStart.Add(-time.Minute))
+	store(dayStart)
+	check(false)
+
+	store(dayStart.Add(time.Minute))
+	check(true)
+
+	for _, since := range repo.since {
+		if !since.Equal(dayStart) {
+			t.Errorf("counted signals since %s, want local midnight %s", since, dayStart)
+		}
+	}
+}
+
+func TestIsInReentryCooldown(t *testing.T) {
+	ctx := context.Background()
+	strategy := newTestMinorityStrategy()
+
+	closedSignal := func(closedAgo time.Duration) *repository.SignalWithOutcome {
+		signal := testSignal(strategy, "BTCUSDT", entity.SignalTypeShort)
+		signal.Status = entity.SignalStatusClosed
+		return &repository.SignalWithOutcome{
+			Signal: signal,
+			Outcome: &entity.SignalOutcome{
+				SignalID: signal.SignalID,
+				Outcome:  string(entity.OutcomeLoss),
+				ClosedAt: time.Now().Add(-closedAgo),
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		cooldownHours int
+		latest        *repository.SignalWithOutcome
+		signalType    entity.SignalType
+		want          bool
+	}{
+		{"disabled", 0, closedSignal(time.Minute), entity.SignalTypeShort, false},
+		{"no closed signal", 4, nil, entity.SignalTypeShort, false},
+		{"closed within cooldown", 4, closedSignal(time.Hour), entity.SignalTypeShort, true},
+		{"closed just before the cooldown ends", 4, closedSignal(4*time.Hour - time.Minute), entity.SignalTypeShort, true},
+		{"cooldown over", 4, closedSignal(4*time.Hour + time.Minute), entity.SignalTypeShort, false},
+		{"other direction", 4, closedSignal(time.Hour), entity.SignalTypeLong, false},
+		{"closed signal without outcome", 4, &repository.SignalWithOutcome{Signal: closedSignal(time.Hour).Signal}, entity.SignalTypeShort, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMemSignalRepo()
+			if tt.latest != nil {
+				repo.closed[closedKey("BTCUSDT", strategy.Name(), entity.SignalTypeShort)] = tt.latest
+			}
+			a := newTestAnalyzer([]service.Strategy{strategy}, repo, &memMarketDataRepo{}, nil,
+				config.GlobalStrategy{ReentryCooldownHours: tt.cooldownHours}, time.UTC)
+
+			signal := testSignal(strategy, "BTCUSDT", tt.signalType)
+			got, err := a.isInReentryCooldown(ctx, signal)
+			if err != nil {
+				t.Fatalf("isInReentryCooldown: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("isInReentryCooldown = %v, want %v", got, tt.want)
+			}
+
+			// A signal in cooldown is not stored
+			if _, created := a.storeSignal(ctx, strategy, signal); created == tt.want {
+				t.Errorf("storeSignal created = %v, want %v", created, !tt.want)
+			}
+		})
+	}
+}
@@ -2,7 +2,9 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"ContractAnalysis/config"
@@ -10,6 +12,8 @@ import (
 	"ContractAnalysis/internal/domain/repository"
 	"ContractAnalysis/internal/infrastructure/binance"
 	"ContractAnalysis/internal/infrastructure/logger"
+	"ContractAnalysis/internal/infrastructure/notification"
+	"ContractAnalysis/pkg/utils"
 
 	"github.com/shopspring/decimal"
 	"go.uber.org/zap"
@@ -20,23 +24,45 @@ type Collector struct {
 	binanceClient   *binance.Client
 	marketDataRepo  *repository.MarketDataRepository
 	tradingPairRepo repository.TradingPairRepository
+	notifier        *notification.NotificationDispatcher
 	config          config.CollectionConfig
 	logger          *logger.Logger
+
+	// failures counts consecutive failed collections per symbol to deactivate perma-failing pairs
+	failuresMu sync.Mutex
+	failures   map[string]int
+
+	// health tracks the success rate across collection runs
+	healthMu sync.Mutex
+	health   collectionHealth
+}
+
+// collectionHealth holds the exponentially smoothed collection success rate
+type collectionHealth struct {
+	runs         int
+	lastRate     float64
+	smoothedRate float64
+	degradedRuns int  // Consecutive runs with the smoothed rate below the alert threshold
+	alerted      bool // Whether the current degradation has already been alerted
 }
 
 // NewCollector creates a new collector
+// notifier may be nil, in which case no success rate alerts are sent
 func NewCollector(
 	binanceClient *binance.Client,
 	marketDataRepo *repository.MarketDataRepository,
 	tradingPairRepo repository.TradingPairRepository,
 	cfg config.CollectionConfig,
+	notifier *notification.NotificationDispatcher,
 ) *Collector {
 	return &Collector{
 		binanceClient:   binanceClient,
 		marketDataRepo:  marketDataRepo,
 		tradingPairRepo: tradingPairRepo,
+		notifier:        notifier,
 		config:          cfg,
 		logger:          logger.WithComponent("collector"),
+		failures:        make(map[string]int),
 	}
 }
 
@@ -50,8 +76,8 @@ func (c *Collector) CollectAll(ctx context.Context) error {
 	c.logger.Info("Starting data collection")
 	startTime := time.Now()
 
-	// Get all USDT futures pairs from Binance
-	allPairs, err := c.binanceClient.GetAllUSDTFuturesPairs(ctx)
+	// Get all futures pairs for the configured margin mode from Binance
+	allPairs, err := c.binanceClient.GetFuturesPairs(ctx, c.config.PairFilter.MarginMode)
 	if err != nil {
 		return fmt.Errorf("failed to get trading pairs: %w", err)
 	}
@@ -69,35 +95,39 @@ func (c *Collector) CollectAll(ctx context.Context) error {
 		// Continue even if this fails
 	}
 
+	// Skip pairs deactivated after repeated failures
+	pairs = c.excludeInactivePairs(ctx, pairs)
+
 	// Collect market data for each pair
-	collected := 0
-	failed := 0
-	failedSymbols := make([]string, 0)
+	run := c.collectSymbols(ctx, pairs, c.collectForSymbol)
+	collected, failed, skipped := run.collected, len(run.failedSymbols), len(run.skippedSymbols)
+	failedSymbols, skippedSymbols := run.failedSymbols, run.skippedSymbols
 
-	for _, symbol := range pairs {
-		if err := c.collectForSymbol(ctx, symbol); err != nil {
-			c.logger.WithError(err).WithSymbol(symbol).Warn("Failed to collect data for symbol")
-			failed++
-			failedSymbols = append(failedSymbols, symbol)
-			continue
-		}
-		collected++
+	duration := time.Since(startTime)
+	totalPairs := len(pairs)
 
-		// Small delay to avoid rate limiting
-		time.Sleep(100 * time.Millisecond)
+	if run.interrupted {
+		c.logger.WithError(ctx.Err()).Warn("Data collection interrupted",
+			zap.Int("not_collected", totalPairs-collected-failed-skipped),
+		)
+		totalPairs = collected + failed + skipped
 	}
 
-	duration := time.Since(startTime)
-	totalPairs := len(pairs)
-	successRate := float64(collected) / float64(totalPairs) * 100
+	// Skipped symbols had nothing to collect, so they don't count against the success rate
+	successRate := 100.0
+	if attempted := totalPairs - skipped; attempted > 0 {
+		successRate = float64(collected) / float64(attempted) * 100
+	}
 
 	c.logger.Info("Data collection completed",
 		zap.Int("total_pairs", totalPairs),
 		zap.Int("collected", collected),
 		zap.Int("failed", failed),
+		zap.Int("skipped", skipped),
 		zap.Float64("success_rate", successRate),
 		zap.Duration("duration", duration),
 		zap.Strings("failed_symbols", failedSymbols),
+		zap.Strings("skipped_symbols", skippedSymbols),
 	)
 
 	// Warning if success rate is low
@@ -118,57 +148,259 @@ func (c *Collector) CollectAll(ctx context.Context) error {
 		)
 	}
 
+	c.recordSuccessRate(ctx, successRate)
+
 	if failed > 0 && collected == 0 {
 		return fmt.Errorf("failed to collect data for all symbols")
 	}
 
+	// Refresh liquidity tiers from the freshly collected data
+	if c.config.LiquidityTiers.Enabled {
+		if err := c.refreshLiquidityTiers(ctx); err != nil {
+			c.logger.WithError(err).Warn("Failed to refresh liquidity tiers")
+			// Don't fail the collection if tier refresh fails
+		}
+	}
+
 	return nil
 }
 
-// CollectForSymbol collects market data for a specific symbol
-func (c *Collector) CollectForSymbol(ctx context.Context, symbol string) error {
-	return c.collectForSymbol(ctx, symbol)
+// RefreshLiquidityTiers reclassifies all trading pairs by their latest volume and open interest
+func (c *Collector) RefreshLiquidityTiers(ctx context.Context) error {
+	return c.refreshLiquidityTiers(ctx)
 }
 
-// collectForSymbol collects and stores market data for a symbol
-func (c *Collector) collectForSymbol(ctx context.Context, symbol string) error {
-	c.logger.Debug("Collecting data for symbol", zap.String("symbol", symbol))
+// refreshLiquidityTiers classifies each pair with its latest market data and stores changed tiers
+func (c *Collector) refreshLiquidityTiers(ctx context.Context) error {
+	repo := *c.marketDataRepo
 
-	// Fetch market data from Binance with retry
-	var marketData *binance.MarketData
-	var err error
+	latestData, err := repo.GetLatestForAllSymbols(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get latest market data: %w", err)
+	}
+
+	pairs, err := c.tradingPairRepo.GetAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get trading pairs: %w", err)
+	}
+
+	currentTiers := make(map[string]entity.LiquidityTier, len(pairs))
+	for _, pair := range pairs {
+		currentTiers[pair.Symbol] = pair.LiquidityTier
+	}
+
+	thresholds := entity.LiquidityThresholds{
+		HighMinVolume24h:      decimal.NewFromFloat(c.config.LiquidityTiers.HighMinVolume24h),
+		HighMinOpenInterest:   decimal.NewFromFloat(c.config.LiquidityTiers.HighMinOpenInterest),
+		MediumMinVolume24h:    decimal.NewFromFloat(c.config.LiquidityTiers.MediumMinVolume24h),
+		MediumMinOpenInterest: decimal.NewFromFloat(c.config.LiquidityTiers.MediumMinOpenInterest),
+	}
+
+	updated := 0
+	tierCounts := make(map[entity.LiquidityTier]int)
+	for _, data := range latestData {
+		current, exists := currentTiers[data.Symbol]
+		if !exists {
+			continue
+		}
+
+		tier := entity.ClassifyLiquidityTier(data.Volume24h, data.OpenInterest, thresholds)
+		tierCounts[tier]++
+
+		if tier == current {
+			continue
+		}
+
+		if err := c.tradingPairRepo.SetLiquidityTier(ctx, data.Symbol, tier); err != nil {
+			c.logger.WithError(err).WithSymbol(data.Symbol).Warn("Failed to update liquidity tier")
+			continue
+		}
+		updated++
+	}
+
+	c.logger.Info("Liquidity tiers refreshed",
+		zap.Int("updated", updated),
+		zap.Int("high", tierCounts[entity.LiquidityTierHigh]),
+		zap.Int("medium", tierCounts[entity.LiquidityTierMedium]),
+		zap.Int("low", tierCounts[entity.LiquidityTierLow]),
+	)
+
+	return nil
+}
+
+// excludeInactivePairs removes pairs marked inactive in the database
+// Returns the input unchanged if the active state cannot be loaded
+func (c *Collector) excludeInactivePairs(ctx context.Context, symbols []string) []string {
+	storedPairs, err := c.tradingPairRepo.GetAll(ctx)
+	if err != nil {
+		c.logger.WithError(err).Warn("Failed to load trading pair states, collecting all pairs")
+		return symbols
+	}
+
+	inactive := make(map[string]bool)
+	for _, pair := range storedPairs {
+		if !pair.IsActive {
+			inactive[pair.Symbol] = true
+		}
+	}
+
+	if len(inactive) == 0 {
+		return symbols
+	}
+
+	active := make([]string, 0, len(symbols))
+	for _, symbol := range symbols {
+		if !inactive[symbol] {
+			active = append(active, symbol)
+		}
+	}
+
+	c.logger.Debug("Skipping inactive trading pairs", zap.Int("count", len(symbols)-len(active)))
+	return active
+}
+
+// collectionRun summarizes one pass of collectSymbols
+type collectionRun struct {
+	collected      int
+	failedSymbols  []string
+	skippedSymbols []string
+	interrupted    bool // ctx was done before every symbol was collected
+}
 
-	for attempt := 0; attempt < c.config.Retry.MaxAttempts; attempt++ {
-		marketData, err = c.binanceClient.GetMarketData(ctx, symbol)
-		if err == nil {
+// collectSymbols collects each symbol in turn and counts pair failures towards deactivation
+// It stops early once ctx is done, leaving the remaining symbols uncounted
+func (c *Collector) collectSymbols(ctx context.Context, symbols []string, collect func(context.Context, string) error) collectionRun {
+	run := collectionRun{
+		failedSymbols:  make([]string, 0),
+		skippedSymbols: make([]string, 0),
+	}
+
+	for _, symbol := range symbols {
+		if ctx.Err() != nil {
+			run.interrupted = true
 			break
 		}
 
-		if attempt < c.config.Retry.MaxAttempts-1 {
-			delay := c.config.Retry.Delay * time.Duration(attempt+1)
-			c.logger.Debug("Retrying after error",
-				zap.String("symbol", symbol),
-				zap.Int("attempt", attempt+1),
-				zap.String("delay", delay.String()),
-			)
-			time.Sleep(delay)
+		if err := collect(ctx, symbol); err != nil {
+			// Some pairs have no data on certain endpoints, which is not a collection failure
+			if errors.Is(err, binance.ErrNoData) {
+				c.logger.WithError(err).WithSymbol(symbol).Debug("No data available for symbol, skipping")
+				run.skippedSymbols = append(run.skippedSymbols, symbol)
+			} else {
+				c.logger.WithError(err).WithSymbol(symbol).Warn("Failed to collect data for symbol")
+				run.failedSymbols = append(run.failedSymbols, symbol)
+			}
+			if isPairFailure(err) {
+				c.recordFailure(ctx, symbol, err)
+			}
+			continue
+		}
+		run.collected++
+		c.resetFailures(symbol)
+
+		// Small delay to avoid rate limiting
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return run
+}
+
+// isPairFailure reports whether a collection error says something about the symbol itself
+// An open breaker, rate limiting, rejected credentials and a cancelled or timed out run
+// fail every symbol alike, so they don't count towards deactivating the pair
+func isPairFailure(err error) bool {
+	return !errors.Is(err, binance.ErrCircuitOpen) &&
+		!errors.Is(err, binance.ErrRateLimited) &&
+		!errors.Is(err, binance.ErrInvalidCredentials) &&
+		!errors.Is(err, context.Canceled) &&
+		!errors.Is(err, context.DeadlineExceeded)
+}
+
+// recordFailure counts a failed collection for a symbol and deactivates the pair once
+// it reaches the configured number of consecutive failures
+func (c *Collector) recordFailure(ctx context.Context, symbol string, cause error) {
+	if c.config.MaxConsecutiveFailures <= 0 {
+		return
+	}
+
+	c.failuresMu.Lock()
+	c.failures[symbol]++
+	count := c.failures[symbol]
+	c.failuresMu.Unlock()
+
+	if count < c.config.MaxConsecutiveFailures {
+		return
+	}
+
+	if err := c.tradingPairRepo.SetActive(ctx, symbol, false); err != nil {
+		c.logger.WithError(err).WithSymbol(symbol).Error("Failed to deactivate repeatedly failing trading pair")
+		return
+	}
+
+	c.resetFailures(symbol)
+	c.logger.WithSymbol(symbol).Warn("Deactivated trading pair after repeated collection failures",
+		zap.Int("consecutive_failures", count),
+		zap.String("last_error", cause.Error()),
+	)
+}
+
+// resetFailures clears the consecutive failure count of a symbol
+func (c *Collector) resetFailures(symbol string) {
+	c.failuresMu.Lock()
+	defer c.failuresMu.Unlock()
+
+	delete(c.failures, symbol)
+}
+
+// ReactivatePair marks a trading pair active again so it is collected and analyzed
+// Returns nil if the pair does not exist
+func (c *Collector) ReactivatePair(ctx context.Context, symbol string) (*repository.TradingPair, error) {
+	pair, err := c.tradingPairRepo.GetBySymbol(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trading pair: %w", err)
+	}
+	if pair == nil {
+		return nil, nil
+	}
+
+	if !pair.IsActive {
+		if err := c.tradingPairRepo.SetActive(ctx, symbol, true); err != nil {
+			return nil, fmt.Errorf("failed to activate trading pair: %w", err)
 		}
+		pair.IsActive = true
+
+		c.logger.WithSymbol(symbol).Info("Trading pair reactivated")
 	}
 
+	c.resetFailures(symbol)
+
+	return pair, nil
+}
+
+// CollectForSymbol collects market data for a specific symbol
+func (c *Collector) CollectForSymbol(ctx context.Context, symbol string) error {
+	return c.collectForSymbol(ctx, symbol)
+}
+
+// collectForSymbol collects and stores market data for a symbol
+func (c *Collector) collectForSymbol(ctx context.Context, symbol string) error {
+	c.logger.Debug("Collecting data for symbol", zap.String("symbol", symbol))
+
+	entity, err := c.fetchMarketData(ctx, symbol)
 	if err != nil {
-		return fmt.Errorf("failed to fetch market data after %d attempts: %w", c.config.Retry.MaxAttempts, err)
+		return err
 	}
 
-	// Convert to domain entity
-	entity := c.convertToEntity(marketData)
+	repo := *c.marketDataRepo
 
-	// Validate entity
-	if err := entity.Validate(); err != nil {
-		return fmt.Errorf("invalid market data: %w", err)
+	// Compare open interest against the previous stored data point
+	previous, err := repo.GetLatestBySymbol(ctx, symbol)
+	if err != nil {
+		c.logger.WithError(err).WithSymbol(symbol).Warn("Failed to get previous market data, skipping open interest change")
 	}
+	entity.SetOpenInterestChange(previous)
 
 	// Store in database
-	repo := *c.marketDataRepo
 	if err := repo.Create(ctx, entity); err != nil {
 		return fmt.Errorf("failed to store market data: %w", err)
 	}
@@ -180,6 +412,46 @@ func (c *Collector) collectForSymbol(ctx context.Context, symbol string) error {
 	return nil
 }
 
+// FetchForSymbol fetches and validates the current market data of a symbol without storing it
+// The open interest change is left unset since no previous data point is consulted
+func (c *Collector) FetchForSymbol(ctx context.Context, symbol string) (*entity.MarketData, error) {
+	return c.fetchMarketData(ctx, symbol)
+}
+
+// fetchMarketData fetches market data from Binance with retry and converts it to a validated entity
+func (c *Collector) fetchMarketData(ctx context.Context, symbol string) (*entity.MarketData, error) {
+	// Fetch market data from Binance with retry
+	var marketData *binance.MarketData
+	attempts, err := utils.Retry(ctx, utils.RetryPolicy(c.config.Retry), binance.IsRetryable, func(attempt int) error {
+		if attempt > 1 {
+			c.logger.Debug("Retrying after error",
+				zap.String("symbol", symbol),
+				zap.Int("attempt", attempt),
+			)
+		}
+
+		var fetchErr error
+		marketData, fetchErr = c.binanceClient.GetMarketData(ctx, symbol)
+		return fetchErr
+	})
+	if err != nil {
+		if !binance.IsRetryable(err) {
+			return nil, fmt.Errorf("failed to fetch market data: %w", err)
+		}
+		return nil, fmt.Errorf("failed to fetch market data after %d attempts: %w", attempts, err)
+	}
+
+	// Convert to domain entity
+	entity := c.convertToEntity(marketData)
+
+	// Validate entity
+	if err := entity.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid market data: %w", err)
+	}
+
+	return entity, nil
+}
+
 // updateTradingPairs updates the trading pairs in the database
 func (c *Collector) updateTradingPairs(ctx context.Context, symbols []string) error {
 	// Get existing pairs
@@ -189,8 +461,10 @@ func (c *Collector) updateTradingPairs(ctx context.Context, symbols []string) er
 	}
 
 	existingMap := make(map[string]bool)
+	pairsBySymbol := make(map[string]*repository.TradingPair, len(existingPairs))
 	for _, pair := range existingPairs {
 		existingMap[pair.Symbol] = true
+		pairsBySymbol[pair.Symbol] = pair
 	}
 
 	// Create new pairs
@@ -211,6 +485,44 @@ func (c *Collector) updateTradingPairs(ctx context.Context, symbols []string) er
 		c.logger.Info("Created new trading pairs", zap.Int("count", len(newPairs)))
 	}
 
+	// Store the exchange tick size per symbol so trade levels can be rounded
+	if err := c.updatePrecisions(ctx, symbols, pairsBySymbol); err != nil {
+		c.logger.WithError(err).Warn("Failed to update trading pair precisions")
+	}
+
+	return nil
+}
+
+// updatePrecisions stores changed exchange price precision rules for the given symbols
+func (c *Collector) updatePrecisions(ctx context.Context, symbols []string, pairsBySymbol map[string]*repository.TradingPair) error {
+	precisions, err := c.binanceClient.GetSymbolPrecisions(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get symbol precisions: %w", err)
+	}
+
+	updated := 0
+	for _, symbol := range symbols {
+		precision, ok := precisions[symbol]
+		if !ok {
+			continue
+		}
+
+		if pair, exists := pairsBySymbol[symbol]; exists &&
+			pair.TickSize.Equal(precision.TickSize) && pair.PricePrecision == precision.PricePrecision {
+			continue
+		}
+
+		if err := c.tradingPairRepo.SetPrecision(ctx, symbol, precision.TickSize, precision.PricePrecision); err != nil {
+			c.logger.WithError(err).WithSymbol(symbol).Warn("Failed to update precision")
+			continue
+		}
+		updated++
+	}
+
+	if updated > 0 {
+		c.logger.Info("Updated trading pair precisions", zap.Int("count", updated))
+	}
+
 	return nil
 }
 
@@ -250,11 +562,103 @@ func (c *Collector) convertToEntity(data *binance.MarketData) *entity.MarketData
 		Volume24h:              decimal.NewFromFloat(data.Volume24h),
 		OpenInterest:           decimal.NewFromFloat(data.OpenInterest),
 		FundingRate:            decimal.NewFromFloat(data.FundingRate),
+		TakerBuySellRatio:      decimal.NewFromFloat(data.TakerBuySellRatio),
+		NextFundingTime:        nextFundingTime(data.NextFundingTime),
+	}
+}
+
+// nextFundingTime converts a next funding settlement time, treating the zero time as unknown
+func nextFundingTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// recordSuccessRate folds a run's success rate into the smoothed rate and alerts once the
+// smoothed rate has stayed below the configured threshold for enough consecutive runs
+// Each degradation is alerted once; the alert re-arms when the smoothed rate recovers
+func (c *Collector) recordSuccessRate(ctx context.Context, rate float64) {
+	alertCfg := c.config.SuccessRateAlert
+
+	c.healthMu.Lock()
+	health := &c.health
+	if health.runs == 0 {
+		health.smoothedRate = rate
+	} else {
+		factor := alertCfg.SmoothingFactor
+		if factor <= 0 || factor > 1 {
+			factor = 1
+		}
+		health.smoothedRate = factor*rate + (1-factor)*health.smoothedRate
+	}
+	health.runs++
+	health.lastRate = rate
+
+	var alert, recovered bool
+	if alertCfg.Enabled && health.smoothedRate < alertCfg.Threshold {
+		health.degradedRuns++
+		if health.degradedRuns >= alertCfg.ConsecutiveRuns && !health.alerted {
+			health.alerted = true
+			alert = true
+		}
+	} else {
+		recovered = health.alerted
+		health.degradedRuns = 0
+		health.alerted = false
+	}
+	smoothedRate := health.smoothedRate
+	degradedRuns := health.degradedRuns
+	c.healthMu.Unlock()
+
+	if recovered {
+		c.logger.Info("Data collection success rate recovered",
+			zap.Float64("smoothed_success_rate", smoothedRate),
+			zap.Float64("threshold", alertCfg.Threshold),
+		)
+	}
+
+	if !alert {
+		return
+	}
+
+	c.logger.Error("Sustained low data collection success rate",
+		zap.Float64("smoothed_success_rate", smoothedRate),
+		zap.Float64("last_success_rate", rate),
+		zap.Float64("threshold", alertCfg.Threshold),
+		zap.Int("consecutive_runs", degradedRuns),
+	)
+
+	if c.notifier != nil {
+		message := fmt.Sprintf("Data collection success rate degraded: smoothed rate %.1f%% has been below %.1f%% for %d consecutive runs",
+			smoothedRate, alertCfg.Threshold, degradedRuns)
+		if err := c.notifier.NotifySystemError(ctx, message, map[string]interface{}{
+			"smoothed_success_rate": smoothedRate,
+			"last_success_rate":     rate,
+			"threshold":             alertCfg.Threshold,
+			"consecutive_runs":      degradedRuns,
+		}); err != nil {
+			c.logger.WithError(err).Warn("Failed to send success rate alert")
+		}
 	}
 }
 
+// CollectionStatus represents the current state of data collection
+type CollectionStatus struct {
+	Enabled          bool
+	TotalDataPoints  int64
+	SymbolsTracked   int
+	LatestCollection time.Time
+
+	// Success rates of the collection runs since startup, nil before the first run completes
+	RunsSinceStartup    int
+	LastSuccessRate     *float64
+	SmoothedSuccessRate *float64
+	DegradedRuns        int // Consecutive runs with the smoothed rate below the alert threshold
+}
+
 // GetCollectionStatus returns the current collection status
-func (c *Collector) GetCollectionStatus(ctx context.Context) (map[string]interface{}, error) {
+func (c *Collector) GetCollectionStatus(ctx context.Context) (*CollectionStatus, error) {
 	repo := *c.marketDataRepo
 
 	count, err := repo.Count(ctx)
@@ -273,12 +677,23 @@ func (c *Collector) GetCollectionStatus(ctx context.Context) (map[string]interfa
 		latestTimestamp = latestData[0].Timestamp
 	}
 
-	status := map[string]interface{}{
-		"enabled":           c.config.Enabled,
-		"total_data_points": count,
-		"symbols_tracked":   len(latestData),
-		"latest_collection": latestTimestamp,
+	status := &CollectionStatus{
+		Enabled:          c.config.Enabled,
+		TotalDataPoints:  count,
+		SymbolsTracked:   len(latestData),
+		LatestCollection: latestTimestamp,
+	}
+
+	c.healthMu.Lock()
+	if c.health.runs > 0 {
+		lastRate := c.health.lastRate
+		smoothedRate := c.health.smoothedRate
+		status.RunsSinceStartup = c.health.runs
+		status.LastSuccessRate = &lastRate
+		status.SmoothedSuccessRate = &smoothedRate
+		status.DegradedRuns = c.health.degradedRuns
 	}
+	c.healthMu.Unlock()
 
 	return status, nil
 }
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"ContractAnalysis/config"
+	"ContractAnalysis/internal/domain/repository"
+	"ContractAnalysis/internal/infrastructure/binance"
+)
+
+func TestCollectSymbolsCountsOnlyPairFailures(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		wantDeactivate bool
+	}{
+		{"no data", fmt.Errorf("failed to fetch market data: %w", binance.ErrNoData), true},
+		{"API error", &binance.APIError{Code: -1121, Message: "Invalid symbol."}, true},
+		{"rate limited", &binance.APIError{StatusCode: 429}, false},
+		{"rejected API key", &binance.APIError{Code: -2015}, false},
+		{"circuit open", fmt.Errorf("failed to fetch market data: %w", binance.ErrCircuitOpen), false},
+		{"cancelled", context.Canceled, false},
+		{"timed out", fmt.Errorf("failed to fetch market data after 3 attempts: %w", context.DeadlineExceeded), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pairs := &memTradingPairRepo{pairs: []*repository.TradingPair{{ID: 1, Symbol: "BTCUSDT", IsActive: true}}}
+			c := NewCollector(nil, nil, pairs, config.CollectionConfig{MaxConsecutiveFailures: 2}, nil)
+
+			failing := func(ctx context.Context, symbol string) error { return tt.err }
+			for range 2 {
+				c.collectSymbols(context.Background(), []string{"BTCUSDT"}, failing)
+			}
+
+			if deactivated := !pairs.pairs[0].IsActive; deactivated != tt.wantDeactivate {
+				t.Errorf("pair deactivated = %v, want %v", deactivated, tt.wantDeactivate)
+			}
+		})
+	}
+}
+
+func TestCollectSymbolsStopsWhenContextDone(t *testing.T) {
+	pairs := &memTradingPairRepo{pairs: []*repository.TradingPair{
+		{ID: 1, Symbol: "BTCUSDT", IsActive: true},
+		{ID: 2, Symbol: "ETHUSDT", IsActive: true},
+		{ID: 3, Symbol: "SOLUSDT", IsActive: true},
+	}}
+	c := NewCollector(nil, nil, pairs, config.CollectionConfig{MaxConsecutiveFailures: 1}, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var attempted []string
+	collect := func(ctx context.Context, symbol string) error {
+		attempted = append(attempted, symbol)
+		// The job is cancelled while the first symbol is collected
+		cancel()
+		return ctx.Err()
+	}
+
+	run := c.collectSymbols(ctx, []string{"BTCUSDT", "ETHUSDT", "SOLUSDT"}, collect)
+
+	if fmt.Sprint(attempted) != "[BTCUSDT]" {
+		t.Errorf("attempted %v, want only BTCUSDT", attempted)
+	}
+	if !run.interrupted || run.collected != 0 || len(run.failedSymbols) != 1 {
+		t.Errorf("run = %+v, want interrupted with BTCUSDT failed", run)
+	}
+	for _, pair := range pairs.pairs {
+		if !pair.IsActive {
+			t.Errorf("%s deactivated by a cancelled run", pair.Symbol)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"ContractAnalysis/config"
+	"ContractAnalysis/internal/domain/entity"
+	"ContractAnalysis/internal/domain/repository"
+	"ContractAnalysis/internal/infrastructure/logger"
+
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// PaperTrader simulates account equity by applying closed signals to a running balance
+// It maintains one curve per strategy plus a combined portfolio curve
+type PaperTrader struct {
+	signalRepo *repository.SignalRepository
+	equityRepo repository.PaperEquityRepository
+	config     config.PaperTradingConfig
+	logger     *logger.Logger
+}
+
+// NewPaperTrader creates a new paper trader
+func NewPaperTrader(
+	signalRepo *repository.SignalRepository,
+	equityRepo repository.PaperEquityRepository,
+	config config.PaperTradingConfig,
+) *PaperTrader {
+	return &PaperTrader{
+		signalRepo: signalRepo,
+		equityRepo: equityRepo,
+		config:     config,
+		logger:     logger.WithComponent("paper-trader"),
+	}
+}
+
+// Update applies the signals closed since the last run to the equity curves
+func (p *PaperTrader) Update(ctx context.Context) error {
+	sigRepo := *p.signalRepo
+
+	// Every closed signal is applied to the portfolio curve, so it marks how far the ledger got
+	latest, err := p.equityRepo.GetLatest(ctx, entity.PaperPortfolioScope)
+	if err != nil {
+		return fmt.Errorf("failed to get latest portfolio snapshot: %w", err)
+	}
+
+	var since time.Time
+	applied := make(map[string]bool)
+	if latest != nil {
+		since = latest.ClosedAt
+
+		// Outcomes closed at the cursor's timestamp may or may not have been applied yet,
+		// so they are told apart by signal ID rather than by close time
+		atCursor, err := p.equityRepo.GetCurve(ctx, entity.PaperPortfolioScope, &since, nil)
+		if err != nil {
+			return fmt.Errorf("failed to get portfolio snapshots at cursor: %w", err)
+		}
+		for _, snapshot := range atCursor {
+			applied[snapshot.SignalID] = true
+		}
+	}
+
+	outcomes, err := sigRepo.GetOutcomesByTimeRange(ctx, since, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to get outcomes: %w", err)
+	}
+
+	pending := make([]*entity.SignalOutcome, 0, len(outcomes))
+	for _, outcome := range outcomes {
+		if !applied[outcome.SignalID] {
+			pending = append(pending, outcome)
+		}
+	}
+
+	if len(pending) == 0 {
+		p.logger.Debug("No newly closed signals to apply")
+		return nil
+	}
+
+	// Apply trades in the order they closed
+	sort.Slice(pending, func(i, j int) bool {
+		if pending[i].ClosedAt.Equal(pending[j].ClosedAt) {
+			return pending[i].SignalID < pending[j].SignalID
+		}
+		return pending[i].ClosedAt.Before(pending[j].ClosedAt)
+	})
+
+	signalIDs := make([]string, len(pending))
+	for i, outcome := range pending {
+		signalIDs[i] = outcome.SignalID
+	}
+
+	signals, err := sigRepo.GetByIDs(ctx, signalIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get signals: %w", err)
+	}
+
+	signalMap := make(map[string]*entity.Signal, len(signals))
+	for _, signal := range signals {
+		signalMap[signal.SignalID] = signal
+	}
+
+	balances := make(map[string]decimal.Decimal)
+	var snapshots []*entity.PaperEquitySnapshot
+
+	for _, outcome := range pending {
+		signal, ok := signalMap[outcome.SignalID]
+		if !ok {
+			p.logger.WithSignalID(outcome.SignalID).Warn("Signal not found for outcome, skipping")
+			continue
+		}
+
+		for _, scope := range []string{entity.PaperPortfolioScope, signal.StrategyName} {
+			balance, ok := balances[scope]
+			if !ok {
+				balance, err = p.startingBalance(ctx, scope)
+				if err != nil {
+					return err
+				}
+			}
+
+			snapshot := p.applyTrade(scope, balance, signal, outcome)
+			balances[scope] = snapshot.Balance
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+
+	if err := p.equityRepo.CreateBatch(ctx, snapshots); err != nil {
+		return fmt.Errorf("failed to save equity snapshots: %w", err)
+	}
+
+	p.logger.Info("Paper trading ledger updated",
+		zap.Int("trades", len(pending)),
+		zap.Int("snapshots", len(snapshots)),
+		zap.String("portfolio_balance", balances[entity.PaperPortfolioScope].StringFixed(2)),
+	)
+
+	return nil
+}
+
+// startingBalance returns the current balance of a curve, or the initial balance if it is empty
+func (p *PaperTrader) startingBalance(ctx context.Context, scope string) (decimal.Decimal, error) {
+	latest, err := p.equityRepo.GetLatest(ctx, scope)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to get latest snapshot for %s: %w", scope, err)
+	}
+
+	if latest == nil {
+		return decimal.NewFromFloat(p.config.InitialBalance), nil
+	}
+
+	return latest.Balance, nil
+}
+
+// applyTrade sizes the trade so that a stop-out loses RiskPerTradePct of the balance
+// and applies its realized return to the balance
+func (p *PaperTrader) applyTrade(scope string, balance decimal.Decimal, signal *entity.Signal, outcome *entity.SignalOutcome) *entity.PaperEquitySnapshot {
+	hundred := decimal.NewFromInt(100)
+
+	returnPct := signal.RealizedReturnPct(outcome)
+	positionSizePct := decimal.NewFromFloat(p.config.RiskPerTradePct).Div(p.stopDistancePct(signal)).Mul(hundred)
+
+	notional := balance.Mul(positionSizePct).Div(hundred)
+	pnl := notional.Mul(returnPct).Div(hundred)
+
+	return &entity.PaperEquitySnapshot{
+		StrategyName:    scope,
+		SignalID:        signal.SignalID,
+		Symbol:          signal.Symbol,
+		SignalType:      signal.Type,
+		ReturnPct:       returnPct.Round(4),
+		PositionSizePct: positionSizePct.Round(4),
+		PnL:             pnl.Round(8),
+		Balance:         balance.Add(pnl).Round(8),
+		ClosedAt:        outcome.ClosedAt,
+		CreatedAt:       time.Now(),
+	}
+}
+
+// stopDistancePct returns the stop distance of the signal, taken from its stop loss price
+// when set, like CalculateRiskSizing, otherwise from the strategy's configured percentage
+// Falls back to DefaultStopLossPct when the signal's config snapshot has neither
+func (p *PaperTrader) stopDistancePct(signal *entity.Signal) decimal.Decimal {
+	if !signal.StopLossPrice.IsZero() && signal.PriceAtSignal.GreaterThan(decimal.Zero) {
+		distance := signal.StopLossPrice.Sub(signal.PriceAtSignal).Abs().Div(signal.PriceAtSignal).Mul(decimal.NewFromInt(100))
+		if distance.GreaterThan(decimal.Zero) {
+			return distance
+		}
+	}
+	if stopLossPct, ok := signal.ConfigSnapshot["stop_loss_pct"].(float64); ok && stopLossPct > 0 {
+		return decimal.NewFromFloat(stopLossPct)
+	}
+	return decimal.NewFromFloat(p.config.DefaultStopLossPct)
+}
@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ContractAnalysis/config"
+	"ContractAnalysis/internal/domain/entity"
+	"ContractAnalysis/internal/domain/repository"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestPaperTraderUpdateAppliesEachOutcomeOnce(t *testing.T) {
+	ctx := context.Background()
+	strategy := newTestMinorityStrategy()
+	signals := newMemSignalRepo()
+	equity := &memPaperEquityRepo{}
+
+	var signalRepo repository.SignalRepository = signals
+	trader := NewPaperTrader(&signalRepo, equity, config.PaperTradingConfig{
+		InitialBalance:     10000,
+		RiskPerTradePct:    1,
+		DefaultStopLossPct: 1,
+	})
+
+	// MySQL stores close times with second precision
+	closedAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	closeSignal := func(symbol string) {
+		t.Helper()
+		signal := testSignal(strategy, symbol, entity.SignalTypeLong)
+		signal.Status = entity.SignalStatusClosed
+		if err := signals.Create(ctx, signal); err != nil {
+			t.Fatal(err)
+		}
+		signals.outcomes = append(signals.outcomes, &entity.SignalOutcome{
+			SignalID:            signal.SignalID,
+			Outcome:             string(entity.OutcomeProfit),
+			FinalPriceChangePct: decimal.NewFromInt(2),
+			ClosedAt:            closedAt,
+		})
+	}
+	update := func(wantTrades int) {
+		t.Helper()
+		before := len(equity.snapshots)
+		if err := trader.Update(ctx); err != nil {
+			t.Fatalf("Update: %v", err)
+		}
+		// One snapshot on the portfolio curve and one on the strategy curve per trade
+		if got := (len(equity.snapshots) - before) / 2; got != wantTrades {
+			t.Errorf("applied %d trades, want %d", got, wantTrades)
+		}
+	}
+
+	closeSignal("BTCUSDT")
+	update(1)
+
+	// Closed in the same second as the ledger cursor, after the previous run
+	closeSignal("ETHUSDT")
+	update(1)
+	update(0)
+
+	seen := make(map[string]bool)
+	for _, snapshot := range equity.snapshots {
+		key := snapshot.StrategyName + "|" + snapshot.SignalID
+		if seen[key] {
+			t.Errorf("signal %s applied twice to %s", snapshot.SignalID, snapshot.StrategyName)
+		}
+		seen[key] = true
+	}
+}
+
+func TestPaperTraderStopDistancePct(t *testing.T) {
+	trader := NewPaperTrader(nil, nil, config.PaperTradingConfig{DefaultStopLossPct: 1})
+
+	tests := []struct {
+		name        string
+		signalType  entity.SignalType
+		stopLoss    string // Zero = no stop loss price
+		stopLossPct interface{}
+		want        string
+	}{
+		{"long stop price", entity.SignalTypeLong, "97", 1.5, "3"},
+		{"short stop price", entity.SignalTypeShort, "102.5", 1.5, "2.5"},
+		{"configured percentage", entity.SignalTypeLong, "0", 1.5, "1.5"},
+		{"default percentage", entity.SignalTypeLong, "0", nil, "1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := &entity.Signal{
+				Type:           tt.signalType,
+				PriceAtSignal:  decimal.NewFromInt(100),
+				StopLossPrice:  decimal.RequireFromString(tt.stopLoss),
+				ConfigSnapshot: map[string]interface{}{},
+			}
+			if tt.stopLossPct != nil {
+				signal.ConfigSnapshot["stop_loss_pct"] = tt.stopLossPct
+			}
+
+			if got := trader.stopDistancePct(signal); !got.Equal(decimal.RequireFromString(tt.want)) {
+				t.Errorf("stopDistancePct = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
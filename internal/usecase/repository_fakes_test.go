@@ -0,0 +1,465 @@
+package usecase
+
+import (
+	"context"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"ContractAnalysis/config"
+	"ContractAnalysis/internal/domain/entity"
+	"ContractAnalysis/internal/domain/repository"
+	"ContractAnalysis/internal/domain/service"
+)
+
+// memSignalRepo is an in-memory SignalRepository for tests
+// Methods the tests don't need fall through to the nil embedded interface and panic
+type memSignalRepo struct {
+	repository.SignalRepository
+
+	mu       sync.Mutex
+	signals  []*entity.Signal
+	closed   map[string]*repository.SignalWithOutcome // Latest closed signal by symbol|strategy|type
+	since    []time.Time                              // Arguments of CountSignalsByStrategySince calls
+	outcomes []*entity.SignalOutcome
+
+	trackings   []*entity.SignalTracking
+	trackingErr error // Returned by CreateTracking when set
+
+	outcomeBatches  int                            // CreateOutcomesBatch calls
+	outcomeBatchErr error                          // Returned by CreateOutcomesBatch when set
+	savedStatus     map[string]entity.SignalStatus // Status of each signal at its last Update
+}
+
+func newMemSignalRepo() *memSignalRepo {
+	return &memSignalRepo{closed: make(map[string]*repository.SignalWithOutcome)}
+}
+
+func closedKey(symbol, strategyName string, signalType entity.SignalType) string {
+	return symbol + "|" + strategyName + "|" + string(signalType)
+}
+
+func isActiveStatus(status entity.SignalStatus) bool {
+	return status == entity.SignalStatusPending ||
+		status == entity.SignalStatusConfirmed ||
+		status == entity.SignalStatusTracking
+}
+
+func (r *memSignalRepo) Create(ctx context.Context, signal *entity.Signal) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	signal.ID = int64(len(r.signals) + 1)
+	r.signals = append(r.signals, signal)
+	return nil
+}
+
+// WithTransaction runs fn against the repository, restoring the stored signals and
+// trackings if it fails
+func (r *memSignalRepo) WithTransaction(ctx context.Context, fn func(txRepo repository.SignalRepository) error) error {
+	r.mu.Lock()
+	signals := append([]*entity.Signal(nil), r.signals...)
+	trackings := append([]*entity.SignalTracking(nil), r.trackings...)
+	r.mu.Unlock()
+
+	if err := fn(r); err != nil {
+		r.mu.Lock()
+		r.signals, r.trackings = signals, trackings
+		r.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+func (r *memSignalRepo) CreateTracking(ctx context.Context, tracking *entity.SignalTracking) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.trackingErr != nil {
+		return r.trackingErr
+	}
+	r.trackings = append(r.trackings, tracking)
+	return nil
+}
+
+func (r *memSignalRepo) GetConfirmedSignals(ctx context.Context) ([]*entity.Signal, error) {
+	return r.signalsWithStatus(entity.SignalStatusConfirmed), nil
+}
+
+func (r *memSignalRepo) GetTrackingSignals(ctx context.Context) ([]*entity.Signal, error) {
+	return r.signalsWithStatus(entity.SignalStatusTracking), nil
+}
+
+func (r *memSignalRepo) Update(ctx context.Context, signal *entity.Signal) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.savedStatus == nil {
+		r.savedStatus = make(map[string]entity.SignalStatus)
+	}
+	r.savedStatus[signal.SignalID] = signal.Status
+	return nil
+}
+
+func (r *memSignalRepo) GetLatestTracking(ctx context.Context, signalID string) (*entity.SignalTracking, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := len(r.trackings) - 1; i >= 0; i-- {
+		if r.trackings[i].SignalID == signalID {
+			return r.trackings[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memSignalRepo) GetKlineTrackingBySignal(ctx context.Context, signalID string) ([]*entity.SignalKlineTracking, error) {
+	return nil, nil
+}
+
+func (r *memSignalRepo) CreateOutcomesBatch(ctx context.Context, outcomes []*entity.SignalOutcome) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.outcomeBatches++
+	if r.outcomeBatchErr != nil {
+		return r.outcomeBatchErr
+	}
+	r.outcomes = append(r.outcomes, outcomes...)
+	return nil
+}
+
+func (r *memSignalRepo) GetByID(ctx context.Context, signalID string) (*entity.Signal, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, s := range r.signals {
+		if s.SignalID == signalID {
+			return s, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memSignalRepo) CountActiveSignalsBySymbol(ctx context.Context, symbol string) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	count := 0
+	for _, s := range r.signals {
+		if s.Symbol == symbol && isActiveStatus(s.Status) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *memSignalRepo) CountActiveSignalsBySymbolStrategyType(ctx context.Context, symbol, strategyName string, signalType entity.SignalType) (int, error) {
+	r.mu.Lock()
+	count := 0
+	for _, s := range r.signals {
+		if s.Symbol == symbol && s.StrategyName == strategyName && s.Type == signalType && isActiveStatus(s.Status) {
+			count++
+		}
+	}
+	r.mu.Unlock()
+
+	// Widen the window between the duplicate check and the insert, like a database round trip
+	runtime.Gosched()
+	return count, nil
+}
+
+func (r *memSignalRepo) GetActiveSignalBySymbolStrategyType(ctx context.Context, symbol, strategyName string, signalType entity.SignalType) (*entity.Signal, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := len(r.signals) - 1; i >= 0; i-- {
+		s := r.signals[i]
+		if s.Symbol == symbol && s.StrategyName == strategyName && s.Type == signalType && isActiveStatus(s.Status) {
+			return s, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memSignalRepo) CountSignalsByStrategySince(ctx context.Context, strategyName string, since time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.since = append(r.since, since)
+
+	count := 0
+	for _, s := range r.signals {
+		if s.StrategyName == strategyName && !s.GeneratedAt.Before(since) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *memSignalRepo) GetLatestClosedSignal(ctx context.Context, symbol, strategyName string, signalType entity.SignalType) (*repository.SignalWithOutcome, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.closed[closedKey(symbol, strategyName, signalType)], nil
+}
+
+func (r *memSignalRepo) GetRecentSignalsBySymbol(ctx context.Context, symbol string, since time.Time) ([]*entity.Signal, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var recent []*entity.Signal
+	for _, s := range r.signals {
+		if s.Symbol == symbol && !s.GeneratedAt.Before(since) {
+			recent = append(recent, s)
+		}
+	}
+	return recent, nil
+}
+
+func (r *memSignalRepo) GetByIDs(ctx context.Context, signalIDs []string) ([]*entity.Signal, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	wanted := make(map[string]bool, len(signalIDs))
+	for _, id := range signalIDs {
+		wanted[id] = true
+	}
+
+	var signals []*entity.Signal
+	for _, s := range r.signals {
+		if wanted[s.SignalID] {
+			signals = append(signals, s)
+		}
+	}
+	return signals, nil
+}
+
+func (r *memSignalRepo) GetOutcomesByTimeRange(ctx context.Context, start, end time.Time) ([]*entity.SignalOutcome, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var outcomes []*entity.SignalOutcome
+	for _, o := range r.outcomes {
+		if !o.ClosedAt.Before(start) && !o.ClosedAt.After(end) {
+			outcomes = append(outcomes, o)
+		}
+	}
+	return outcomes, nil
+}
+
+func (r *memSignalRepo) GetAll(ctx context.Context) ([]*entity.Signal, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*entity.Signal(nil), r.signals...), nil
+}
+
+// signalsWithStatus returns the stored signals with the given status
+func (r *memSignalRepo) signalsWithStatus(status entity.SignalStatus) []*entity.Signal {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var signals []*entity.Signal
+	for _, s := range r.signals {
+		if s.Status == status {
+			signals = append(signals, s)
+		}
+	}
+	return signals
+}
+
+// activeSignals returns the stored active signals
+func (r *memSignalRepo) activeSignals() []*entity.Signal {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var active []*entity.Signal
+	for _, s := range r.signals {
+		if isActiveStatus(s.Status) {
+			active = append(active, s)
+		}
+	}
+	return active
+}
+
+// memMarketDataRepo is an in-memory MarketDataRepository for tests
+type memMarketDataRepo struct {
+	repository.MarketDataRepository
+
+	bySymbol map[string][]*entity.MarketData // Newest first
+}
+
+func (r *memMarketDataRepo) GetBySymbol(ctx context.Context, symbol string, start, end time.Time) ([]*entity.MarketData, error) {
+	var data []*entity.MarketData
+	for _, d := range r.bySymbol[symbol] {
+		if !d.Timestamp.Before(start) && !d.Timestamp.After(end) {
+			data = append(data, d)
+		}
+	}
+	return data, nil
+}
+
+// memTradingPairRepo is an in-memory TradingPairRepository for tests
+type memTradingPairRepo struct {
+	repository.TradingPairRepository
+
+	pairs []*repository.TradingPair
+}
+
+func (r *memTradingPairRepo) GetActive(ctx context.Context) ([]*repository.TradingPair, error) {
+	var active []*repository.TradingPair
+	for _, p := range r.pairs {
+		if p.IsActive {
+			active = append(active, p)
+		}
+	}
+	sort.Slice(active, func(i, j int) bool { return active[i].ID < active[j].ID })
+	return active, nil
+}
+
+func (r *memTradingPairRepo) GetBySymbol(ctx context.Context, symbol string) (*repository.TradingPair, error) {
+	for _, p := range r.pairs {
+		if p.Symbol == symbol {
+			return p, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memTradingPairRepo) SetActive(ctx context.Context, symbol string, active bool) error {
+	for _, p := range r.pairs {
+		if p.Symbol == symbol {
+			p.IsActive = active
+		}
+	}
+	return nil
+}
+
+// memStatisticsRepo is an in-memory StatisticsRepository for tests
+type memStatisticsRepo struct {
+	repository.StatisticsRepository
+
+	mu        sync.Mutex
+	stats     []*repository.StrategyStatistics
+	saveErr   error       // Returned by CreateOrUpdate when set
+	deletions []time.Time // Arguments of DeleteOlderThan calls
+}
+
+func (r *memStatisticsRepo) CreateOrUpdate(ctx context.Context, stats *repository.StrategyStatistics) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.saveErr != nil {
+		return r.saveErr
+	}
+	r.stats = append(r.stats, stats)
+	return nil
+}
+
+func (r *memStatisticsRepo) DeleteOlderThan(ctx context.Context, before time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.deletions = append(r.deletions, before)
+
+	kept := r.stats[:0]
+	for _, stats := range r.stats {
+		if !stats.CalculatedAt.Before(before) {
+			kept = append(kept, stats)
+		}
+	}
+	deleted := int64(len(r.stats) - len(kept))
+	r.stats = kept
+	return deleted, nil
+}
+
+// memPauseRepo is an in-memory AnalysisPauseRepository for tests
+type memPauseRepo struct {
+	mu    sync.Mutex
+	pause *repository.AnalysisPause
+}
+
+func (r *memPauseRepo) Get(ctx context.Context) (*repository.AnalysisPause, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.pause, nil
+}
+
+func (r *memPauseRepo) Set(ctx context.Context, pause *repository.AnalysisPause) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.pause = pause
+	return nil
+}
+
+// memPaperEquityRepo is an in-memory PaperEquityRepository for tests
+type memPaperEquityRepo struct {
+	snapshots []*entity.PaperEquitySnapshot
+}
+
+func (r *memPaperEquityRepo) CreateBatch(ctx context.Context, snapshots []*entity.PaperEquitySnapshot) error {
+	for _, snapshot := range snapshots {
+		snapshot.ID = int64(len(r.snapshots) + 1)
+		r.snapshots = append(r.snapshots, snapshot)
+	}
+	return nil
+}
+
+func (r *memPaperEquityRepo) GetLatest(ctx context.Context, strategyName string) (*entity.PaperEquitySnapshot, error) {
+	curve, _ := r.GetCurve(ctx, strategyName, nil, nil)
+	if len(curve) == 0 {
+		return nil, nil
+	}
+	return curve[len(curve)-1], nil
+}
+
+func (r *memPaperEquityRepo) GetCurve(ctx context.Context, strategyName string, start, end *time.Time) ([]*entity.PaperEquitySnapshot, error) {
+	var curve []*entity.PaperEquitySnapshot
+	for _, s := range r.snapshots {
+		if s.StrategyName != strategyName ||
+			(start != nil && s.ClosedAt.Before(*start)) ||
+			(end != nil && s.ClosedAt.After(*end)) {
+			continue
+		}
+		curve = append(curve, s)
+	}
+	sort.SliceStable(curve, func(i, j int) bool { return curve[i].ClosedAt.Before(curve[j].ClosedAt) })
+	return curve, nil
+}
+
+// newTestAnalyzer builds an analyzer over in-memory repositories
+func newTestAnalyzer(strategies []service.Strategy, signals *memSignalRepo, marketData *memMarketDataRepo, pairs *memTradingPairRepo, cfg config.GlobalStrategy, loc *time.Location) *Analyzer {
+	var signalRepo repository.SignalRepository = signals
+	var marketDataRepo repository.MarketDataRepository = marketData
+	var pairRepo repository.TradingPairRepository
+	if pairs != nil {
+		pairRepo = pairs
+	}
+	return NewAnalyzer(strategies, &marketDataRepo, &signalRepo, pairRepo, &memPauseRepo{}, nil, cfg, loc)
+}
+
+// newTestMinorityStrategy returns an enabled minority strategy that fires on 70% account ratios
+func newTestMinorityStrategy() *service.MinorityStrategy {
+	return newNamedMinorityStrategy(entity.StrategyMinority)
+}
+
+// newNamedMinorityStrategy returns the test minority strategy under another name
+func newNamedMinorityStrategy(name string) *service.MinorityStrategy {
+	return service.NewMinorityStrategy(service.MinorityStrategyConfig{
+		BaseConfig: service.StrategyConfig{
+			Name:              name,
+			Enabled:           true,
+			ConfirmationHours: 1,
+			TrackingHours:     24,
+			ProfitTargetPct:   2,
+			StopLossPct:       1,
+		},
+		MinRatioDifference:              70,
+		GenerateLongWhenShortRatioAbove: 70,
+		GenerateShortWhenLongRatioAbove: 70,
+	}, nil, nil)
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ContractAnalysis/config"
+	"ContractAnalysis/internal/domain/repository"
+	"ContractAnalysis/internal/infrastructure/logger"
+
+	"go.uber.org/zap"
+)
+
+// RetentionCleaner prunes old market data and statistics
+type RetentionCleaner struct {
+	marketDataRepo *repository.MarketDataRepository
+	statsRepo      repository.StatisticsRepository
+	config         config.RetentionConfig
+	logger         *logger.Logger
+}
+
+// NewRetentionCleaner creates a new retention cleaner
+func NewRetentionCleaner(
+	marketDataRepo *repository.MarketDataRepository,
+	statsRepo repository.StatisticsRepository,
+	config config.RetentionConfig,
+) *RetentionCleaner {
+	return &RetentionCleaner{
+		marketDataRepo: marketDataRepo,
+		statsRepo:      statsRepo,
+		config:         config,
+		logger:         logger.WithComponent("retention"),
+	}
+}
+
+// Cleanup deletes market data and statistics older than the configured retention windows
+func (r *RetentionCleaner) Cleanup(ctx context.Context) error {
+	r.logger.Info("Starting data retention cleanup")
+
+	if r.config.MarketDataDays > 0 {
+		mdRepo := *r.marketDataRepo
+		before := time.Now().AddDate(0, 0, -r.config.MarketDataDays)
+
+		deleted, err := mdRepo.DeleteOlderThan(ctx, before)
+		if err != nil {
+			return fmt.Errorf("failed to prune market data: %w", err)
+		}
+
+		r.logger.Info("Pruned old market data",
+			zap.Int64("rows_deleted", deleted),
+			zap.Time("before", before),
+		)
+	}
+
+	if r.config.StatisticsDays > 0 {
+		before := time.Now().AddDate(0, 0, -r.config.StatisticsDays)
+
+		deleted, err := r.statsRepo.DeleteOlderThan(ctx, before)
+		if err != nil {
+			return fmt.Errorf("failed to prune statistics: %w", err)
+		}
+
+		r.logger.Info("Pruned old statistics",
+			zap.Int64("rows_deleted", deleted),
+			zap.Time("before", before),
+		)
+	}
+
+	r.logger.Info("Data retention cleanup completed")
+	return nil
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"ContractAnalysis/config"
+	"ContractAnalysis/internal/domain/service"
+	"ContractAnalysis/internal/infrastructure/logger"
+
+	"go.uber.org/zap"
+)
+
+// SelfTest checks the Binance connection and strategy configuration end-to-end on startup
+type SelfTest struct {
+	collector  *Collector
+	strategies []service.Strategy
+	config     config.SelfTestConfig
+	logger     *logger.Logger
+}
+
+// NewSelfTest creates a new startup self-test
+func NewSelfTest(
+	collector *Collector,
+	strategies []service.Strategy,
+	config config.SelfTestConfig,
+) *SelfTest {
+	return &SelfTest{
+		collector:  collector,
+		strategies: strategies,
+		config:     config,
+		logger:     logger.WithComponent("self_test"),
+	}
+}
+
+// Run fetches market data for the configured symbol and evaluates every enabled strategy
+// against it without persisting anything. Results are logged; the returned error reports
+// a failed fetch or the strategies that failed to evaluate
+func (s *SelfTest) Run(ctx context.Context) error {
+	symbol := s.config.Symbol
+	s.logger.Info("Running startup self-test", zap.String("symbol", symbol))
+
+	data, err := s.collector.FetchForSymbol(ctx, symbol)
+	if err != nil {
+		return fmt.Errorf("failed to fetch market data for %s: %w", symbol, err)
+	}
+
+	s.logger.Info("Self-test market data fetched",
+		zap.String("symbol", symbol),
+		zap.String("long_account_ratio", data.LongAccountRatio.String()),
+		zap.String("short_account_ratio", data.ShortAccountRatio.String()),
+		zap.Bool("position_ratio_available", data.PositionRatioAvailable),
+		zap.String("price", data.Price.String()),
+		zap.Int("data_quality_score", data.DataQualityScore),
+	)
+
+	var failed []string
+	for _, strategy := range s.strategies {
+		if !strategy.IsEnabled() {
+			continue
+		}
+
+		// Aggregators depend on other strategies' signals and can't be evaluated alone
+		if _, ok := strategy.(service.SignalAggregator); ok {
+			continue
+		}
+
+		shouldGenerate, reason, err := strategy.ShouldGenerateSignal(ctx, data)
+		if err != nil {
+			s.logger.WithError(err).Warn("Self-test strategy evaluation failed",
+				zap.String("strategy", strategy.Name()),
+				zap.String("symbol", symbol))
+			failed = append(failed, strategy.Name())
+			continue
+		}
+
+		s.logger.Info("Self-test strategy evaluated",
+			zap.String("strategy", strategy.Name()),
+			zap.String("symbol", symbol),
+			zap.Bool("would_fire", shouldGenerate),
+			zap.String("reason", reason))
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("%d strategies failed to evaluate %s: %v", len(failed), symbol, failed)
+	}
+
+	s.logger.Info("Startup self-test passed", zap.String("symbol", symbol))
+	return nil
+}
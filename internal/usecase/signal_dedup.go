@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+
+	"ContractAnalysis/internal/domain/entity"
+	"ContractAnalysis/internal/domain/service"
+
+	"go.uber.org/zap"
+)
+
+// Signal deduplication modes, applied to signals several strategies generate for the same
+// symbol and direction in one analysis run
+const (
+	SignalDedupOff      = ""         // Keep every strategy's signal
+	SignalDedupMerge    = "merge"    // Keep one signal listing every contributing strategy
+	SignalDedupPriority = "priority" // Keep only the highest-priority strategy's signal
+)
+
+// candidateSignal is a signal generated in an analysis run, before it is stored
+type candidateSignal struct {
+	strategy service.Strategy
+	signal   *entity.Signal
+	folded   []*entity.Signal // Signals of other strategies deduplicated into this one
+}
+
+// dedupSignals reduces the candidates of one symbol to one per direction according to the
+// configured mode. The highest-priority strategy's signal is kept, in merge mode with the
+// other strategies' signals recorded in its config snapshot. The dropped signals are kept in
+// the folded field of the signal they were deduplicated into
+// Candidates are returned in their original order
+func (a *Analyzer) dedupSignals(candidates []candidateSignal) []candidateSignal {
+	mode := a.globalConfig.SignalDedup
+	if mode == SignalDedupOff || len(candidates) < 2 {
+		return candidates
+	}
+
+	// Pick the highest-priority candidate of each direction
+	best := make(map[entity.SignalType]int)
+	for i, c := range candidates {
+		j, ok := best[c.signal.Type]
+		if !ok || a.strategyRank(c.strategy) < a.strategyRank(candidates[j].strategy) {
+			best[c.signal.Type] = i
+		}
+	}
+
+	kept := make([]candidateSignal, 0, len(best))
+	for i, c := range candidates {
+		if best[c.signal.Type] != i {
+			continue
+		}
+
+		var others []candidateSignal
+		for j, other := range candidates {
+			if j != i && other.signal.Type == c.signal.Type {
+				others = append(others, other)
+			}
+		}
+		if len(others) > 0 {
+			if mode == SignalDedupMerge {
+				mergeSignals(c.signal, others)
+			}
+			a.logger.Info("Deduplicated signals of multiple strategies",
+				zap.String("symbol", c.signal.Symbol),
+				zap.String("type", string(c.signal.Type)),
+				zap.String("mode", mode),
+				zap.String("kept_strategy", c.signal.StrategyName),
+				zap.Strings("dropped_strategies", candidateStrategyKeys(others)),
+			)
+			for _, other := range others {
+				c.folded = append(c.folded, other.signal)
+			}
+		}
+
+		kept = append(kept, c)
+	}
+
+	return kept
+}
+
+// agreeingSignals returns the stored signal of a candidate together with the signals deduplicated
+// into it, so aggregators still count every agreeing strategy. The folded signals were never
+// stored and reference the stored signal's ID instead of their own
+func (c candidateSignal) agreeingSignals() []*entity.Signal {
+	signals := []*entity.Signal{c.signal}
+	for _, folded := range c.folded {
+		agreeing := *folded
+		agreeing.SignalID = c.signal.SignalID
+		signals = append(signals, &agreeing)
+	}
+	return signals
+}
+
+// strategyRank returns the priority rank of a strategy, lower ranks first
+// Strategies listed in signal_dedup_priority come first in that order, the others follow
+// in their configured order
+func (a *Analyzer) strategyRank(strategy service.Strategy) int {
+	for i, key := range a.globalConfig.SignalDedupPriority {
+		if key == strategy.Key() {
+			return i
+		}
+	}
+	for i, s := range a.strategies {
+		if s == strategy {
+			return len(a.globalConfig.SignalDedupPriority) + i
+		}
+	}
+	return len(a.globalConfig.SignalDedupPriority) + len(a.strategies)
+}
+
+// mergeSignals records the signals of other strategies agreeing with signal in its reason and
+// config snapshot, keeping each strategy's own reason and configuration
+func mergeSignals(signal *entity.Signal, others []candidateSignal) {
+	contributors := append([]string{signal.StrategyName}, candidateStrategyKeys(others)...)
+
+	merged := make([]map[string]interface{}, 0, len(others))
+	for _, other := range others {
+		merged = append(merged, map[string]interface{}{
+			"strategy":        other.signal.StrategyName,
+			"reason":          other.signal.Reason,
+			"config_snapshot": other.signal.ConfigSnapshot,
+		})
+	}
+
+	signal.Reason = fmt.Sprintf("%s Also generated by: %s.", signal.Reason, strings.Join(contributors[1:], ", "))
+	signal.ConfigSnapshot["contributing_strategies"] = contributors
+	signal.ConfigSnapshot["merged_signals"] = merged
+}
+
+// candidateStrategyKeys returns the strategy keys of the candidates' signals
+func candidateStrategyKeys(candidates []candidateSignal) []string {
+	keys := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		keys = append(keys, c.signal.StrategyName)
+	}
+	return keys
+}
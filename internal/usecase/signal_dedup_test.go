@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"ContractAnalysis/config"
+	"ContractAnalysis/internal/domain/entity"
+	"ContractAnalysis/internal/domain/service"
+)
+
+func TestDedupSignals(t *testing.T) {
+	strategyA := newNamedMinorityStrategy("MinorityA")
+	strategyB := newNamedMinorityStrategy("MinorityB")
+	strategyC := newNamedMinorityStrategy("MinorityC")
+	strategies := []service.Strategy{strategyA, strategyB, strategyC}
+
+	tests := []struct {
+		name       string
+		mode       string
+		priority   []string
+		wantKept   []string            // strategy/type of the kept candidates, in order
+		wantFolded map[string][]string // strategies folded into each kept strategy
+		wantMerged bool                // Whether the kept signals list their contributors
+	}{
+		{
+			name:     "off keeps every signal",
+			mode:     SignalDedupOff,
+			wantKept: []string{"MinorityA/LONG", "MinorityB/LONG", "MinorityC/SHORT"},
+		},
+		{
+			name:       "merge keeps the first strategy per direction",
+			mode:       SignalDedupMerge,
+			wantKept:   []string{"MinorityA/LONG", "MinorityC/SHORT"},
+			wantFolded: map[string][]string{"MinorityA": {"MinorityB"}},
+			wantMerged: true,
+		},
+		{
+			name:       "priority keeps the highest-priority strategy",
+			mode:       SignalDedupPriority,
+			priority:   []string{"MinorityB"},
+			wantKept:   []string{"MinorityB/LONG", "MinorityC/SHORT"},
+			wantFolded: map[string][]string{"MinorityB": {"MinorityA"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newTestAnalyzer(strategies, newMemSignalRepo(), &memMarketDataRepo{}, nil,
+				config.GlobalStrategy{SignalDedup: tt.mode, SignalDedupPriority: tt.priority}, nil)
+			candidates := []candidateSignal{
+				{strategy: strategyA, signal: testSignal(strategyA, "BTCUSDT", entity.SignalTypeLong)},
+				{strategy: strategyB, signal: testSignal(strategyB, "BTCUSDT", entity.SignalTypeLong)},
+				{strategy: strategyC, signal: testSignal(strategyC, "BTCUSDT", entity.SignalTypeShort)},
+			}
+
+			kept := a.dedupSignals(candidates)
+
+			var got []string
+			for _, c := range kept {
+				got = append(got, c.signal.StrategyName+"/"+string(c.signal.Type))
+			}
+			if strings.Join(got, ",") != strings.Join(tt.wantKept, ",") {
+				t.Fatalf("kept %v, want %v", got, tt.wantKept)
+			}
+
+			for _, c := range kept {
+				var folded []string
+				for _, signal := range c.folded {
+					folded = append(folded, signal.StrategyName)
+				}
+				if want := tt.wantFolded[c.signal.StrategyName]; strings.Join(folded, ",") != strings.Join(want, ",") {
+					t.Errorf("%s folded %v, want %v", c.signal.StrategyName, folded, want)
+				}
+
+				_, merged := c.signal.ConfigSnapshot["contributing_strategies"]
+				if merged != (tt.wantMerged && len(c.folded) > 0) {
+					t.Errorf("%s lists contributing strategies = %v, want %v", c.signal.StrategyName, merged, !merged)
+				}
+			}
+		})
+	}
+}
+
+func TestConsensusAfterDedup(t *testing.T) {
+	for _, mode := range []string{SignalDedupOff, SignalDedupMerge, SignalDedupPriority} {
+		t.Run("mode "+mode, func(t *testing.T) {
+			f := newAnalyzeAllFixture(1)
+			f.cfg.SignalDedup = mode
+			f.strategies = append(f.strategies, service.NewConsensusStrategy(service.ConsensusStrategyConfig{
+				BaseConfig: service.StrategyConfig{
+					Name:              "Consensus",
+					Enabled:           true,
+					ConfirmationHours: 1,
+					TrackingHours:     24,
+					ProfitTargetPct:   2,
+					StopLossPct:       1,
+				},
+				MinAgreeingStrategies: 2,
+			}))
+
+			signals, err := f.analyzer().AnalyzeAll(context.Background())
+			if err != nil {
+				t.Fatalf("AnalyzeAll: %v", err)
+			}
+
+			stored := make(map[string]bool)
+			var consensus *entity.Signal
+			for _, signal := range signals {
+				stored[signal.SignalID] = true
+				if signal.StrategyName == entity.StrategyConsensus {
+					consensus = signal
+				}
+			}
+			if consensus == nil {
+				t.Fatalf("no consensus signal among %d signals", len(signals))
+			}
+
+			contributors, _ := consensus.ConfigSnapshot["contributing_strategies"].([]string)
+			if len(contributors) != 2 {
+				t.Errorf("contributing_strategies = %v, want both minority strategies", contributors)
+			}
+			// Folded signals reference the signal they were stored as
+			ids, _ := consensus.ConfigSnapshot["contributing_signal_ids"].([]string)
+			for _, id := range ids {
+				if !stored[id] {
+					t.Errorf("contributing signal %s was not stored", id)
+				}
+			}
+		})
+	}
+}
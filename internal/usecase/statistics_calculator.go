@@ -3,12 +3,15 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"sort"
+	"sync"
 	"time"
 
 	"ContractAnalysis/config"
 	"ContractAnalysis/internal/domain/entity"
 	"ContractAnalysis/internal/domain/repository"
 	"ContractAnalysis/internal/infrastructure/logger"
+	"ContractAnalysis/pkg/utils"
 
 	"github.com/shopspring/decimal"
 	"go.uber.org/zap"
@@ -20,6 +23,18 @@ type StatisticsCalculator struct {
 	statisticsRepo repository.StatisticsRepository
 	config         config.StatisticsConfig
 	logger         *logger.Logger
+
+	// Serializes full calculations, so a scheduled run can't write rows while a rebuild purges them
+	calculateMu sync.Mutex
+
+	rebuildMu      sync.Mutex
+	rebuildJobs    map[string]*StatisticsRebuildJob
+	runningRebuild *StatisticsRebuildJob
+
+	// Rebuilds run in the background under rebuildCtx, cancelled by StopRebuilds
+	rebuildCtx    context.Context
+	rebuildCancel context.CancelFunc
+	rebuildWG     sync.WaitGroup
 }
 
 // NewStatisticsCalculator creates a new statistics calculator
@@ -28,16 +43,30 @@ func NewStatisticsCalculator(
 	statisticsRepo repository.StatisticsRepository,
 	cfg config.StatisticsConfig,
 ) *StatisticsCalculator {
+	rebuildCtx, rebuildCancel := context.WithCancel(context.Background())
 	return &StatisticsCalculator{
 		signalRepo:     signalRepo,
 		statisticsRepo: statisticsRepo,
 		config:         cfg,
 		logger:         logger.WithComponent("statistics"),
+		rebuildJobs:    make(map[string]*StatisticsRebuildJob),
+		rebuildCtx:     rebuildCtx,
+		rebuildCancel:  rebuildCancel,
 	}
 }
 
 // CalculateAll calculates statistics for all strategies and periods
 func (s *StatisticsCalculator) CalculateAll(ctx context.Context) error {
+	s.calculateMu.Lock()
+	defer s.calculateMu.Unlock()
+
+	_, _, err := s.calculateAll(ctx)
+	return err
+}
+
+// calculateAll calculates statistics for all strategies and periods and returns how many
+// statistics were saved and how many failed. The caller must hold calculateMu
+func (s *StatisticsCalculator) calculateAll(ctx context.Context) (int, int, error) {
 	s.logger.Info("Starting statistics calculation")
 	startTime := time.Now()
 
@@ -46,12 +75,12 @@ func (s *StatisticsCalculator) CalculateAll(ctx context.Context) error {
 	// Get all signals
 	allSignals, err := sigRepo.GetAll(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get all signals: %w", err)
+		return 0, 0, fmt.Errorf("failed to get all signals: %w", err)
 	}
 
 	if len(allSignals) == 0 {
 		s.logger.Info("No signals found, skipping statistics calculation")
-		return nil
+		return 0, 0, nil
 	}
 
 	s.logger.Info("Calculating statistics", zap.Int("total_signals", len(allSignals)))
@@ -72,7 +101,7 @@ func (s *StatisticsCalculator) CalculateAll(ctx context.Context) error {
 		// Calculate for each configured period
 		for _, period := range s.config.Periods {
 			// Overall statistics (all symbols)
-			if err := s.calculateForPeriod(ctx, strategyName, nil, signals, period); err != nil {
+			if _, err := s.calculateForPeriod(ctx, strategyName, nil, signals, period); err != nil {
 				s.logger.WithError(err).Error("Failed to calculate overall statistics",
 					zap.String("strategy", strategyName),
 					zap.String("period", period),
@@ -86,7 +115,7 @@ func (s *StatisticsCalculator) CalculateAll(ctx context.Context) error {
 			signalsBySymbol := s.groupSignalsBySymbol(signals)
 			for symbol, symbolSignals := range signalsBySymbol {
 				symbolCopy := symbol
-				if err := s.calculateForPeriod(ctx, strategyName, &symbolCopy, symbolSignals, period); err != nil {
+				if _, err := s.calculateForPeriod(ctx, strategyName, &symbolCopy, symbolSignals, period); err != nil {
 					s.logger.WithError(err).Warn("Failed to calculate symbol statistics",
 						zap.String("strategy", strategyName),
 						zap.String("symbol", symbol),
@@ -107,17 +136,56 @@ func (s *StatisticsCalculator) CalculateAll(ctx context.Context) error {
 		zap.String("duration", duration.String()),
 	)
 
-	return nil
+	return calculated, failed, nil
+}
+
+// Recalculate recomputes and saves statistics for a single strategy, period and optional symbol
+// Returns nil statistics when the strategy has no signals in the period
+func (s *StatisticsCalculator) Recalculate(
+	ctx context.Context,
+	strategyName string,
+	symbol *string,
+	periodLabel string,
+) (*repository.StrategyStatistics, error) {
+	if _, err := utils.ParsePeriodLabel(periodLabel); err != nil {
+		return nil, err
+	}
+
+	sigRepo := *s.signalRepo
+
+	signals, err := sigRepo.GetSignalsByStrategy(ctx, strategyName, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get signals for strategy: %w", err)
+	}
+
+	if symbol != nil {
+		signals = s.groupSignalsBySymbol(signals)[*symbol]
+	}
+
+	stats, err := s.calculateForPeriod(ctx, strategyName, symbol, signals, periodLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Statistics recalculated",
+		zap.String("strategy", strategyName),
+		zap.Stringp("symbol", symbol),
+		zap.String("period", periodLabel),
+		zap.Bool("has_signals", stats != nil),
+	)
+
+	return stats, nil
 }
 
-// calculateForPeriod calculates statistics for a specific period
+// calculateForPeriod calculates and saves statistics for a specific period
+// Returns nil statistics when there are no signals in the period
 func (s *StatisticsCalculator) calculateForPeriod(
 	ctx context.Context,
 	strategyName string,
 	symbol *string,
 	signals []*entity.Signal,
 	periodLabel string,
-) error {
+) (*repository.StrategyStatistics, error) {
 	now := time.Now()
 	periodStart, periodEnd := s.getPeriodRange(now, periodLabel)
 
@@ -126,7 +194,7 @@ func (s *StatisticsCalculator) calculateForPeriod(
 
 	if len(periodSignals) == 0 {
 		// No signals in this period, skip
-		return nil
+		return nil, nil
 	}
 
 	// Calculate metrics
@@ -169,10 +237,10 @@ func (s *StatisticsCalculator) calculateForPeriod(
 
 	// Save statistics
 	if err := s.statisticsRepo.CreateOrUpdate(ctx, stats); err != nil {
-		return fmt.Errorf("failed to save statistics: %w", err)
+		return nil, fmt.Errorf("failed to save statistics: %w", err)
 	}
 
-	return nil
+	return stats, nil
 }
 
 // calculateOutcomeMetrics calculates performance metrics from closed signals
@@ -180,9 +248,22 @@ func (s *StatisticsCalculator) calculateOutcomeMetrics(ctx context.Context, stat
 	var totalProfit decimal.Decimal
 	var totalLoss decimal.Decimal
 	var totalHoldingHours decimal.Decimal
+	var minHolding, maxHolding *decimal.Decimal
+	var holdingHoursList []float64
 	var best *decimal.Decimal
 	var worst *decimal.Decimal
 
+	var totalHoursToTarget decimal.Decimal
+	var targetsReached int
+
+	// Equal-weighted and volume-weighted returns of signals with an outcome
+	var totalReturn decimal.Decimal
+	var returnCount int
+	var totalVolume, profitableVolume, volumeWeightedReturn decimal.Decimal
+
+	// Collected for the percentile distributions
+	var finalReturns, favorableMoves, adverseMoves []decimal.Decimal
+
 	// Extract signal IDs for bulk fetching
 	signalIDs := make([]string, len(signals))
 	for i, signal := range signals {
@@ -209,9 +290,38 @@ func (s *StatisticsCalculator) calculateOutcomeMetrics(ctx context.Context, stat
 			continue
 		}
 
+		if outcome.HoursToProfitTarget != nil {
+			totalHoursToTarget = totalHoursToTarget.Add(decimal.NewFromInt(int64(*outcome.HoursToProfitTarget)))
+			targetsReached++
+		}
+
+		finalReturns = append(finalReturns, outcome.FinalPriceChangePct)
+
+		totalReturn = totalReturn.Add(outcome.FinalPriceChangePct)
+		returnCount++
+
+		// Signals from before the volume was recorded carry no weight
+		if signal.Volume24h.IsPositive() {
+			totalVolume = totalVolume.Add(signal.Volume24h)
+			volumeWeightedReturn = volumeWeightedReturn.Add(outcome.FinalPriceChangePct.Mul(signal.Volume24h))
+			if outcome.Outcome == string(entity.OutcomeProfit) {
+				profitableVolume = profitableVolume.Add(signal.Volume24h)
+			}
+		}
+		favorableMoves = append(favorableMoves, outcome.MaxFavorableMovePct)
+		adverseMoves = append(adverseMoves, outcome.MaxAdverseMovePct)
+
 		// Calculate holding hours from signal generation to close
 		holdingHours := outcome.ClosedAt.Sub(signal.GeneratedAt).Hours()
-		totalHoldingHours = totalHoldingHours.Add(decimal.NewFromFloat(holdingHours))
+		holding := decimal.NewFromFloat(holdingHours)
+		totalHoldingHours = totalHoldingHours.Add(holding)
+		holdingHoursList = append(holdingHoursList, holdingHours)
+		if minHolding == nil || holding.LessThan(*minHolding) {
+			minHolding = &holding
+		}
+		if maxHolding == nil || holding.GreaterThan(*maxHolding) {
+			maxHolding = &holding
+		}
 
 		// Classify based on actual outcome
 		switch outcome.Outcome {
@@ -272,14 +382,111 @@ func (s *StatisticsCalculator) calculateOutcomeMetrics(ctx context.Context, stat
 		stats.WinRate = &winRate
 	}
 
+	if returnCount > 0 {
+		avgReturn := totalReturn.Div(decimal.NewFromInt(int64(returnCount)))
+		stats.AvgReturnPct = &avgReturn
+	}
+
+	if totalVolume.IsPositive() {
+		weightedWinRate := profitableVolume.Div(totalVolume).Mul(decimal.NewFromInt(100))
+		stats.VolumeWeightedWinRate = &weightedWinRate
+
+		weightedAvgReturn := volumeWeightedReturn.Div(totalVolume)
+		stats.VolumeWeightedAvgReturnPct = &weightedAvgReturn
+	}
+
+	if targetsReached > 0 {
+		avgHoursToTarget := totalHoursToTarget.Div(decimal.NewFromInt(int64(targetsReached)))
+		stats.AvgHoursToTarget = &avgHoursToTarget
+	}
+
 	stats.BestSignalPct = best
 	stats.WorstSignalPct = worst
 
+	stats.MinHoldingHours = minHolding
+	stats.MaxHoldingHours = maxHolding
+
 	// Profit factor
 	if !totalLoss.IsZero() {
 		profitFactor := totalProfit.Div(totalLoss)
 		stats.ProfitFactor = &profitFactor
 	}
+
+	// Return distribution
+	if len(finalReturns) > 0 && len(s.config.Percentiles) > 0 {
+		stats.Percentiles = &repository.ReturnPercentiles{
+			FinalPriceChangePct: calculatePercentiles(finalReturns, s.config.Percentiles),
+			MaxFavorableMovePct: calculatePercentiles(favorableMoves, s.config.Percentiles),
+			MaxAdverseMovePct:   calculatePercentiles(adverseMoves, s.config.Percentiles),
+		}
+	}
+
+	// Holding time distribution
+	if len(holdingHoursList) > 0 && len(s.config.HoldingHistogram) > 0 {
+		stats.HoldingTimeHistogram = calculateHoldingHistogram(holdingHoursList, s.config.HoldingHistogram)
+	}
+}
+
+// calculateHoldingHistogram counts holding times into buckets split at the given ascending
+// upper bounds, plus an open-ended bucket for holding times beyond the last bound
+func calculateHoldingHistogram(hours []float64, bounds []float64) []repository.HoldingTimeBucket {
+	buckets := make([]repository.HoldingTimeBucket, len(bounds)+1)
+	lower := 0.0
+	for i := range bounds {
+		upper := bounds[i]
+		buckets[i] = repository.HoldingTimeBucket{MinHours: lower, MaxHours: &upper}
+		lower = upper
+	}
+	buckets[len(bounds)] = repository.HoldingTimeBucket{MinHours: lower}
+
+	for _, h := range hours {
+		i := sort.SearchFloat64s(bounds, h)
+		// SearchFloat64s returns the index of an equal bound, which belongs to the next bucket
+		if i < len(bounds) && bounds[i] == h {
+			i++
+		}
+		buckets[i].Count++
+	}
+	return buckets
+}
+
+// calculatePercentiles returns the requested percentiles (0-100) of values
+func calculatePercentiles(values []decimal.Decimal, percentiles []int) map[int]decimal.Decimal {
+	sorted := make([]decimal.Decimal, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LessThan(sorted[j])
+	})
+
+	result := make(map[int]decimal.Decimal, len(percentiles))
+	for _, p := range percentiles {
+		result[p] = percentile(sorted, p).Round(4)
+	}
+	return result
+}
+
+// percentile returns the p-th percentile of sorted values, linearly interpolating
+// between the two closest ranks (the same method as Excel PERCENTILE.INC)
+func percentile(sorted []decimal.Decimal, p int) decimal.Decimal {
+	if len(sorted) == 0 {
+		return decimal.Zero
+	}
+	if p <= 0 {
+		return sorted[0]
+	}
+	if p >= 100 {
+		return sorted[len(sorted)-1]
+	}
+
+	// Fractional rank in [0, n-1]
+	rank := decimal.NewFromInt(int64(p)).Div(decimal.NewFromInt(100)).Mul(decimal.NewFromInt(int64(len(sorted) - 1)))
+	lower := int(rank.IntPart())
+	if lower >= len(sorted)-1 {
+		return sorted[len(sorted)-1]
+	}
+
+	weight := rank.Sub(decimal.NewFromInt(int64(lower)))
+	return sorted[lower].Add(sorted[lower+1].Sub(sorted[lower]).Mul(weight))
 }
 
 // groupSignalsByStrategy groups signals by strategy name
@@ -323,21 +530,18 @@ func (s *StatisticsCalculator) filterClosedSignals(signals []*entity.Signal) []*
 }
 
 // getPeriodRange returns the start and end time for a period label
+// Labels are parsed as durations (e.g. "4h", "7d", "2w"); "all" has no lower bound
 func (s *StatisticsCalculator) getPeriodRange(now time.Time, periodLabel string) (time.Time, time.Time) {
-	switch periodLabel {
-	case "24h":
+	duration, err := utils.ParsePeriodLabel(periodLabel)
+	if err != nil {
+		// Default to 24h
 		return now.Add(-24 * time.Hour), now
-	case "7d":
-		return now.Add(-7 * 24 * time.Hour), now
-	case "30d":
-		return now.Add(-30 * 24 * time.Hour), now
-	case "all":
+	}
+	if duration == 0 {
 		// Use a very old date for "all"
 		return time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), now
-	default:
-		// Default to 24h
-		return now.Add(-24 * time.Hour), now
 	}
+	return now.Add(-duration), now
 }
 
 // calculateKlineMetrics calculates kline-based win rate and performance metrics
@@ -355,6 +559,9 @@ func (s *StatisticsCalculator) calculateKlineMetrics(ctx context.Context, stats
 	var sumMaxProfit decimal.Decimal
 	var sumMaxLoss decimal.Decimal
 
+	var sumOptimalExitHours int
+	var optimalExitSignals int
+
 	// Process each closed signal
 	for _, signal := range signals {
 		if signal.Status != entity.SignalStatusClosed {
@@ -370,6 +577,12 @@ func (s *StatisticsCalculator) calculateKlineMetrics(ctx context.Context, stats
 			continue
 		}
 
+		// Hour at which closing would have captured the most profit
+		if hours, _, ok := entity.OptimalExit(signal, klines); ok {
+			sumOptimalExitHours += hours
+			optimalExitSignals++
+		}
+
 		// Process each kline tracking record
 		for _, kline := range klines {
 			totalKlineHours++
@@ -438,5 +651,12 @@ func (s *StatisticsCalculator) calculateKlineMetrics(ctx context.Context, stats
 		stats.AvgMaxPotentialLossPct = &avgMaxLoss
 	}
 
+	if optimalExitSignals > 0 {
+		avgOptimalExitHours := decimal.NewFromInt(int64(sumOptimalExitHours)).
+			Div(decimal.NewFromInt(int64(optimalExitSignals))).
+			Round(2)
+		stats.AvgOptimalExitHours = &avgOptimalExitHours
+	}
+
 	return nil
 }
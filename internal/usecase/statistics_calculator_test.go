@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func decimals(values ...float64) []decimal.Decimal {
+	result := make([]decimal.Decimal, len(values))
+	for i, v := range values {
+		result[i] = decimal.NewFromFloat(v)
+	}
+	return result
+}
+
+func TestCalculatePercentiles(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []decimal.Decimal
+		want   map[int]string
+	}{
+		{
+			name:   "unsorted input",
+			values: decimals(5, 1, 4, 2, 3),
+			want:   map[int]string{0: "1", 25: "2", 50: "3", 75: "4", 90: "4.6", 100: "5"},
+		},
+		{
+			name:   "single value",
+			values: decimals(7),
+			want:   map[int]string{0: "7", 50: "7", 99: "7", 100: "7"},
+		},
+		{
+			name:   "interpolation between ranks",
+			values: decimals(20, 10),
+			want:   map[int]string{25: "12.5", 50: "15", 90: "19"},
+		},
+		{
+			name:   "negative returns",
+			values: decimals(-3, 2, -1, 0),
+			want:   map[int]string{0: "-3", 10: "-2.4", 50: "-0.5", 100: "2"},
+		},
+		{
+			name:   "rounded to 4 places",
+			values: decimals(1, 2, 3),
+			want:   map[int]string{33: "1.66", 67: "2.34", 1: "1.02"},
+		},
+		{
+			name: "no values",
+			want: map[int]string{0: "0", 50: "0", 100: "0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ps := make([]int, 0, len(tt.want))
+			for p := range tt.want {
+				ps = append(ps, p)
+			}
+			input := append([]decimal.Decimal(nil), tt.values...)
+
+			got := calculatePercentiles(tt.values, ps)
+
+			for p, want := range tt.want {
+				if !got[p].Equal(decimal.RequireFromString(want)) {
+					t.Errorf("p%d = %s, want %s", p, got[p], want)
+				}
+			}
+			for i := range input {
+				if !tt.values[i].Equal(input[i]) {
+					t.Fatalf("input was reordered: %v, want %v", tt.values, input)
+				}
+			}
+		})
+	}
+}
+
+func TestPercentileOutOfRange(t *testing.T) {
+	sorted := decimals(1, 2, 3)
+	if got := percentile(sorted, -10); !got.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("percentile(-10) = %s, want the minimum", got)
+	}
+	if got := percentile(sorted, 150); !got.Equal(decimal.NewFromInt(3)) {
+		t.Errorf("percentile(150) = %s, want the maximum", got)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"ContractAnalysis/config"
 	"ContractAnalysis/internal/domain/repository"
 	"ContractAnalysis/internal/infrastructure/logger"
+	"ContractAnalysis/internal/infrastructure/notification"
 
 	"github.com/shopspring/decimal"
 	"go.uber.org/zap"
@@ -16,6 +17,8 @@ import (
 // StatisticsMonitor monitors statistics changes and alerts on significant variations
 type StatisticsMonitor struct {
 	statisticsRepo repository.StatisticsRepository
+	alertRepo      repository.StatisticsAlertRepository
+	notifier       *notification.NotificationDispatcher
 	config         config.StatisticsMonitoringConfig
 	logger         *logger.Logger
 }
@@ -31,12 +34,17 @@ type MetricChange struct {
 }
 
 // NewStatisticsMonitor creates a new statistics monitor
+// Detected changes are persisted to alertRepo and dispatched through notifier; either may be nil
 func NewStatisticsMonitor(
 	statisticsRepo repository.StatisticsRepository,
+	alertRepo repository.StatisticsAlertRepository,
+	notifier *notification.NotificationDispatcher,
 	config config.StatisticsMonitoringConfig,
 ) *StatisticsMonitor {
 	return &StatisticsMonitor{
 		statisticsRepo: statisticsRepo,
+		alertRepo:      alertRepo,
+		notifier:       notifier,
 		config:         config,
 		logger:         logger.WithComponent("statistics_monitor"),
 	}
@@ -116,6 +124,8 @@ func (m *StatisticsMonitor) MonitorChanges(
 
 	if len(changes) > 0 {
 		m.logChanges(current, previous, changes)
+		m.saveAlerts(ctx, current, previous, changes)
+		m.notifyChanges(ctx, current, previous, changes)
 	}
 
 	return changes, nil
@@ -127,10 +137,13 @@ func (m *StatisticsMonitor) detectSignificantChanges(
 ) []MetricChange {
 	var changes []MetricChange
 
+	// Strategy-specific overrides take precedence over the global thresholds
+	thresholds := m.config.ForStrategy(current.StrategyName)
+
 	// Check Win Rate (percentage point change)
 	if current.WinRate != nil && previous.WinRate != nil {
 		change := current.WinRate.Sub(*previous.WinRate).InexactFloat64()
-		if math.Abs(change) >= m.config.WinRateChangeThreshold {
+		if math.Abs(change) >= thresholds.WinRateChangeThreshold {
 			changes = append(changes, MetricChange{
 				MetricName:    "Win Rate",
 				PreviousValue: fmt.Sprintf("%.2f%%", previous.WinRate.InexactFloat64()),
@@ -148,7 +161,7 @@ func (m *StatisticsMonitor) detectSignificantChanges(
 		currRatio := float64(current.ProfitableSignals) / float64(current.TotalSignals) * 100
 		change := currRatio - prevRatio
 
-		if math.Abs(change) >= m.config.ProfitRatioChangeThreshold {
+		if math.Abs(change) >= thresholds.ProfitRatioChangeThreshold {
 			changes = append(changes, MetricChange{
 				MetricName:    "Profitable Signals Ratio",
 				PreviousValue: fmt.Sprintf("%.2f%%", prevRatio),
@@ -167,7 +180,7 @@ func (m *StatisticsMonitor) detectSignificantChanges(
 			Mul(decimal.NewFromInt(100)).
 			InexactFloat64()
 
-		if math.Abs(percentChange) >= m.config.AvgProfitChangeThreshold {
+		if math.Abs(percentChange) >= thresholds.AvgProfitChangeThreshold {
 			changes = append(changes, MetricChange{
 				MetricName:    "Average Profit",
 				PreviousValue: fmt.Sprintf("%.2f%%", previous.AvgProfitPct.InexactFloat64()),
@@ -186,7 +199,7 @@ func (m *StatisticsMonitor) detectSignificantChanges(
 			Mul(decimal.NewFromInt(100)).
 			InexactFloat64()
 
-		if math.Abs(percentChange) >= m.config.AvgLossChangeThreshold {
+		if math.Abs(percentChange) >= thresholds.AvgLossChangeThreshold {
 			changes = append(changes, MetricChange{
 				MetricName:    "Average Loss",
 				PreviousValue: fmt.Sprintf("%.2f%%", previous.AvgLossPct.InexactFloat64()),
@@ -205,7 +218,7 @@ func (m *StatisticsMonitor) detectSignificantChanges(
 			Mul(decimal.NewFromInt(100)).
 			InexactFloat64()
 
-		if math.Abs(percentChange) >= m.config.ProfitFactorChangeThreshold {
+		if math.Abs(percentChange) >= thresholds.ProfitFactorChangeThreshold {
 			changes = append(changes, MetricChange{
 				MetricName:    "Profit Factor",
 				PreviousValue: fmt.Sprintf("%.2f", previous.ProfitFactor.InexactFloat64()),
@@ -221,7 +234,7 @@ func (m *StatisticsMonitor) detectSignificantChanges(
 	if previous.TotalSignals > 0 {
 		percentChange := float64(current.TotalSignals-previous.TotalSignals) / float64(previous.TotalSignals) * 100
 
-		if math.Abs(percentChange) >= m.config.SignalCountChangeThreshold {
+		if math.Abs(percentChange) >= thresholds.SignalCountChangeThreshold {
 			changes = append(changes, MetricChange{
 				MetricName:    "Total Signals",
 				PreviousValue: fmt.Sprintf("%d", previous.TotalSignals),
@@ -236,6 +249,84 @@ func (m *StatisticsMonitor) detectSignificantChanges(
 	return changes
 }
 
+// saveAlerts persists detected changes as statistics alerts
+// Failures are logged only, so that monitoring of the remaining statistics continues
+func (m *StatisticsMonitor) saveAlerts(
+	ctx context.Context,
+	current, previous *repository.StrategyStatistics,
+	changes []MetricChange,
+) {
+	if m.alertRepo == nil {
+		return
+	}
+
+	alerts := make([]*repository.StatisticsAlert, len(changes))
+	for i, change := range changes {
+		alerts[i] = &repository.StatisticsAlert{
+			StrategyName:         current.StrategyName,
+			Symbol:               current.Symbol,
+			PeriodLabel:          current.PeriodLabel,
+			MetricName:           change.MetricName,
+			PreviousValue:        change.PreviousValue,
+			CurrentValue:         change.CurrentValue,
+			Change:               decimal.NewFromFloat(change.Change).Round(4),
+			ChangeType:           change.ChangeType,
+			PreviousCalculatedAt: previous.CalculatedAt,
+			CurrentCalculatedAt:  current.CalculatedAt,
+		}
+	}
+
+	if err := m.alertRepo.CreateBatch(ctx, alerts); err != nil {
+		m.logger.WithError(err).Warn("Failed to save statistics alerts",
+			zap.String("strategy", current.StrategyName),
+			zap.String("period", current.PeriodLabel))
+	}
+}
+
+// notifyChanges dispatches detected changes as a statistics change notification
+func (m *StatisticsMonitor) notifyChanges(
+	ctx context.Context,
+	current, previous *repository.StrategyStatistics,
+	changes []MetricChange,
+) {
+	if m.notifier == nil {
+		return
+	}
+
+	symbolStr := "ALL"
+	if current.Symbol != nil {
+		symbolStr = *current.Symbol
+	}
+
+	metricChanges := make([]map[string]interface{}, len(changes))
+	for i, change := range changes {
+		metricChanges[i] = map[string]interface{}{
+			"metric_name":    change.MetricName,
+			"previous_value": change.PreviousValue,
+			"current_value":  change.CurrentValue,
+			"change":         change.Change,
+			"change_type":    change.ChangeType,
+		}
+	}
+
+	message := fmt.Sprintf("%d significant statistics change(s) for %s (%s, %s)",
+		len(changes), current.StrategyName, symbolStr, current.PeriodLabel)
+	metadata := map[string]interface{}{
+		"strategy":               current.StrategyName,
+		"symbol":                 symbolStr,
+		"period":                 current.PeriodLabel,
+		"previous_calculated_at": previous.CalculatedAt,
+		"current_calculated_at":  current.CalculatedAt,
+		"changes":                metricChanges,
+	}
+
+	if err := m.notifier.NotifyStatisticsChange(ctx, message, metadata); err != nil {
+		m.logger.WithError(err).Warn("Failed to send statistics change notification",
+			zap.String("strategy", current.StrategyName),
+			zap.String("period", current.PeriodLabel))
+	}
+}
+
 // logChanges logs detected changes in a formatted message
 func (m *StatisticsMonitor) logChanges(
 	current, previous *repository.StrategyStatistics,
@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// StatisticsRebuildStatus is the state of a statistics rebuild job
+type StatisticsRebuildStatus string
+
+const (
+	StatisticsRebuildRunning   StatisticsRebuildStatus = "running"
+	StatisticsRebuildCompleted StatisticsRebuildStatus = "completed"
+	StatisticsRebuildFailed    StatisticsRebuildStatus = "failed"
+)
+
+// maxRebuildJobs is how many finished rebuild jobs are kept for status queries
+const maxRebuildJobs = 20
+
+// StatisticsRebuildJob describes a full recalculation of the strategy statistics and the purge
+// of the records it replaced
+type StatisticsRebuildJob struct {
+	ID          string
+	Status      StatisticsRebuildStatus
+	StartedAt   time.Time
+	FinishedAt  *time.Time
+	DeletedRows int64  // Old statistics rows purged after recalculating
+	Calculated  int    // Statistics saved by the recalculation
+	Failed      int    // Statistics that failed to calculate
+	Error       string // Set when the job failed
+}
+
+// StartRebuild starts recalculating all statistics from the signals in the background and
+// then purging every record the recalculation didn't write, so rows written by an older
+// calculation don't linger when their keys are no longer produced. The old records are
+// only purged once every statistic was recalculated, a failed rebuild leaves them in place
+// If a rebuild is already running it is returned instead of starting another; started
+// reports whether a new job was started
+func (s *StatisticsCalculator) StartRebuild() (job *StatisticsRebuildJob, started bool) {
+	s.rebuildMu.Lock()
+	defer s.rebuildMu.Unlock()
+
+	if s.runningRebuild != nil {
+		running := *s.runningRebuild
+		return &running, false
+	}
+
+	job = &StatisticsRebuildJob{
+		ID:        uuid.New().String(),
+		Status:    StatisticsRebuildRunning,
+		StartedAt: time.Now(),
+	}
+	s.rebuildJobs[job.ID] = job
+	s.runningRebuild = job
+	s.pruneRebuildJobsLocked()
+
+	s.rebuildWG.Add(1)
+	go func(job *StatisticsRebuildJob) {
+		defer s.rebuildWG.Done()
+		s.runRebuild(s.rebuildCtx, job)
+	}(job)
+
+	snapshot := *job
+	return &snapshot, true
+}
+
+// StopRebuilds cancels a running rebuild and waits for it to finish until ctx is done
+// Call it once the API server stopped accepting requests, before closing the database
+func (s *StatisticsCalculator) StopRebuilds(ctx context.Context) error {
+	s.rebuildCancel()
+
+	done := make(chan struct{})
+	go func() {
+		s.rebuildWG.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("statistics rebuild still running: %w", ctx.Err())
+	}
+}
+
+// GetRebuild returns a rebuild job by ID, nil if it is unknown or was pruned
+func (s *StatisticsCalculator) GetRebuild(id string) *StatisticsRebuildJob {
+	s.rebuildMu.Lock()
+	defer s.rebuildMu.Unlock()
+
+	job, ok := s.rebuildJobs[id]
+	if !ok {
+		return nil
+	}
+	snapshot := *job
+	return &snapshot
+}
+
+// runRebuild recalculates the statistics and purges the records it didn't write, recording
+// the outcome on the job. It runs detached from the request that started it, until ctx is
+// cancelled on shutdown
+func (s *StatisticsCalculator) runRebuild(ctx context.Context, job *StatisticsRebuildJob) {
+	s.calculateMu.Lock()
+	defer s.calculateMu.Unlock()
+
+	s.logger.Info("Starting statistics rebuild", zap.String("job_id", job.ID))
+
+	calculated, failed, err := s.calculateAll(ctx)
+	if err == nil && failed > 0 {
+		err = fmt.Errorf("%d statistics failed to calculate, old statistics were kept", failed)
+	}
+	if err != nil {
+		s.finishRebuild(job, 0, calculated, failed, err)
+		return
+	}
+
+	// Every current record was written by this run, with a calculation time after the start
+	// The cutoff is truncated to whole seconds, the precision calculated_at is stored with
+	deleted, err := s.statisticsRepo.DeleteOlderThan(ctx, job.StartedAt.Truncate(time.Second))
+	s.finishRebuild(job, deleted, calculated, failed, err)
+}
+
+// finishRebuild records the outcome of a rebuild job and releases the running slot
+func (s *StatisticsCalculator) finishRebuild(job *StatisticsRebuildJob, deleted int64, calculated, failed int, err error) {
+	s.rebuildMu.Lock()
+	defer s.rebuildMu.Unlock()
+
+	now := time.Now()
+	job.FinishedAt = &now
+	job.DeletedRows = deleted
+	job.Calculated = calculated
+	job.Failed = failed
+	job.Status = StatisticsRebuildCompleted
+	if err != nil {
+		job.Status = StatisticsRebuildFailed
+		job.Error = err.Error()
+	}
+	s.runningRebuild = nil
+
+	log := s.logger.WithFields(
+		zap.String("job_id", job.ID),
+		zap.Int64("deleted_rows", deleted),
+		zap.Int("calculated", calculated),
+		zap.Int("failed", failed),
+		zap.String("duration", now.Sub(job.StartedAt).String()),
+	)
+	if err != nil {
+		log.WithError(err).Error("Statistics rebuild failed")
+		return
+	}
+	log.Info("Statistics rebuild completed")
+}
+
+// pruneRebuildJobsLocked drops the oldest finished jobs beyond maxRebuildJobs
+// The caller must hold rebuildMu
+func (s *StatisticsCalculator) pruneRebuildJobsLocked() {
+	if len(s.rebuildJobs) <= maxRebuildJobs {
+		return
+	}
+
+	finished := make([]*StatisticsRebuildJob, 0, len(s.rebuildJobs))
+	for _, job := range s.rebuildJobs {
+		if job.Status != StatisticsRebuildRunning {
+			finished = append(finished, job)
+		}
+	}
+	sort.Slice(finished, func(i, j int) bool {
+		return finished[i].StartedAt.Before(finished[j].StartedAt)
+	})
+
+	for _, job := range finished[:min(len(finished), len(s.rebuildJobs)-maxRebuildJobs)] {
+		delete(s.rebuildJobs, job.ID)
+	}
+}
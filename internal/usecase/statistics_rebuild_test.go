@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"ContractAnalysis/config"
+	"ContractAnalysis/internal/domain/entity"
+	"ContractAnalysis/internal/domain/repository"
+)
+
+// blockingSignalRepo holds GetAll until release is closed or ctx is done
+type blockingSignalRepo struct {
+	*memSignalRepo
+	entered chan struct{}
+	release chan struct{}
+}
+
+func (r *blockingSignalRepo) GetAll(ctx context.Context) ([]*entity.Signal, error) {
+	r.entered <- struct{}{}
+	select {
+	case <-r.release:
+		return r.memSignalRepo.GetAll(ctx)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func newTestStatisticsCalculator(signals repository.SignalRepository, stats *memStatisticsRepo) *StatisticsCalculator {
+	return NewStatisticsCalculator(&signals, stats, config.StatisticsConfig{Periods: []string{"24h", "all"}})
+}
+
+// waitRebuild polls a rebuild job until it leaves the running state
+func waitRebuild(t *testing.T, s *StatisticsCalculator, id string) *StatisticsRebuildJob {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if job := s.GetRebuild(id); job != nil && job.Status != StatisticsRebuildRunning {
+			return job
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("rebuild %s still running", id)
+	return nil
+}
+
+func TestStatisticsRebuild(t *testing.T) {
+	strategy := newTestMinorityStrategy()
+	stale := &repository.StrategyStatistics{StrategyName: strategy.Name(), PeriodLabel: "7d", CalculatedAt: time.Now().Add(-time.Hour)}
+
+	tests := []struct {
+		name        string
+		saveErr     error
+		wantStatus  StatisticsRebuildStatus
+		wantPurged  bool
+		wantDeleted int64
+	}{
+		{"success purges the replaced rows", nil, StatisticsRebuildCompleted, true, 1},
+		{"failure keeps the old rows", errors.New("db down"), StatisticsRebuildFailed, false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signals := newMemSignalRepo()
+			signals.signals = []*entity.Signal{testSignal(strategy, "BTCUSDT", entity.SignalTypeLong)}
+			stats := &memStatisticsRepo{stats: []*repository.StrategyStatistics{stale}, saveErr: tt.saveErr}
+			s := newTestStatisticsCalculator(signals, stats)
+
+			job, started := s.StartRebuild()
+			if !started || job.Status != StatisticsRebuildRunning {
+				t.Fatalf("StartRebuild = %s, started %v, want a running job", job.Status, started)
+			}
+
+			job = waitRebuild(t, s, job.ID)
+			if job.Status != tt.wantStatus {
+				t.Fatalf("status = %s (%s), want %s", job.Status, job.Error, tt.wantStatus)
+			}
+			if job.FinishedAt == nil {
+				t.Error("FinishedAt not set")
+			}
+			if job.DeletedRows != tt.wantDeleted {
+				t.Errorf("DeletedRows = %d, want %d", job.DeletedRows, tt.wantDeleted)
+			}
+			if purged := len(stats.deletions) > 0; purged != tt.wantPurged {
+				t.Errorf("purged = %v, want %v", purged, tt.wantPurged)
+			}
+
+			keptStale := false
+			for _, row := range stats.stats {
+				keptStale = keptStale || row == stale
+			}
+			if keptStale == tt.wantPurged {
+				t.Errorf("stale row kept = %v, want %v", keptStale, !tt.wantPurged)
+			}
+
+			// A finished job frees the slot for the next rebuild
+			next, started := s.StartRebuild()
+			if !started || next.ID == job.ID {
+				t.Errorf("StartRebuild after finish: started %v, job %s, want a new job", started, next.ID)
+			}
+			waitRebuild(t, s, next.ID)
+		})
+	}
+}
+
+func TestStatisticsRebuildRejectsConcurrentStart(t *testing.T) {
+	signals := &blockingSignalRepo{memSignalRepo: newMemSignalRepo(), entered: make(chan struct{}, 1), release: make(chan struct{})}
+	s := newTestStatisticsCalculator(signals, &memStatisticsRepo{})
+
+	first, started := s.StartRebuild()
+	if !started {
+		t.Fatal("first StartRebuild did not start a job")
+	}
+	<-signals.entered
+
+	second, started := s.StartRebuild()
+	if started {
+		t.Error("second StartRebuild started a job while one is running")
+	}
+	if second.ID != first.ID || second.Status != StatisticsRebuildRunning {
+		t.Errorf("second StartRebuild = %s (%s), want the running job %s", second.ID, second.Status, first.ID)
+	}
+
+	close(signals.release)
+	if job := waitRebuild(t, s, first.ID); job.Status != StatisticsRebuildCompleted {
+		t.Errorf("status = %s (%s), want completed", job.Status, job.Error)
+	}
+}
+
+func TestStopRebuildsCancelsRunningJob(t *testing.T) {
+	signals := &blockingSignalRepo{memSignalRepo: newMemSignalRepo(), entered: make(chan struct{}, 1), release: make(chan struct{})}
+	stats := &memStatisticsRepo{}
+	s := newTestStatisticsCalculator(signals, stats)
+
+	job, _ := s.StartRebuild()
+	<-signals.entered
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.StopRebuilds(ctx); err != nil {
+		t.Fatalf("StopRebuilds: %v", err)
+	}
+
+	// StopRebuilds returns only once the job finished
+	job = s.GetRebuild(job.ID)
+	if job.Status != StatisticsRebuildFailed {
+		t.Errorf("status = %s, want failed", job.Status)
+	}
+	if len(stats.deletions) > 0 {
+		t.Error("cancelled rebuild purged statistics")
+	}
+}
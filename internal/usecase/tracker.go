@@ -9,26 +9,45 @@ import (
 	"ContractAnalysis/internal/domain/repository"
 	"ContractAnalysis/internal/infrastructure/binance"
 	"ContractAnalysis/internal/infrastructure/logger"
+	"ContractAnalysis/internal/infrastructure/notification"
 
 	"github.com/shopspring/decimal"
 	"go.uber.org/zap"
 )
 
+// Tracking history modes
+const (
+	TrackingHistoryFull   = "full"   // Write a tracking row on every run
+	TrackingHistoryLatest = "latest" // Refresh the latest row, adding a row only on a new peak or trough
+)
+
 // Tracker orchestrates signal tracking and outcome calculation
 type Tracker struct {
 	binanceClient *binance.Client
+	priceSource   repository.PriceSource // Price TP/SL and unrealized PnL are evaluated on
+	historyMode   string                 // TrackingHistoryFull or TrackingHistoryLatest
 	signalRepo    *repository.SignalRepository
+	notifier      *notification.NotificationDispatcher
 	logger        *logger.Logger
 }
 
 // NewTracker creates a new tracker
+// priceSource selects the price (last or mark) stops and targets are checked against
+// historyMode is TrackingHistoryFull or TrackingHistoryLatest
+// notifier may be nil, in which case no outcome notifications are sent
 func NewTracker(
 	binanceClient *binance.Client,
+	priceSource repository.PriceSource,
+	historyMode string,
 	signalRepo *repository.SignalRepository,
+	notifier *notification.NotificationDispatcher,
 ) *Tracker {
 	return &Tracker{
 		binanceClient: binanceClient,
+		priceSource:   priceSource,
+		historyMode:   historyMode,
 		signalRepo:    signalRepo,
+		notifier:      notifier,
 		logger:        logger.WithComponent("tracker"),
 	}
 }
@@ -61,11 +80,14 @@ func (t *Tracker) TrackAll(ctx context.Context) error {
 	t.logger.Info("Tracking signals", zap.Int("count", len(allSignals)))
 
 	tracked := 0
-	completed := 0
 	failed := 0
 
+	// Outcomes of the signals closing in this run are written together afterwards
+	var closing []*closingSignal
+
 	for _, signal := range allSignals {
-		if err := t.trackSignal(ctx, signal); err != nil {
+		closed, err := t.trackSignal(ctx, signal)
+		if err != nil {
 			t.logger.WithError(err).WithSignalID(signal.SignalID).Warn("Failed to track signal")
 			failed++
 			continue
@@ -73,21 +95,74 @@ func (t *Tracker) TrackAll(ctx context.Context) error {
 
 		tracked++
 
-		// Check if signal is completed
-		if signal.Status == entity.SignalStatusClosed {
-			completed++
+		if closed != nil {
+			closing = append(closing, closed)
 		}
 	}
 
+	completed, err := t.closeSignals(ctx, closing)
+
 	duration := time.Since(startTime)
 	t.logger.Info("Signal tracking completed",
 		zap.Int("tracked", tracked),
 		zap.Int("completed", completed),
-		zap.Int("failed", failed),
+		zap.Int("failed", failed+len(closing)-completed),
 		zap.String("duration", duration.String()),
 	)
 
-	return nil
+	return err
+}
+
+// closingSignal is a signal that met a close condition, with the outcome to store for it
+type closingSignal struct {
+	signal  *entity.Signal
+	outcome *entity.SignalOutcome
+	reason  string
+}
+
+// closeSignals stores the outcomes of closing signals in a single batch, then saves each
+// signal as closed and sends its outcome notification
+// Signals stay open in the database if their outcomes can't be stored, so the next
+// tracking run closes them again. Returns the number of signals closed
+func (t *Tracker) closeSignals(ctx context.Context, closing []*closingSignal) (int, error) {
+	if len(closing) == 0 {
+		return 0, nil
+	}
+
+	sigRepo := *t.signalRepo
+
+	outcomes := make([]*entity.SignalOutcome, len(closing))
+	for i, c := range closing {
+		outcomes[i] = c.outcome
+	}
+
+	if err := sigRepo.CreateOutcomesBatch(ctx, outcomes); err != nil {
+		return 0, fmt.Errorf("failed to create outcomes: %w", err)
+	}
+
+	closed := 0
+	for _, c := range closing {
+		if err := sigRepo.Update(ctx, c.signal); err != nil {
+			t.logger.WithError(err).WithSignalID(c.signal.SignalID).Warn("Failed to update closed signal")
+			continue
+		}
+		closed++
+
+		t.logger.Info("Signal closed",
+			zap.String("signal_id", c.signal.SignalID),
+			zap.String("reason", c.reason),
+			zap.String("outcome", c.outcome.Outcome),
+			zap.String("final_change", c.outcome.FinalPriceChangePct.String()),
+		)
+
+		if t.notifier != nil {
+			if err := t.notifier.NotifySignalOutcome(ctx, c.signal, c.outcome); err != nil {
+				t.logger.WithError(err).WithSignalID(c.signal.SignalID).Warn("Failed to send outcome notification")
+			}
+		}
+	}
+
+	return closed, nil
 }
 
 // TrackSignal tracks a specific signal
@@ -103,17 +178,30 @@ func (t *Tracker) TrackSignal(ctx context.Context, signalID string) error {
 		return fmt.Errorf("signal not found: %s", signalID)
 	}
 
-	return t.trackSignal(ctx, signal)
+	closed, err := t.trackSignal(ctx, signal)
+	if err != nil {
+		return err
+	}
+
+	if closed != nil {
+		if _, err := t.closeSignals(ctx, []*closingSignal{closed}); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // trackSignal tracks a signal and updates its status
-func (t *Tracker) trackSignal(ctx context.Context, signal *entity.Signal) error {
+// If the signal meets a close condition it is closed in memory and returned with its
+// outcome, for the caller to store with closeSignals
+func (t *Tracker) trackSignal(ctx context.Context, signal *entity.Signal) (*closingSignal, error) {
 	sigRepo := *t.signalRepo
 
-	// Get current price
-	currentPrice, err := t.binanceClient.GetPrice(ctx, signal.Symbol)
+	// Get current price from the configured source
+	currentPrice, err := t.priceSource.GetPrice(ctx, signal.Symbol)
 	if err != nil {
-		return fmt.Errorf("failed to get current price: %w", err)
+		return nil, fmt.Errorf("failed to get current %s price: %w", t.priceSource.Name(), err)
 	}
 
 	currentPriceDecimal := decimal.NewFromFloat(currentPrice)
@@ -121,7 +209,7 @@ func (t *Tracker) trackSignal(ctx context.Context, signal *entity.Signal) error
 	// Get latest tracking record
 	latestTracking, err := sigRepo.GetLatestTracking(ctx, signal.SignalID)
 	if err != nil {
-		return fmt.Errorf("failed to get latest tracking: %w", err)
+		return nil, fmt.Errorf("failed to get latest tracking: %w", err)
 	}
 
 	// Calculate price change
@@ -129,6 +217,7 @@ func (t *Tracker) trackSignal(ctx context.Context, signal *entity.Signal) error
 
 	// Create or update tracking record
 	var tracking *entity.SignalTracking
+	newExtreme := false
 	if latestTracking == nil {
 		// First tracking record
 		tracking = entity.NewSignalTracking(signal.SignalID, signal, currentPriceDecimal)
@@ -145,12 +234,20 @@ func (t *Tracker) trackSignal(ctx context.Context, signal *entity.Signal) error
 		tracking.LowestPriceAt = latestTracking.LowestPriceAt
 
 		// Update if new peak or trough
-		tracking.UpdatePeakTrough(currentPriceDecimal, priceChangePct)
+		newExtreme = tracking.UpdatePeakTrough(currentPriceDecimal, priceChangePct)
 	}
 
-	// Save tracking record
-	if err := sigRepo.CreateTracking(ctx, tracking); err != nil {
-		return fmt.Errorf("failed to create tracking: %w", err)
+	// Save tracking record. In latest-only mode the latest row is refreshed in place and
+	// a new row is only written when a new peak or trough is set, so the latest row
+	// always carries the peak/trough the outcome is calculated from
+	if latestTracking != nil && !newExtreme && t.historyMode == TrackingHistoryLatest {
+		tracking.ID = latestTracking.ID
+		tracking.CreatedAt = latestTracking.CreatedAt
+		if err := sigRepo.UpdateTracking(ctx, tracking); err != nil {
+			return nil, fmt.Errorf("failed to update tracking: %w", err)
+		}
+	} else if err := sigRepo.CreateTracking(ctx, tracking); err != nil {
+		return nil, fmt.Errorf("failed to create tracking: %w", err)
 	}
 
 	t.logger.Debug("Tracking updated",
@@ -162,10 +259,10 @@ func (t *Tracker) trackSignal(ctx context.Context, signal *entity.Signal) error
 	if signal.Status == entity.SignalStatusConfirmed {
 		// Start tracking
 		if err := signal.StartTracking(); err != nil {
-			return fmt.Errorf("failed to start tracking: %w", err)
+			return nil, fmt.Errorf("failed to start tracking: %w", err)
 		}
 		if err := sigRepo.Update(ctx, signal); err != nil {
-			return fmt.Errorf("failed to update signal: %w", err)
+			return nil, fmt.Errorf("failed to update signal: %w", err)
 		}
 		t.logger.Info("Signal tracking started", zap.String("signal_id", signal.SignalID))
 	}
@@ -174,7 +271,7 @@ func (t *Tracker) trackSignal(ctx context.Context, signal *entity.Signal) error
 	// Get strategy config from signal
 	profitTargetPct := decimal.NewFromFloat(5.0) // Default
 	stopLossPct := decimal.NewFromFloat(2.0)     // Default
-	trackingHours := 24                          // Default
+	trackingHours := 24                          // Default, 0 in the snapshot means no time limit
 
 	if signal.ConfigSnapshot != nil {
 		if val, ok := signal.ConfigSnapshot["profit_target_pct"].(float64); ok {
@@ -252,7 +349,7 @@ func (t *Tracker) trackSignal(ctx context.Context, signal *entity.Signal) error
 	}
 
 	// Check tracking time limit
-	if !shouldClose && signal.HoursElapsed() >= float64(trackingHours) {
+	if !shouldClose && signal.TrackingPeriodElapsed(trackingHours) {
 		shouldClose = true
 		closeReason = "tracking period elapsed"
 		signal.ExitPrice = currentPriceDecimal
@@ -262,29 +359,25 @@ func (t *Tracker) trackSignal(ctx context.Context, signal *entity.Signal) error
 	if shouldClose && signal.Status == entity.SignalStatusTracking {
 		// Close signal and calculate outcome
 		if err := signal.Close(); err != nil {
-			return fmt.Errorf("failed to close signal: %w", err)
+			return nil, fmt.Errorf("failed to close signal: %w", err)
 		}
 
 		// Create outcome
 		outcome := entity.NewSignalOutcome(signal.SignalID, signal, tracking, profitTargetPct, stopLossPct)
-		if err := sigRepo.CreateOutcome(ctx, outcome); err != nil {
-			return fmt.Errorf("failed to create outcome: %w", err)
-		}
 
-		// Update signal
-		if err := sigRepo.Update(ctx, signal); err != nil {
-			return fmt.Errorf("failed to update signal: %w", err)
+		// Time-to-target comes from the hourly kline records
+		klines, err := sigRepo.GetKlineTrackingBySignal(ctx, signal.SignalID)
+		if err != nil {
+			t.logger.WithError(err).WithSignalID(signal.SignalID).Warn("Failed to get kline tracking, skipping target timings")
+		} else {
+			outcome.SetTargetTimings(signal, klines, profitTargetPct, stopLossPct)
+			outcome.SetOptimalExit(signal, klines)
 		}
 
-		t.logger.Info("Signal closed",
-			zap.String("signal_id", signal.SignalID),
-			zap.String("reason", closeReason),
-			zap.String("outcome", outcome.Outcome),
-			zap.String("final_change", outcome.FinalPriceChangePct.String()),
-		)
+		return &closingSignal{signal: signal, outcome: outcome, reason: closeReason}, nil
 	}
 
-	return nil
+	return nil, nil
 }
 
 // updateTrailingStop updates the trailing stop loss for a signal
@@ -417,6 +510,85 @@ func (t *Tracker) GetTrackingStatus(ctx context.Context) (map[string]interface{}
 	return status, nil
 }
 
+// OpenPosition represents the live unrealized PnL of a tracking signal
+type OpenPosition struct {
+	Signal           *entity.Signal
+	CurrentPrice     decimal.Decimal
+	UnrealizedPnlPct decimal.Decimal
+	PriceAvailable   bool // False if the current price could not be fetched
+}
+
+// OpenPositionsSummary represents the unrealized PnL across all tracking signals
+type OpenPositionsSummary struct {
+	Positions   []*OpenPosition
+	PricedCount int
+	TotalPnlPct decimal.Decimal // Sum of unrealized PnL over priced positions
+	AvgPnlPct   decimal.Decimal
+	Winning     int
+	Losing      int
+}
+
+// GetOpenPositions fetches the current price of every TRACKING signal from the tracker's
+// price source and computes its unrealized PnL. Prices are fetched once per symbol; positions whose price cannot be
+// fetched are returned without a PnL and left out of the totals
+func (t *Tracker) GetOpenPositions(ctx context.Context) (*OpenPositionsSummary, error) {
+	sigRepo := *t.signalRepo
+
+	trackingSignals, err := sigRepo.GetTrackingSignals(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tracking signals: %w", err)
+	}
+
+	summary := &OpenPositionsSummary{
+		Positions:   make([]*OpenPosition, 0, len(trackingSignals)),
+		TotalPnlPct: decimal.Zero,
+		AvgPnlPct:   decimal.Zero,
+	}
+
+	prices := make(map[string]decimal.Decimal)
+	failedSymbols := make(map[string]bool)
+
+	for _, signal := range trackingSignals {
+		position := &OpenPosition{Signal: signal}
+		summary.Positions = append(summary.Positions, position)
+
+		price, ok := prices[signal.Symbol]
+		if !ok && !failedSymbols[signal.Symbol] {
+			currentPrice, err := t.priceSource.GetPrice(ctx, signal.Symbol)
+			if err != nil {
+				t.logger.WithError(err).WithSymbol(signal.Symbol).Warn("Failed to get current price for open position",
+					zap.String("price_source", t.priceSource.Name()))
+				failedSymbols[signal.Symbol] = true
+				continue
+			}
+			price = decimal.NewFromFloat(currentPrice)
+			prices[signal.Symbol] = price
+			ok = true
+		}
+		if !ok {
+			continue
+		}
+
+		position.CurrentPrice = price
+		position.UnrealizedPnlPct = signal.CalculatePriceChange(price)
+		position.PriceAvailable = true
+
+		summary.PricedCount++
+		summary.TotalPnlPct = summary.TotalPnlPct.Add(position.UnrealizedPnlPct)
+		if position.UnrealizedPnlPct.IsPositive() {
+			summary.Winning++
+		} else if position.UnrealizedPnlPct.IsNegative() {
+			summary.Losing++
+		}
+	}
+
+	if summary.PricedCount > 0 {
+		summary.AvgPnlPct = summary.TotalPnlPct.Div(decimal.NewFromInt(int64(summary.PricedCount)))
+	}
+
+	return summary, nil
+}
+
 // TrackAllKlines tracks all active signals using kline data (runs hourly)
 func (t *Tracker) TrackAllKlines(ctx context.Context) error {
 	t.logger.Info("Starting kline tracking")
@@ -444,19 +616,26 @@ func (t *Tracker) TrackAllKlines(ctx context.Context) error {
 
 	t.logger.Info("Tracking klines for signals", zap.Int("count", len(allSignals)))
 
-	// Group signals by symbol for batch optimization
-	signalsBySymbol := make(map[string][]*entity.Signal)
+	// Group signals by symbol and tracking interval for batch optimization
+	type klineBatch struct {
+		symbol   string
+		interval string
+	}
+	signalsByBatch := make(map[klineBatch][]*entity.Signal)
 	for _, signal := range allSignals {
-		signalsBySymbol[signal.Symbol] = append(signalsBySymbol[signal.Symbol], signal)
+		batch := klineBatch{symbol: signal.Symbol, interval: signal.KlineTrackingInterval()}
+		signalsByBatch[batch] = append(signalsByBatch[batch], signal)
 	}
 
 	tracked := 0
 	failed := 0
 
 	// Process each symbol's signals
-	for symbol, signals := range signalsBySymbol {
-		if err := t.trackSymbolKlines(ctx, symbol, signals); err != nil {
-			t.logger.WithError(err).WithSymbol(symbol).Warn("Failed to track klines for symbol")
+	for batch, signals := range signalsByBatch {
+		if err := t.trackSymbolKlines(ctx, batch.symbol, batch.interval, signals); err != nil {
+			t.logger.WithError(err).WithSymbol(batch.symbol).Warn("Failed to track klines for symbol",
+				zap.String("interval", batch.interval),
+			)
 			failed += len(signals)
 			continue
 		}
@@ -476,10 +655,15 @@ func (t *Tracker) TrackAllKlines(ctx context.Context) error {
 	return nil
 }
 
-// trackSymbolKlines tracks klines for all signals of a specific symbol
-func (t *Tracker) trackSymbolKlines(ctx context.Context, symbol string, signals []*entity.Signal) error {
+// trackSymbolKlines tracks klines of the given interval for all signals of a specific symbol
+func (t *Tracker) trackSymbolKlines(ctx context.Context, symbol, interval string, signals []*entity.Signal) error {
 	sigRepo := *t.signalRepo
 
+	intervalDuration, ok := entity.KlineIntervalDuration(interval)
+	if !ok {
+		return fmt.Errorf("unsupported kline tracking interval: %s", interval)
+	}
+
 	// Determine the earliest start time among all signals
 	var earliestStart time.Time
 	for i, signal := range signals {
@@ -491,11 +675,11 @@ func (t *Tracker) trackSymbolKlines(ctx context.Context, symbol string, signals
 
 		var startTime time.Time
 		if latestKline != nil {
-			// Start from next hour after last tracked kline
+			// Start from the kline after the last tracked one
 			startTime = latestKline.KlineCloseTime.Add(1 * time.Second)
 		} else {
-			// Start from signal generation time (truncate to hour)
-			startTime = signal.GeneratedAt.Truncate(time.Hour)
+			// Start from the signal's tracking start time (truncated to the interval)
+			startTime = signal.KlineTrackingStart().Truncate(intervalDuration)
 		}
 
 		if i == 0 || startTime.Before(earliestStart) {
@@ -503,12 +687,12 @@ func (t *Tracker) trackSymbolKlines(ctx context.Context, symbol string, signals
 		}
 	}
 
-	// Get current hour (don't fetch incomplete kline)
+	// Get the open time of the current kline (don't fetch incomplete kline)
 	now := time.Now()
-	currentHour := now.Truncate(time.Hour)
+	currentKlineOpen := now.Truncate(intervalDuration)
 
 	// Skip if no complete klines available
-	if earliestStart.After(currentHour) || earliestStart.Equal(currentHour) {
+	if earliestStart.After(currentKlineOpen) || earliestStart.Equal(currentKlineOpen) {
 		t.logger.Debug("No new complete klines to track",
 			zap.String("symbol", symbol),
 		)
@@ -516,15 +700,15 @@ func (t *Tracker) trackSymbolKlines(ctx context.Context, symbol string, signals
 	}
 
 	// Fetch klines for this symbol
-	klines, err := t.binanceClient.GetKlinesSince(ctx, symbol, "1h", earliestStart)
+	klines, err := t.binanceClient.GetKlinesSince(ctx, symbol, interval, earliestStart)
 	if err != nil {
 		return fmt.Errorf("failed to get klines: %w", err)
 	}
 
-	// Filter out incomplete klines (current hour)
+	// Filter out incomplete klines (current interval)
 	var completedKlines []*entity.Kline
 	for _, kline := range klines {
-		if kline.CloseTime.Before(currentHour) {
+		if kline.CloseTime.Before(currentKlineOpen) {
 			completedKlines = append(completedKlines, kline)
 		}
 	}
@@ -538,7 +722,7 @@ func (t *Tracker) trackSymbolKlines(ctx context.Context, symbol string, signals
 
 	// Process klines for each signal
 	for _, signal := range signals {
-		if err := t.processSignalKlines(ctx, signal, completedKlines); err != nil {
+		if _, err := t.processSignalKlines(ctx, signal, completedKlines); err != nil {
 			t.logger.WithError(err).WithSignalID(signal.SignalID).Warn("Failed to process klines for signal")
 			continue
 		}
@@ -547,41 +731,218 @@ func (t *Tracker) trackSymbolKlines(ctx context.Context, symbol string, signals
 	return nil
 }
 
-// processSignalKlines processes klines for a single signal
-func (t *Tracker) processSignalKlines(ctx context.Context, signal *entity.Signal, klines []*entity.Kline) error {
+// processSignalKlines creates kline tracking records of a single signal for the klines
+// it has no record for yet, so it also fills gaps left by earlier runs
+// Returns the number of records created
+func (t *Tracker) processSignalKlines(ctx context.Context, signal *entity.Signal, klines []*entity.Kline) (int, error) {
 	sigRepo := *t.signalRepo
 
-	// Get latest kline tracking to avoid duplicates
-	latestKline, err := sigRepo.GetLatestKlineTracking(ctx, signal.SignalID)
+	// Get existing kline tracking to avoid duplicates
+	existing, err := sigRepo.GetKlineTrackingBySignal(ctx, signal.SignalID)
 	if err != nil {
-		return fmt.Errorf("failed to get latest kline tracking: %w", err)
+		return 0, fmt.Errorf("failed to get kline tracking: %w", err)
+	}
+
+	tracked := make(map[int64]bool, len(existing))
+	for _, record := range existing {
+		tracked[record.KlineOpenTime.UnixMilli()] = true
 	}
 
-	var lastTrackedTime time.Time
-	if latestKline != nil {
-		lastTrackedTime = latestKline.KlineCloseTime
+	trackingStart := signal.KlineTrackingStart()
+
+	// Changes are measured from the signal price, or when tracking starts after confirmation,
+	// from the price captured at confirmation if the strategy opted in and otherwise from
+	// the open of the first tracked kline
+	entryPrice := signal.PriceAtSignal
+	if !trackingStart.Equal(signal.GeneratedAt) {
+		entryPrice = decimal.Zero
+		if signal.KlineEntryAtConfirmation() && signal.EntryPrice.IsPositive() {
+			entryPrice = signal.EntryPrice
+		} else if len(existing) > 0 {
+			entryPrice = existing[0].OpenPrice
+		}
 	}
 
-	// Create kline tracking records for new klines only
+	// Collect kline tracking records for new klines only
+	var trackings []*entity.SignalKlineTracking
 	for _, kline := range klines {
-		// Skip if kline is before signal generation or already tracked
-		if kline.OpenTime.Before(signal.GeneratedAt) || kline.CloseTime.Before(lastTrackedTime) || kline.CloseTime.Equal(lastTrackedTime) {
+		// Skip if kline is before the tracking start or already tracked
+		if kline.OpenTime.Before(trackingStart) || tracked[kline.OpenTime.UnixMilli()] {
 			continue
 		}
 
-		// Create kline tracking record
-		tracking := entity.NewSignalKlineTracking(signal.SignalID, signal, kline)
+		if entryPrice.IsZero() {
+			entryPrice = kline.Open
+		}
+
+		trackings = append(trackings, entity.NewSignalKlineTracking(signal.SignalID, signal, kline, entryPrice, trackingStart))
+	}
+
+	if len(trackings) == 0 {
+		return 0, nil
+	}
+
+	// Insert all new records at once rather than one write per kline
+	if err := sigRepo.CreateKlineTrackingBatch(ctx, trackings); err != nil {
+		return 0, fmt.Errorf("failed to create kline tracking: %w", err)
+	}
+
+	latest := trackings[len(trackings)-1]
+	t.logger.Debug("Kline tracking created",
+		zap.String("signal_id", signal.SignalID),
+		zap.Int("count", len(trackings)),
+		zap.Time("latest_kline_time", latest.KlineOpenTime),
+		zap.String("close_change", latest.CloseChangePct.String()),
+	)
+
+	return len(trackings), nil
+}
+
+// KlineBackfillResult summarizes a kline tracking backfill
+type KlineBackfillResult struct {
+	Signals    int // Signals checked for missing kline tracking
+	Backfilled int // Kline tracking records created
+	Failed     int // Signals whose backfill failed
+}
+
+// BackfillSignalKlines re-fetches the klines of a signal's tracking window and creates
+// the kline tracking records that are missing. Records already present are skipped,
+// so running it repeatedly is safe. Returns nil if the signal does not exist
+func (t *Tracker) BackfillSignalKlines(ctx context.Context, signalID string) (*KlineBackfillResult, error) {
+	sigRepo := *t.signalRepo
+
+	signal, err := sigRepo.GetByID(ctx, signalID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get signal: %w", err)
+	}
 
-		if err := sigRepo.CreateKlineTracking(ctx, tracking); err != nil {
-			return fmt.Errorf("failed to create kline tracking: %w", err)
+	if signal == nil {
+		return nil, nil
+	}
+
+	created, err := t.backfillSignalKlines(ctx, signal)
+	if err != nil {
+		return nil, err
+	}
+
+	t.logger.Info("Kline tracking backfill completed",
+		zap.String("signal_id", signalID),
+		zap.Int("backfilled", created),
+	)
+
+	return &KlineBackfillResult{Signals: 1, Backfilled: created}, nil
+}
+
+// BackfillKlines backfills missing kline tracking records for all signals generated
+// between start and end (see BackfillSignalKlines)
+func (t *Tracker) BackfillKlines(ctx context.Context, start, end time.Time) (*KlineBackfillResult, error) {
+	t.logger.Info("Starting kline tracking backfill",
+		zap.Time("start", start),
+		zap.Time("end", end),
+	)
+	startTime := time.Now()
+
+	sigRepo := *t.signalRepo
+
+	signals, _, err := sigRepo.GetSignalsWithFilters(ctx, repository.SignalFilterParams{
+		StartTime: &start,
+		EndTime:   &end,
+	}, 0, maxBackfillSignals)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get signals: %w", err)
+	}
+
+	result := &KlineBackfillResult{}
+	for _, signal := range signals {
+		if !isKlineTracked(signal) {
+			continue
 		}
 
-		t.logger.Debug("Kline tracking created",
-			zap.String("signal_id", signal.SignalID),
-			zap.Time("kline_time", kline.OpenTime),
-			zap.String("close_change", tracking.CloseChangePct.String()),
-		)
+		result.Signals++
+
+		created, err := t.backfillSignalKlines(ctx, signal)
+		if err != nil {
+			t.logger.WithError(err).WithSignalID(signal.SignalID).Warn("Failed to backfill klines for signal")
+			result.Failed++
+			continue
+		}
+		result.Backfilled += created
+
+		// Avoid API rate limiting
+		time.Sleep(100 * time.Millisecond)
 	}
 
-	return nil
+	duration := time.Since(startTime)
+	t.logger.Info("Kline tracking backfill completed",
+		zap.Int("signals", result.Signals),
+		zap.Int("backfilled", result.Backfilled),
+		zap.Int("failed", result.Failed),
+		zap.String("duration", duration.String()),
+	)
+
+	return result, nil
+}
+
+// maxBackfillSignals caps the number of signals a single range backfill processes
+const maxBackfillSignals = 1000
+
+// isKlineTracked reports whether kline tracking applies to a signal's status
+// Pending and invalidated signals never had a trade entered
+func isKlineTracked(signal *entity.Signal) bool {
+	switch signal.Status {
+	case entity.SignalStatusConfirmed, entity.SignalStatusTracking, entity.SignalStatusClosed:
+		return true
+	default:
+		return false
+	}
+}
+
+// backfillSignalKlines fetches the completed klines from the signal's tracking start until
+// it closed (or now) and creates the missing kline tracking records
+func (t *Tracker) backfillSignalKlines(ctx context.Context, signal *entity.Signal) (int, error) {
+	if !isKlineTracked(signal) {
+		return 0, nil
+	}
+
+	sigRepo := *t.signalRepo
+
+	interval := signal.KlineTrackingInterval()
+	intervalDuration, ok := entity.KlineIntervalDuration(interval)
+	if !ok {
+		return 0, fmt.Errorf("unsupported kline tracking interval: %s", interval)
+	}
+
+	// Only complete klines, and none after the signal closed
+	end := time.Now().Truncate(intervalDuration)
+	if signal.Status == entity.SignalStatusClosed {
+		outcome, err := sigRepo.GetOutcome(ctx, signal.SignalID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get outcome: %w", err)
+		}
+		if outcome != nil && outcome.ClosedAt.Before(end) {
+			end = outcome.ClosedAt
+		}
+	}
+
+	var klines []*entity.Kline
+	start := signal.KlineTrackingStart().Truncate(intervalDuration)
+	for start.Before(end) {
+		page, err := t.binanceClient.GetKlinesSince(ctx, signal.Symbol, interval, start)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get klines: %w", err)
+		}
+
+		for _, kline := range page {
+			if kline.CloseTime.Before(end) {
+				klines = append(klines, kline)
+			}
+		}
+
+		if len(page) < binance.MaxKlinesPerRequest {
+			break
+		}
+		start = page[len(page)-1].OpenTime.Add(intervalDuration)
+	}
+
+	return t.processSignalKlines(ctx, signal, klines)
 }
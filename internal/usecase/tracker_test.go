@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"ContractAnalysis/internal/domain/entity"
+	"ContractAnalysis/internal/domain/repository"
+
+	"github.com/shopspring/decimal"
+)
+
+// stubPriceSource returns a fixed price per symbol
+type stubPriceSource map[string]float64
+
+func (p stubPriceSource) GetPrice(ctx context.Context, symbol string) (float64, error) {
+	return p[symbol], nil
+}
+
+func (p stubPriceSource) Name() string { return "last" }
+
+func TestTrackAllBatchesOutcomes(t *testing.T) {
+	// Entered LONG at 100 with a 5% target and 2% stop; BTC and ETH close, SOL stays open
+	prices := stubPriceSource{"BTCUSDT": 106, "ETHUSDT": 97, "SOLUSDT": 101}
+
+	tests := []struct {
+		name         string
+		batchErr     error
+		wantErr      bool
+		wantOutcomes int
+		wantClosed   []string
+	}{
+		{"closed signals share one batch", nil, false, 2, []string{"BTCUSDT", "ETHUSDT"}},
+		{"signals stay open when the batch fails", errors.New("connection reset"), true, 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signals := newMemSignalRepo()
+			signals.outcomeBatchErr = tt.batchErr
+			for _, symbol := range []string{"BTCUSDT", "ETHUSDT", "SOLUSDT"} {
+				signals.signals = append(signals.signals, &entity.Signal{
+					SignalID:      symbol + "-signal",
+					Symbol:        symbol,
+					Type:          entity.SignalTypeLong,
+					Status:        entity.SignalStatusTracking,
+					GeneratedAt:   time.Now().Add(-time.Hour),
+					PriceAtSignal: decimal.NewFromInt(100),
+					ConfigSnapshot: map[string]interface{}{
+						"profit_target_pct": 5.0,
+						"stop_loss_pct":     2.0,
+						"tracking_hours":    24.0,
+					},
+				})
+			}
+
+			var repo repository.SignalRepository = signals
+			tracker := NewTracker(nil, prices, TrackingHistoryFull, &repo, nil)
+
+			err := tracker.TrackAll(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TrackAll = %v, want error %v", err, tt.wantErr)
+			}
+
+			if signals.outcomeBatches != 1 {
+				t.Errorf("CreateOutcomesBatch called %d times, want once", signals.outcomeBatches)
+			}
+			if len(signals.outcomes) != tt.wantOutcomes {
+				t.Errorf("stored %d outcomes, want %d", len(signals.outcomes), tt.wantOutcomes)
+			}
+
+			var closed []string
+			for _, s := range signals.signals {
+				if signals.savedStatus[s.SignalID] == entity.SignalStatusClosed {
+					closed = append(closed, s.Symbol)
+				}
+			}
+			if fmt.Sprint(closed) != fmt.Sprint(tt.wantClosed) {
+				t.Errorf("saved as closed %v, want %v", closed, tt.wantClosed)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/signal"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"ContractAnalysis/config"
+	"ContractAnalysis/internal/domain/entity"
 	"ContractAnalysis/internal/domain/repository"
 	"ContractAnalysis/internal/domain/service"
 	"ContractAnalysis/internal/infrastructure/binance"
@@ -19,6 +21,8 @@ import (
 	"ContractAnalysis/internal/infrastructure/scheduler"
 	"ContractAnalysis/internal/presentation/api"
 	"ContractAnalysis/internal/usecase"
+	"ContractAnalysis/pkg/buildinfo"
+	"ContractAnalysis/pkg/utils"
 
 	"github.com/shopspring/decimal"
 	"go.uber.org/zap"
@@ -52,8 +56,17 @@ func main() {
 	// Set global logger
 	logger.SetGlobal(log)
 
+	// Display timezone for notifications and localized API responses; stored times stay UTC
+	location, err := time.LoadLocation(cfg.App.Timezone)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to load app timezone", zap.String("timezone", cfg.App.Timezone))
+	}
+
+	build := buildinfo.Get(cfg.App.Version)
 	log.Info("Starting Binance Futures Analysis System",
-		zap.String("version", cfg.App.Version),
+		zap.String("version", build.Version),
+		zap.String("git_commit", build.GitCommit),
+		zap.String("build_time", build.BuildTime),
 		zap.String("environment", cfg.App.Environment),
 	)
 
@@ -78,6 +91,43 @@ func main() {
 		log.WithError(err).Fatal("Failed to initialize Binance client")
 	}
 
+	// Measure the local clock skew before any data is collected, then keep it up to date
+	clockSyncCtx, stopClockSync := context.WithCancel(context.Background())
+	defer stopClockSync()
+	if cfg.Binance.ClockSync.Enabled {
+		syncCtx, cancel := context.WithTimeout(clockSyncCtx, cfg.Binance.Timeout)
+		if offset, err := binanceClient.SyncServerTime(syncCtx, cfg.Binance.ClockSync.MaxSkew); err != nil {
+			log.WithError(err).Warn("Failed to sync with Binance server time, using the local clock")
+		} else {
+			log.Info("Synced with Binance server time", zap.Duration("offset", offset))
+		}
+		cancel()
+
+		go binanceClient.RunClockSync(clockSyncCtx, cfg.Binance.ClockSync.Interval, cfg.Binance.ClockSync.MaxSkew)
+	}
+
+	// Verify the API key up front, so a bad or expired key surfaces now rather than on
+	// the first authenticated request. Clock sync above keeps signed timestamps valid
+	if binanceClient.HasCredentials() {
+		checkCtx, cancel := context.WithTimeout(context.Background(), cfg.Binance.Timeout)
+		permissions, err := binanceClient.CheckCredentials(checkCtx)
+		cancel()
+		switch {
+		case errors.Is(err, binance.ErrInvalidCredentials):
+			log.WithError(err).Fatal("Binance rejected the configured API key")
+		case err != nil:
+			log.WithError(err).Warn("Failed to verify the Binance API key, continuing")
+		default:
+			log.Info("Binance API key verified",
+				zap.Bool("can_trade", permissions.CanTrade),
+				zap.Bool("can_deposit", permissions.CanDeposit),
+				zap.Bool("can_withdraw", permissions.CanWithdraw),
+			)
+		}
+	} else {
+		log.Info("No Binance API key configured, only public endpoints are available")
+	}
+
 	// Initialize repositories
 	tradingPairRepo := mysqlRepo.NewTradingPairRepository(db)
 	marketDataRepoImpl := mysqlRepo.NewMarketDataRepository(db)
@@ -85,6 +135,8 @@ func main() {
 	signalRepoImpl := mysqlRepo.NewSignalRepository(db)
 	signalRepo := repository.SignalRepository(signalRepoImpl)
 	statisticsRepo := mysqlRepo.NewStatisticsRepository(db)
+	paperEquityRepo := mysqlRepo.NewPaperEquityRepository(db)
+	statisticsAlertRepo := mysqlRepo.NewStatisticsAlertRepository(db)
 
 	// Initialize strategies
 	var strategies []service.Strategy
@@ -92,17 +144,29 @@ func main() {
 	if cfg.Strategies.Minority.Enabled {
 		minorityStrategy := service.NewMinorityStrategy(service.MinorityStrategyConfig{
 			BaseConfig: service.StrategyConfig{
-				Name:              cfg.Strategies.Minority.Name,
-				Enabled:           cfg.Strategies.Minority.Enabled,
-				ConfirmationHours: cfg.Strategies.Minority.ConfirmationHours,
-				TrackingHours:     cfg.Strategies.Minority.TrackingHours,
-				ProfitTargetPct:   cfg.Strategies.Minority.ProfitTargetPct,
-				StopLossPct:       cfg.Strategies.Minority.StopLossPct,
+				Name:                     cfg.Strategies.Minority.Name,
+				Enabled:                  cfg.Strategies.Minority.Enabled,
+				ConfirmationHours:        cfg.Strategies.Minority.ConfirmationHours,
+				TrackingHours:            cfg.Strategies.Minority.TrackingHours,
+				ProfitTargetPct:          cfg.Strategies.Minority.ProfitTargetPct,
+				StopLossPct:              cfg.Strategies.Minority.StopLossPct,
+				RequireConsecutivePoints: cfg.Strategies.Minority.RequireConsecutivePoints,
+				UseSmoothedRatios:        cfg.Strategies.Minority.UseSmoothedRatios,
+				SmoothingHalfLifePoints:  cfg.Strategies.Minority.SmoothingHalfLifePoints,
+				MinLiquidityTier:         entity.LiquidityTier(cfg.Strategies.Minority.MinLiquidityTier),
+				MinDataQualityScore:      cfg.Strategies.Minority.MinDataQualityScore,
+				AvoidPreFundingMinutes:   cfg.Strategies.Minority.AvoidPreFundingMinutes,
+				MaxSignalsPerDay:         cfg.Strategies.Minority.MaxSignalsPerDay,
+				LookbackHours:            cfg.Strategies.Minority.LookbackHours,
+				KlineFromConfirmation:    cfg.Strategies.Minority.KlineFromConfirmation,
+				KlineEntryAtConfirmation: cfg.Strategies.Minority.KlineEntryAtConfirmation,
+				ATRLevels:                service.ATRLevelsConfig(cfg.Strategies.Minority.ATRLevels),
+				Symbols:                  service.SymbolFilter(cfg.Strategies.Minority.Symbols),
 			},
 			MinRatioDifference:              cfg.Strategies.Minority.MinRatioDifference,
 			GenerateLongWhenShortRatioAbove: cfg.Strategies.Minority.GenerateLongWhenShortRatioAbove,
 			GenerateShortWhenLongRatioAbove: cfg.Strategies.Minority.GenerateShortWhenLongRatioAbove,
-		})
+		}, binanceClient, binanceClient) // Use binanceClient as klineRepo and precisionProvider for ATR levels
 		strategies = append(strategies, minorityStrategy)
 		log.Info("Minority strategy enabled")
 	}
@@ -110,17 +174,31 @@ func main() {
 	if cfg.Strategies.Whale.Enabled {
 		whaleStrategy := service.NewWhaleStrategy(service.WhaleStrategyConfig{
 			BaseConfig: service.StrategyConfig{
-				Name:              cfg.Strategies.Whale.Name,
-				Enabled:           cfg.Strategies.Whale.Enabled,
-				ConfirmationHours: cfg.Strategies.Whale.ConfirmationHours,
-				TrackingHours:     cfg.Strategies.Whale.TrackingHours,
-				ProfitTargetPct:   cfg.Strategies.Whale.ProfitTargetPct,
-				StopLossPct:       cfg.Strategies.Whale.StopLossPct,
+				Name:                     cfg.Strategies.Whale.Name,
+				Enabled:                  cfg.Strategies.Whale.Enabled,
+				ConfirmationHours:        cfg.Strategies.Whale.ConfirmationHours,
+				TrackingHours:            cfg.Strategies.Whale.TrackingHours,
+				ProfitTargetPct:          cfg.Strategies.Whale.ProfitTargetPct,
+				StopLossPct:              cfg.Strategies.Whale.StopLossPct,
+				RequireConsecutivePoints: cfg.Strategies.Whale.RequireConsecutivePoints,
+				UseSmoothedRatios:        cfg.Strategies.Whale.UseSmoothedRatios,
+				SmoothingHalfLifePoints:  cfg.Strategies.Whale.SmoothingHalfLifePoints,
+				MinLiquidityTier:         entity.LiquidityTier(cfg.Strategies.Whale.MinLiquidityTier),
+				MinDataQualityScore:      cfg.Strategies.Whale.MinDataQualityScore,
+				AvoidPreFundingMinutes:   cfg.Strategies.Whale.AvoidPreFundingMinutes,
+				MaxSignalsPerDay:         cfg.Strategies.Whale.MaxSignalsPerDay,
+				LookbackHours:            cfg.Strategies.Whale.LookbackHours,
+				KlineFromConfirmation:    cfg.Strategies.Whale.KlineFromConfirmation,
+				KlineEntryAtConfirmation: cfg.Strategies.Whale.KlineEntryAtConfirmation,
+				ATRLevels:                service.ATRLevelsConfig(cfg.Strategies.Whale.ATRLevels),
+				Symbols:                  service.SymbolFilter(cfg.Strategies.Whale.Symbols),
 			},
-			MinRatioDifference:     cfg.Strategies.Whale.MinRatioDifference,
-			WhalePositionThreshold: cfg.Strategies.Whale.WhalePositionThreshold,
-			MinDivergence:          cfg.Strategies.Whale.MinDivergence,
-		})
+			MinRatioDifference:        cfg.Strategies.Whale.MinRatioDifference,
+			WhalePositionThreshold:    cfg.Strategies.Whale.WhalePositionThreshold,
+			MinDivergence:             cfg.Strategies.Whale.MinDivergence,
+			MinTakerFlowRatio:         cfg.Strategies.Whale.MinTakerFlowRatio,
+			RequireWideningDivergence: cfg.Strategies.Whale.RequireWideningDivergence,
+		}, binanceClient, binanceClient) // Use binanceClient as klineRepo and precisionProvider for ATR levels
 		strategies = append(strategies, whaleStrategy)
 		log.Info("Whale strategy enabled")
 	}
@@ -128,33 +206,104 @@ func main() {
 	if cfg.Strategies.SmartMoney.Enabled {
 		smartMoneyStrategy := service.NewSmartMoneyStrategy(service.SmartMoneyStrategyConfig{
 			BaseConfig: service.StrategyConfig{
-				Name:              cfg.Strategies.SmartMoney.Name,
-				Enabled:           cfg.Strategies.SmartMoney.Enabled,
-				ConfirmationHours: cfg.Strategies.SmartMoney.ConfirmationHours,
-				TrackingHours:     cfg.Strategies.SmartMoney.TrackingHours,
-				ProfitTargetPct:   cfg.Strategies.SmartMoney.ProfitTargetPct,
-				StopLossPct:       cfg.Strategies.SmartMoney.StopLossPct,
+				Name:                     cfg.Strategies.SmartMoney.Name,
+				Enabled:                  cfg.Strategies.SmartMoney.Enabled,
+				ConfirmationHours:        cfg.Strategies.SmartMoney.ConfirmationHours,
+				TrackingHours:            cfg.Strategies.SmartMoney.TrackingHours,
+				ProfitTargetPct:          cfg.Strategies.SmartMoney.ProfitTargetPct,
+				StopLossPct:              cfg.Strategies.SmartMoney.StopLossPct,
+				RequireConsecutivePoints: cfg.Strategies.SmartMoney.RequireConsecutivePoints,
+				UseSmoothedRatios:        cfg.Strategies.SmartMoney.UseSmoothedRatios,
+				SmoothingHalfLifePoints:  cfg.Strategies.SmartMoney.SmoothingHalfLifePoints,
+				MinLiquidityTier:         entity.LiquidityTier(cfg.Strategies.SmartMoney.MinLiquidityTier),
+				MinDataQualityScore:      cfg.Strategies.SmartMoney.MinDataQualityScore,
+				AvoidPreFundingMinutes:   cfg.Strategies.SmartMoney.AvoidPreFundingMinutes,
+				MaxSignalsPerDay:         cfg.Strategies.SmartMoney.MaxSignalsPerDay,
+				LookbackHours:            cfg.Strategies.SmartMoney.LookbackHours,
+				KlineFromConfirmation:    cfg.Strategies.SmartMoney.KlineFromConfirmation,
+				KlineEntryAtConfirmation: cfg.Strategies.SmartMoney.KlineEntryAtConfirmation,
+				ATRLevels:                service.ATRLevelsConfig(cfg.Strategies.SmartMoney.ATRLevels),
+				Symbols:                  service.SymbolFilter(cfg.Strategies.SmartMoney.Symbols),
 			},
 			MinLongAccountRatio: cfg.Strategies.SmartMoney.MinLongAccountRatio,
 			LookbackPeriod:      cfg.Strategies.SmartMoney.LookbackPeriod,
 			KlineInterval:       cfg.Strategies.SmartMoney.KlineInterval,
-		}, binanceClient) // Use binanceClient as klineRepo
+			IndecisionMode:      cfg.Strategies.SmartMoney.IndecisionMode,
+			DojiMaxBodyPct:      cfg.Strategies.SmartMoney.DojiMaxBodyPct,
+			MinTakerFlowRatio:   cfg.Strategies.SmartMoney.MinTakerFlowRatio,
+		}, binanceClient, binanceClient) // Use binanceClient as klineRepo and precisionProvider
 		strategies = append(strategies, smartMoneyStrategy)
 		log.Info("Smart Money strategy enabled")
 	}
 
+	if cfg.Strategies.OISpike.Enabled {
+		oiSpikeStrategy := service.NewOISpikeStrategy(service.OISpikeStrategyConfig{
+			BaseConfig: service.StrategyConfig{
+				Name:                     cfg.Strategies.OISpike.Name,
+				Enabled:                  cfg.Strategies.OISpike.Enabled,
+				ConfirmationHours:        cfg.Strategies.OISpike.ConfirmationHours,
+				TrackingHours:            cfg.Strategies.OISpike.TrackingHours,
+				ProfitTargetPct:          cfg.Strategies.OISpike.ProfitTargetPct,
+				StopLossPct:              cfg.Strategies.OISpike.StopLossPct,
+				RequireConsecutivePoints: cfg.Strategies.OISpike.RequireConsecutivePoints,
+				UseSmoothedRatios:        cfg.Strategies.OISpike.UseSmoothedRatios,
+				SmoothingHalfLifePoints:  cfg.Strategies.OISpike.SmoothingHalfLifePoints,
+				MinLiquidityTier:         entity.LiquidityTier(cfg.Strategies.OISpike.MinLiquidityTier),
+				MinDataQualityScore:      cfg.Strategies.OISpike.MinDataQualityScore,
+				AvoidPreFundingMinutes:   cfg.Strategies.OISpike.AvoidPreFundingMinutes,
+				MaxSignalsPerDay:         cfg.Strategies.OISpike.MaxSignalsPerDay,
+				LookbackHours:            cfg.Strategies.OISpike.LookbackHours,
+				KlineFromConfirmation:    cfg.Strategies.OISpike.KlineFromConfirmation,
+				KlineEntryAtConfirmation: cfg.Strategies.OISpike.KlineEntryAtConfirmation,
+				ATRLevels:                service.ATRLevelsConfig(cfg.Strategies.OISpike.ATRLevels),
+				Symbols:                  service.SymbolFilter(cfg.Strategies.OISpike.Symbols),
+			},
+			MinOIChangePct:  cfg.Strategies.OISpike.MinOIChangePct,
+			LookbackPoints:  cfg.Strategies.OISpike.LookbackPoints,
+			MinAccountRatio: cfg.Strategies.OISpike.MinAccountRatio,
+		}, binanceClient, binanceClient) // Use binanceClient as klineRepo and precisionProvider for ATR levels
+		strategies = append(strategies, oiSpikeStrategy)
+		log.Info("OI Spike strategy enabled")
+	}
+
+	if cfg.Strategies.Consensus.Enabled {
+		consensusStrategy := service.NewConsensusStrategy(service.ConsensusStrategyConfig{
+			BaseConfig: service.StrategyConfig{
+				Name:                     cfg.Strategies.Consensus.Name,
+				Enabled:                  cfg.Strategies.Consensus.Enabled,
+				ConfirmationHours:        cfg.Strategies.Consensus.ConfirmationHours,
+				TrackingHours:            cfg.Strategies.Consensus.TrackingHours,
+				ProfitTargetPct:          cfg.Strategies.Consensus.ProfitTargetPct,
+				StopLossPct:              cfg.Strategies.Consensus.StopLossPct,
+				MaxSignalsPerDay:         cfg.Strategies.Consensus.MaxSignalsPerDay,
+				KlineFromConfirmation:    cfg.Strategies.Consensus.KlineFromConfirmation,
+				KlineEntryAtConfirmation: cfg.Strategies.Consensus.KlineEntryAtConfirmation,
+			},
+			MinAgreeingStrategies: cfg.Strategies.Consensus.MinAgreeingStrategies,
+		})
+		strategies = append(strategies, consensusStrategy)
+		log.Info("Consensus strategy enabled")
+	}
+
 	log.Info("Strategies initialized", zap.Int("count", len(strategies)))
 
 	// Initialize notification system
 	var notifiers []notification.Notifier
 
 	if cfg.Notifications.Console.Enabled {
-		consoleNotifier := notification.NewConsoleNotifier(cfg.Notifications.Console)
+		consoleNotifier := notification.NewConsoleNotifier(cfg.Notifications.Console, location)
 		notifiers = append(notifiers, consoleNotifier)
 		log.Info("Console notifier enabled")
 	}
 
-	notificationDispatcher := notification.NewNotificationDispatcher(notifiers)
+	// In-process broker feeding websocket clients
+	notificationBroker := notification.NewBroker(cfg.Notifications.WebSocket)
+	if cfg.Notifications.WebSocket.Enabled {
+		notifiers = append(notifiers, notificationBroker)
+		log.Info("WebSocket notifications enabled")
+	}
+
+	notificationDispatcher := notification.NewNotificationDispatcher(notifiers, cfg.Notifications.Throttle)
 
 	// Initialize use cases
 	collector := usecase.NewCollector(
@@ -162,6 +311,7 @@ func main() {
 		&marketDataRepo,
 		tradingPairRepo,
 		cfg.Collection,
+		notificationDispatcher,
 	)
 
 	analyzer := usecase.NewAnalyzer(
@@ -169,12 +319,24 @@ func main() {
 		&marketDataRepo,
 		&signalRepo,
 		tradingPairRepo,
+		redisConn.NewAnalysisPauseStore(redisClient),
+		binanceClient,
 		cfg.Strategies.Global,
+		location,
 	)
 
+	trackingPriceSource, err := binance.NewPriceSource(binanceClient, cfg.Strategies.Global.TrackingPriceSource)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to create tracking price source")
+	}
+	log.Info("Tracking signals on price source", zap.String("price_source", trackingPriceSource.Name()))
+
 	tracker := usecase.NewTracker(
 		binanceClient,
+		trackingPriceSource,
+		cfg.Strategies.Global.TrackingHistory,
 		&signalRepo,
+		notificationDispatcher,
 	)
 
 	statisticsCalculator := usecase.NewStatisticsCalculator(
@@ -186,9 +348,39 @@ func main() {
 	// Initialize statistics monitor
 	statisticsMonitor := usecase.NewStatisticsMonitor(
 		statisticsRepo,
+		statisticsAlertRepo,
+		notificationDispatcher,
 		cfg.Statistics.Monitoring,
 	)
 
+	// Initialize retention cleaner
+	retentionCleaner := usecase.NewRetentionCleaner(
+		&marketDataRepo,
+		statisticsRepo,
+		cfg.Retention,
+	)
+
+	// Initialize paper trader
+	paperTrader := usecase.NewPaperTrader(
+		&signalRepo,
+		paperEquityRepo,
+		cfg.PaperTrading,
+	)
+
+	// Validate Binance access and strategy config before the first scheduled run
+	if cfg.SelfTest.Enabled {
+		selfTest := usecase.NewSelfTest(collector, strategies, cfg.SelfTest)
+		selfTestCtx, cancel := context.WithTimeout(context.Background(), cfg.SelfTest.Timeout)
+		err := selfTest.Run(selfTestCtx)
+		cancel()
+		if err != nil {
+			if cfg.SelfTest.FailFast {
+				log.WithError(err).Fatal("Startup self-test failed")
+			}
+			log.WithError(err).Warn("Startup self-test failed, continuing")
+		}
+	}
+
 	// Initialize API server
 	apiServer := api.NewServer(
 		api.ServerConfig{
@@ -196,17 +388,46 @@ func main() {
 			Port:         cfg.Server.Port,
 			ReadTimeout:  cfg.Server.ReadTimeout,
 			WriteTimeout: cfg.Server.WriteTimeout,
+
+			HandlerTimeout: cfg.Server.HandlerTimeout,
+			TrustedProxies: cfg.Server.TrustedProxies,
+
+			AuthEnabled: cfg.Server.Auth.Enabled,
+			APIKeys:     cfg.Server.Auth.APIKeys,
+
+			RateLimitEnabled:           cfg.Server.RateLimit.Enabled,
+			RateLimitRequestsPerMinute: cfg.Server.RateLimit.RequestsPerMinute,
+			RateLimitBurst:             cfg.Server.RateLimit.Burst,
+
+			RefreshRateLimitPerMinute: cfg.Server.RateLimit.RefreshPerMinute,
+			RefreshRateLimitBurst:     cfg.Server.RateLimit.RefreshBurst,
+
+			CompressionEnabled: cfg.Server.Compression.Enabled,
+			CompressionMinSize: cfg.Server.Compression.MinSize,
+
+			Location: location,
 		},
 		api.Dependencies{
 			SignalRepo:       signalRepo,
 			StatsRepo:        statisticsRepo,
+			StatsAlertRepo:   statisticsAlertRepo,
 			MarketDataRepo:   marketDataRepo,
 			TradingPairRepo:  tradingPairRepo,
 			StrategiesConfig: cfg.Strategies,
 			Strategies:       strategies, // Add this line
+			Analyzer:         analyzer,
+			Collector:        collector,
+			Tracker:          tracker,
+			StatsCalculator:  statisticsCalculator,
+			PaperEquityRepo:  paperEquityRepo,
+			Broker:           notificationBroker,
+			WebSocketConfig:  cfg.Notifications.WebSocket,
+			SymbolValidator:  utils.NewSymbolValidator(binanceClient.ListSymbols, utils.DefaultSymbolCacheTTL),
+			BinanceBreaker:   binanceClient.CircuitBreaker(),
+			BinanceClient:    binanceClient,
 		},
 		log,
-		cfg.App.Version,
+		build.Version,
 	)
 
 	// Start API server in goroutine
@@ -217,6 +438,17 @@ func main() {
 		}
 	}()
 
+	// Coordinate jobs across replicas if configured
+	var jobLocker scheduler.JobLocker
+	if cfg.Scheduler.DistributedLock.Enabled {
+		jobLocker = redisConn.NewJobLocker(
+			redisClient,
+			cfg.Scheduler.DistributedLock.KeyPrefix,
+			cfg.Scheduler.DistributedLock.TTL,
+		)
+		log.Info("Distributed job lock enabled", zap.Duration("ttl", cfg.Scheduler.DistributedLock.TTL))
+	}
+
 	// Initialize scheduler
 	sched := scheduler.NewScheduler(
 		collector,
@@ -224,7 +456,12 @@ func main() {
 		tracker,
 		statisticsCalculator,
 		statisticsMonitor,
+		retentionCleaner,
+		paperTrader,
 		notificationDispatcher,
+		cfg.Scheduler.JobTimeouts,
+		cfg.Scheduler.ShutdownTimeout,
+		jobLocker,
 	)
 
 	// Add scheduled jobs
@@ -255,6 +492,20 @@ func main() {
 		log.WithError(err).Fatal("Failed to add statistics job")
 	}
 
+	// Data retention job (daily by default)
+	if cfg.Retention.Enabled {
+		if err = sched.AddRetentionJob(cfg.Retention.Schedule); err != nil {
+			log.WithError(err).Fatal("Failed to add retention job")
+		}
+	}
+
+	// Paper trading equity job (hourly by default)
+	if cfg.PaperTrading.Enabled {
+		if err = sched.AddPaperTradingJob(cfg.PaperTrading.Schedule); err != nil {
+			log.WithError(err).Fatal("Failed to add paper trading job")
+		}
+	}
+
 	// Start scheduler
 	sched.Start()
 
@@ -296,9 +547,16 @@ func main() {
 
 	log.Info("Shutting down...")
 
-	// Stop scheduler
+	// Stop scheduler, waiting for in-flight jobs so they finish before the database is closed
 	sched.Stop()
 
+	// Deliver notifications still queued by notifiers, including those of the jobs just finished
+	flushCtx, flushCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer flushCancel()
+	if err := notificationDispatcher.Flush(flushCtx); err != nil {
+		log.WithError(err).Error("Error flushing notifications")
+	}
+
 	// Shutdown API server
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -306,6 +564,11 @@ func main() {
 		log.WithError(err).Error("Error shutting down API server")
 	}
 
+	// Cancel a statistics rebuild started through the API before the database goes away
+	if err := statisticsCalculator.StopRebuilds(ctx); err != nil {
+		log.WithError(err).Error("Error stopping statistics rebuild")
+	}
+
 	// Close database connection
 	if err := mysqlRepo.Close(db); err != nil {
 		log.WithError(err).Error("Error closing database")
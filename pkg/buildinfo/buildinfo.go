@@ -0,0 +1,42 @@
+// Package buildinfo holds build metadata injected at link time, e.g.
+//
+//	go build -ldflags "-X ContractAnalysis/pkg/buildinfo.Version=1.2.0 \
+//	  -X ContractAnalysis/pkg/buildinfo.GitCommit=$(git rev-parse --short HEAD) \
+//	  -X ContractAnalysis/pkg/buildinfo.BuildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+package buildinfo
+
+import "runtime"
+
+// Unknown is reported for build metadata that was not injected
+const Unknown = "unknown"
+
+// Build metadata, set with -ldflags "-X ContractAnalysis/pkg/buildinfo.<Name>=<value>"
+var (
+	Version   = ""      // Release version; empty falls back to the configured app.version
+	GitCommit = Unknown // Commit the binary was built from
+	BuildTime = Unknown // UTC build time, RFC 3339
+)
+
+// Info describes the running binary
+type Info struct {
+	Version   string
+	GitCommit string
+	BuildTime string
+	GoVersion string
+}
+
+// Get returns the build metadata of the running binary
+// fallbackVersion is reported when no version was injected at build time
+func Get(fallbackVersion string) Info {
+	version := Version
+	if version == "" {
+		version = fallbackVersion
+	}
+
+	return Info{
+		Version:   version,
+		GitCommit: GitCommit,
+		BuildTime: BuildTime,
+		GoVersion: runtime.Version(),
+	}
+}
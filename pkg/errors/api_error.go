@@ -12,19 +12,53 @@ const (
 	ErrForbidden        ErrorCode = 403
 	ErrNotFound         ErrorCode = 404
 	ErrValidationFailed ErrorCode = 422
+	ErrTooManyRequests  ErrorCode = 429
 
 	// Server errors (5xx)
 	ErrInternalServer ErrorCode = 500
 	ErrDatabase       ErrorCode = 501
 	ErrService        ErrorCode = 502
+	ErrUnavailable    ErrorCode = 503
 )
 
+// Stable machine-readable error keys, returned as error.code in every error response
+// Clients should branch on these rather than on messages or HTTP statuses
+const (
+	KeyBadRequest         = "BAD_REQUEST"
+	KeyUnauthorized       = "UNAUTHORIZED"
+	KeyForbidden          = "FORBIDDEN"
+	KeyNotFound           = "NOT_FOUND"
+	KeyValidationFailed   = "VALIDATION_ERROR"
+	KeyRateLimited        = "RATE_LIMITED"
+	KeyInternalServer     = "INTERNAL_ERROR"
+	KeyDatabase           = "DATABASE_ERROR"
+	KeyService            = "SERVICE_ERROR"
+	KeyServiceUnavailable = "SERVICE_UNAVAILABLE"
+)
+
+// errorKeys maps each error code to its stable key
+var errorKeys = map[ErrorCode]string{
+	ErrBadRequest:       KeyBadRequest,
+	ErrUnauthorized:     KeyUnauthorized,
+	ErrForbidden:        KeyForbidden,
+	ErrNotFound:         KeyNotFound,
+	ErrValidationFailed: KeyValidationFailed,
+	ErrTooManyRequests:  KeyRateLimited,
+	ErrInternalServer:   KeyInternalServer,
+	ErrDatabase:         KeyDatabase,
+	ErrService:          KeyService,
+	ErrUnavailable:      KeyServiceUnavailable,
+}
+
 // APIError represents an API error
 type APIError struct {
 	Code    ErrorCode `json:"code"`
+	Key     string    `json:"key"` // Stable machine-readable error key (see Key constants)
 	Message string    `json:"message"`
 	Type    string    `json:"type"`
 	Details []string  `json:"details,omitempty"`
+
+	cause error // Underlying error, never sent to clients
 }
 
 // Error implements the error interface
@@ -32,10 +66,28 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf("[%d] %s: %s", e.Code, e.Type, e.Message)
 }
 
+// WithCause records the error that caused e and returns e
+// The cause is only inspected server-side, e.g. to report timeouts as 503
+func (e *APIError) WithCause(err error) *APIError {
+	e.cause = err
+	return e
+}
+
+// Unwrap returns the underlying error, if any
+func (e *APIError) Unwrap() error {
+	return e.cause
+}
+
 // NewAPIError creates a new API error
 func NewAPIError(code ErrorCode, message string, errorType string, details ...string) *APIError {
+	key, ok := errorKeys[code]
+	if !ok {
+		key = KeyInternalServer
+	}
+
 	return &APIError{
 		Code:    code,
+		Key:     key,
 		Message: message,
 		Type:    errorType,
 		Details: details,
@@ -52,6 +104,16 @@ func NewNotFoundError(message string) *APIError {
 	return NewAPIError(ErrNotFound, message, "NotFound")
 }
 
+// NewUnauthorizedError creates an unauthorized error
+func NewUnauthorizedError(message string) *APIError {
+	return NewAPIError(ErrUnauthorized, message, "Unauthorized")
+}
+
+// NewTooManyRequestsError creates a rate limit exceeded error
+func NewTooManyRequestsError(message string) *APIError {
+	return NewAPIError(ErrTooManyRequests, message, "TooManyRequests")
+}
+
 // NewValidationError creates a validation error
 func NewValidationError(message string, details ...string) *APIError {
 	return NewAPIError(ErrValidationFailed, message, "ValidationError", details...)
@@ -66,3 +128,13 @@ func NewInternalServerError(message string) *APIError {
 func NewDatabaseError(message string) *APIError {
 	return NewAPIError(ErrDatabase, message, "DatabaseError")
 }
+
+// NewServiceUnavailableError creates a service unavailable error
+func NewServiceUnavailableError(message string) *APIError {
+	return NewAPIError(ErrUnavailable, message, "ServiceUnavailable")
+}
+
+// NewServiceError creates an upstream service error
+func NewServiceError(message string) *APIError {
+	return NewAPIError(ErrService, message, "ServiceError")
+}
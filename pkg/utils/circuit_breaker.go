@@ -0,0 +1,182 @@
+package utils
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned while a circuit breaker is failing calls fast
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitState represents the state of a circuit breaker
+type CircuitState string
+
+const (
+	CircuitClosed   CircuitState = "closed"    // Calls pass through
+	CircuitOpen     CircuitState = "open"      // Calls fail fast until the cooldown has passed
+	CircuitHalfOpen CircuitState = "half_open" // A single probe call decides whether to close again
+)
+
+// CircuitBreakerStats is a point-in-time view of a circuit breaker
+type CircuitBreakerStats struct {
+	State               CircuitState
+	ConsecutiveFailures int
+	FailureThreshold    int
+	Cooldown            time.Duration
+	OpenedAt            *time.Time // When the breaker last opened, nil if it never has
+	Trips               int64      // Number of times the breaker has opened
+	Rejected            int64      // Calls failed fast while open
+}
+
+// CircuitBreaker stops calls to a failing dependency after a run of consecutive failures
+// Once open it rejects calls for the cooldown, then lets one probe through: a successful
+// probe closes the breaker, a failed one opens it for another cooldown
+// It is safe for concurrent use
+type CircuitBreaker struct {
+	failureThreshold int
+	cooldown         time.Duration
+	onStateChange    func(from, to CircuitState)
+	now              func() time.Time
+
+	mu                  sync.Mutex
+	state               CircuitState
+	consecutiveFailures int
+	openedAt            time.Time
+	probing             bool
+	trips               int64
+	rejected            int64
+}
+
+// NewCircuitBreaker creates a closed circuit breaker that opens after failureThreshold
+// consecutive failures and probes again after cooldown
+// onStateChange is called on every transition while the lock is held, it may be nil
+func NewCircuitBreaker(failureThreshold int, cooldown time.Duration, onStateChange func(from, to CircuitState)) *CircuitBreaker {
+	return &CircuitBreaker{
+		failureThreshold: failureThreshold,
+		cooldown:         cooldown,
+		onStateChange:    onStateChange,
+		now:              time.Now,
+		state:            CircuitClosed,
+	}
+}
+
+// WithClock replaces the time source the cooldown is measured with, so tests can step
+// through it without sleeping. It must be called before the breaker is used
+func (b *CircuitBreaker) WithClock(now func() time.Time) *CircuitBreaker {
+	b.now = now
+	return b
+}
+
+// Allow reports whether a call may proceed, returning ErrCircuitOpen if not
+// Every allowed call must be followed by RecordSuccess, RecordFailure or RecordAborted
+func (b *CircuitBreaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case CircuitOpen:
+		if b.now().Sub(b.openedAt) < b.cooldown {
+			b.rejected++
+			return ErrCircuitOpen
+		}
+		b.transition(CircuitHalfOpen)
+		b.probing = true
+		return nil
+	case CircuitHalfOpen:
+		// Only the probe is let through until it reports back
+		if b.probing {
+			b.rejected++
+			return ErrCircuitOpen
+		}
+		b.probing = true
+		return nil
+	default:
+		return nil
+	}
+}
+
+// RecordSuccess records a successful call, closing the breaker if it was probing
+func (b *CircuitBreaker) RecordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.consecutiveFailures = 0
+	b.probing = false
+	if b.state != CircuitClosed {
+		b.transition(CircuitClosed)
+	}
+}
+
+// RecordFailure records a failed call, opening the breaker once the threshold is reached
+// or immediately when the failed call was the half-open probe
+func (b *CircuitBreaker) RecordFailure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.consecutiveFailures++
+	b.probing = false
+
+	switch b.state {
+	case CircuitHalfOpen:
+		b.open()
+	case CircuitClosed:
+		if b.consecutiveFailures >= b.failureThreshold {
+			b.open()
+		}
+	}
+}
+
+// RecordAborted records a call that ended without telling anything about the dependency,
+// such as one canceled by its caller, so a pending probe slot is released
+func (b *CircuitBreaker) RecordAborted() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
+
+// State returns the current state of the breaker
+func (b *CircuitBreaker) State() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.state
+}
+
+// Stats returns a snapshot of the breaker's state and counters
+func (b *CircuitBreaker) Stats() CircuitBreakerStats {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	stats := CircuitBreakerStats{
+		State:               b.state,
+		ConsecutiveFailures: b.consecutiveFailures,
+		FailureThreshold:    b.failureThreshold,
+		Cooldown:            b.cooldown,
+		Trips:               b.trips,
+		Rejected:            b.rejected,
+	}
+	if !b.openedAt.IsZero() {
+		openedAt := b.openedAt
+		stats.OpenedAt = &openedAt
+	}
+
+	return stats
+}
+
+// open moves the breaker to the open state, the caller must hold the lock
+func (b *CircuitBreaker) open() {
+	b.openedAt = b.now()
+	b.trips++
+	b.transition(CircuitOpen)
+}
+
+// transition changes the state and notifies the hook, the caller must hold the lock
+func (b *CircuitBreaker) transition(to CircuitState) {
+	from := b.state
+	b.state = to
+	if b.onStateChange != nil {
+		b.onStateChange(from, to)
+	}
+}
@@ -0,0 +1,141 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// fakeClock is a manually advanced time source
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func TestCircuitBreakerTransitions(t *testing.T) {
+	const cooldown = time.Minute
+
+	// step is one call on the breaker; allowed is only checked for "allow"
+	type step struct {
+		op      string // allow, success, failure, aborted or wait (advances the clock by d)
+		d       time.Duration
+		allowed bool
+		state   CircuitState
+	}
+
+	tests := []struct {
+		name        string
+		steps       []step
+		wantTrips   int64
+		wantRejects int64
+	}{
+		{
+			name: "failures below the threshold keep it closed",
+			steps: []step{
+				{op: "allow", allowed: true, state: CircuitClosed},
+				{op: "failure", state: CircuitClosed},
+				{op: "allow", allowed: true, state: CircuitClosed},
+				{op: "failure", state: CircuitClosed},
+				{op: "allow", allowed: true, state: CircuitClosed},
+				{op: "success", state: CircuitClosed},
+				{op: "allow", allowed: true, state: CircuitClosed},
+				{op: "failure", state: CircuitClosed},
+			},
+		},
+		{
+			name: "closed, open, half-open and closed again",
+			steps: []step{
+				{op: "failure", state: CircuitClosed},
+				{op: "failure", state: CircuitClosed},
+				{op: "failure", state: CircuitOpen},
+				{op: "allow", allowed: false, state: CircuitOpen},
+				{op: "wait", d: cooldown - time.Second, state: CircuitOpen},
+				{op: "allow", allowed: false, state: CircuitOpen},
+				{op: "wait", d: time.Second, state: CircuitOpen},
+				{op: "allow", allowed: true, state: CircuitHalfOpen},
+				{op: "success", state: CircuitClosed},
+				{op: "allow", allowed: true, state: CircuitClosed},
+			},
+			wantTrips:   1,
+			wantRejects: 2,
+		},
+		{
+			name: "a single half-open probe at a time",
+			steps: []step{
+				{op: "failure"}, {op: "failure"}, {op: "failure", state: CircuitOpen},
+				{op: "wait", d: cooldown, state: CircuitOpen},
+				{op: "allow", allowed: true, state: CircuitHalfOpen},
+				{op: "allow", allowed: false, state: CircuitHalfOpen},
+				{op: "allow", allowed: false, state: CircuitHalfOpen},
+				// An aborted probe frees the slot for the next one
+				{op: "aborted", state: CircuitHalfOpen},
+				{op: "allow", allowed: true, state: CircuitHalfOpen},
+				{op: "allow", allowed: false, state: CircuitHalfOpen},
+				{op: "success", state: CircuitClosed},
+			},
+			wantTrips:   1,
+			wantRejects: 3,
+		},
+		{
+			name: "a failed probe reopens for another cooldown",
+			steps: []step{
+				{op: "failure"}, {op: "failure"}, {op: "failure", state: CircuitOpen},
+				{op: "wait", d: cooldown, state: CircuitOpen},
+				{op: "allow", allowed: true, state: CircuitHalfOpen},
+				{op: "failure", state: CircuitOpen},
+				{op: "wait", d: cooldown - time.Second, state: CircuitOpen},
+				{op: "allow", allowed: false, state: CircuitOpen},
+				{op: "wait", d: time.Second, state: CircuitOpen},
+				{op: "allow", allowed: true, state: CircuitHalfOpen},
+				{op: "success", state: CircuitClosed},
+			},
+			wantTrips:   2,
+			wantRejects: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := &fakeClock{now: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)}
+			var transitions []string
+			b := NewCircuitBreaker(3, cooldown, func(from, to CircuitState) {
+				transitions = append(transitions, fmt.Sprintf("%s->%s", from, to))
+			}).WithClock(clock.Now)
+
+			for i, s := range tt.steps {
+				switch s.op {
+				case "allow":
+					err := b.Allow()
+					if allowed := err == nil; allowed != s.allowed {
+						t.Fatalf("step %d: Allow() = %v, want allowed %v", i, err, s.allowed)
+					}
+					if err != nil && !errors.Is(err, ErrCircuitOpen) {
+						t.Fatalf("step %d: Allow() = %v, want ErrCircuitOpen", i, err)
+					}
+				case "success":
+					b.RecordSuccess()
+				case "failure":
+					b.RecordFailure()
+				case "aborted":
+					b.RecordAborted()
+				case "wait":
+					clock.now = clock.now.Add(s.d)
+				}
+
+				if s.state != "" && b.State() != s.state {
+					t.Fatalf("step %d (%s): state = %s, want %s (transitions %v)", i, s.op, b.State(), s.state, transitions)
+				}
+			}
+
+			stats := b.Stats()
+			if stats.Trips != tt.wantTrips || stats.Rejected != tt.wantRejects {
+				t.Errorf("trips/rejected = %d/%d, want %d/%d", stats.Trips, stats.Rejected, tt.wantTrips, tt.wantRejects)
+			}
+			if tt.wantTrips > 0 && (stats.OpenedAt == nil || stats.OpenedAt.After(clock.now)) {
+				t.Errorf("OpenedAt = %v, want the time of the last trip", stats.OpenedAt)
+			}
+		})
+	}
+}
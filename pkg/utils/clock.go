@@ -0,0 +1,26 @@
+package utils
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// serverClockOffset is how far the exchange clock runs ahead of the local clock, in nanoseconds
+var serverClockOffset atomic.Int64
+
+// SetServerClockOffset records the offset of the exchange clock from the local clock
+// A positive offset means the local clock is behind the exchange
+func SetServerClockOffset(offset time.Duration) {
+	serverClockOffset.Store(int64(offset))
+}
+
+// ServerClockOffset returns the last recorded offset of the exchange clock, zero until one is set
+func ServerClockOffset() time.Duration {
+	return time.Duration(serverClockOffset.Load())
+}
+
+// ServerNow returns the current time on the exchange clock, estimated from the local clock
+// and the last recorded offset, so exchange timestamps can be compared without local skew
+func ServerNow() time.Time {
+	return time.Now().Add(ServerClockOffset())
+}
@@ -0,0 +1,126 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// DecimalFormatQuery is the query parameter that selects how decimal fields are rendered
+	DecimalFormatQuery = "decimal_format"
+
+	// DecimalFormatHeader is the request header that selects how decimal fields are rendered
+	DecimalFormatHeader = "X-Decimal-Format"
+
+	// DecimalFormatNumber renders decimal fields as JSON numbers instead of strings
+	DecimalFormatNumber = "number"
+)
+
+// decimalLiteral matches the plain (non-exponent) form produced by decimal.Decimal.String
+// and StringFixed, which is also a valid JSON number
+var decimalLiteral = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?$`)
+
+// WantsNumericDecimals reports whether the client asked for decimal fields as JSON numbers
+// via ?decimal_format=number or the X-Decimal-Format: number header
+func WantsNumericDecimals(c *gin.Context) bool {
+	format := c.Query(DecimalFormatQuery)
+	if format == "" {
+		format = c.GetHeader(DecimalFormatHeader)
+	}
+	return strings.EqualFold(format, DecimalFormatNumber)
+}
+
+// NumericDecimals rewrites an encoded JSON document so that string values holding a plain
+// decimal literal (e.g. "75.1234") become JSON numbers (75.1234)
+// The digits are copied verbatim, so the number carries exactly the precision of the
+// string form; object keys and non-numeric strings are left untouched
+func NumericDecimals(raw []byte) ([]byte, error) {
+	return rewriteStringValues(raw, func(value string) ([]byte, bool) {
+		if !decimalLiteral.MatchString(value) {
+			return nil, false
+		}
+		return []byte(value), true
+	})
+}
+
+// rewriteStringValues re-encodes a JSON document, replacing string values (not object keys)
+// for which rewrite returns true with the raw JSON it returns
+func rewriteStringValues(raw []byte, rewrite func(value string) ([]byte, bool)) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+
+	// Each open object or array tracks how many tokens it has emitted so far,
+	// which tells keys from values and where separators go
+	type container struct {
+		object bool
+		count  int
+	}
+
+	var out bytes.Buffer
+	var stack []container
+
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if delim, ok := tok.(json.Delim); ok && (delim == '}' || delim == ']') {
+			stack = stack[:len(stack)-1]
+			out.WriteByte(byte(delim))
+			continue
+		}
+
+		isKey := false
+		if n := len(stack); n > 0 {
+			top := &stack[n-1]
+			if top.count > 0 {
+				if top.object && top.count%2 == 1 {
+					out.WriteByte(':')
+				} else {
+					out.WriteByte(',')
+				}
+			}
+			isKey = top.object && top.count%2 == 0
+			top.count++
+		}
+
+		switch v := tok.(type) {
+		case json.Delim:
+			out.WriteByte(byte(v))
+			stack = append(stack, container{object: v == '{'})
+		case string:
+			if !isKey {
+				if replaced, ok := rewrite(v); ok {
+					out.Write(replaced)
+					continue
+				}
+			}
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			out.Write(encoded)
+		case json.Number:
+			out.WriteString(v.String())
+		case bool:
+			if v {
+				out.WriteString("true")
+			} else {
+				out.WriteString("false")
+			}
+		case nil:
+			out.WriteString("null")
+		}
+	}
+
+	return out.Bytes(), nil
+}
@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// PeriodAll is the statistics period label covering every signal regardless of age
+const PeriodAll = "all"
+
+// periodUnits maps the unit suffix of a period label to its duration
+var periodUnits = map[byte]time.Duration{
+	'h': time.Hour,
+	'd': 24 * time.Hour,
+	'w': 7 * 24 * time.Hour,
+}
+
+// ParsePeriodLabel parses a statistics period label such as "1h", "7d" or "2w" into its duration
+// The label is a positive whole number followed by h (hours), d (days) or w (weeks).
+// "all" is accepted and returns a zero duration, meaning the period has no lower bound
+func ParsePeriodLabel(label string) (time.Duration, error) {
+	if label == PeriodAll {
+		return 0, nil
+	}
+	if len(label) < 2 {
+		return 0, fmt.Errorf("invalid period label %q: expected a number followed by h, d or w, or %q", label, PeriodAll)
+	}
+
+	unit, ok := periodUnits[label[len(label)-1]]
+	if !ok {
+		return 0, fmt.Errorf("invalid period label %q: unit must be h, d or w", label)
+	}
+
+	count, err := strconv.Atoi(label[:len(label)-1])
+	if err != nil || count <= 0 || label[0] == '+' {
+		return 0, fmt.Errorf("invalid period label %q: count must be a positive whole number", label)
+	}
+	if time.Duration(count) > math.MaxInt64/unit {
+		return 0, fmt.Errorf("invalid period label %q: period is too long", label)
+	}
+
+	return time.Duration(count) * unit, nil
+}
+
+// IsValidPeriodLabel reports whether a statistics period label can be parsed
+func IsValidPeriodLabel(label string) bool {
+	_, err := ParsePeriodLabel(label)
+	return err == nil
+}
@@ -1,6 +1,9 @@
 package utils
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"time"
 
 	apierrors "ContractAnalysis/pkg/errors"
@@ -18,21 +21,53 @@ type Response struct {
 }
 
 // SuccessResponse sends a success response
+// Decimal fields are strings by default; clients that opt in via WantsNumericDecimals
+// receive them as JSON numbers instead
+// Timestamps are UTC unless a display timezone was requested (see DisplayLocation)
 func SuccessResponse(c *gin.Context, code int, message string, data interface{}) {
-	c.JSON(code, Response{
+	resp := Response{
 		Code:      code,
 		Message:   message,
 		Data:      data,
 		Timestamp: time.Now().Unix(),
-	})
+	}
+
+	numericDecimals := WantsNumericDecimals(c)
+	loc := DisplayLocation(c)
+
+	if numericDecimals || loc != nil {
+		body, err := json.Marshal(resp)
+		if err == nil && numericDecimals {
+			body, err = NumericDecimals(body)
+		}
+		if err == nil && loc != nil {
+			body, err = LocalizeTimestamps(body, loc)
+		}
+		if err == nil {
+			c.Data(code, "application/json; charset=utf-8", body)
+			return
+		}
+		// Fall back to the default form rather than failing the request
+	}
+
+	c.JSON(code, resp)
 }
 
 // ErrorResponse sends an error response
+// error.code carries the stable key clients branch on (see the Key constants in pkg/errors)
+// Server errors caused by context.DeadlineExceeded, or raised after the request deadline
+// has passed, are reported as 503, since the failure was caused by the deadline rather
+// than by the operation itself
 func ErrorResponse(c *gin.Context, err *apierrors.APIError) {
+	if err.Code >= apierrors.ErrInternalServer && isTimeout(c, err) {
+		err = apierrors.NewServiceUnavailableError("Request timed out, please retry later")
+	}
+
 	c.JSON(int(err.Code), Response{
 		Code:    int(err.Code),
 		Message: err.Message,
 		Error: map[string]interface{}{
+			"code":    err.Key,
 			"type":    err.Type,
 			"details": err.Details,
 		},
@@ -40,6 +75,14 @@ func ErrorResponse(c *gin.Context, err *apierrors.APIError) {
 	})
 }
 
+// isTimeout reports whether err was caused by a deadline, either its own or the request's
+func isTimeout(c *gin.Context, err *apierrors.APIError) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	return c.Request != nil && errors.Is(c.Request.Context().Err(), context.DeadlineExceeded)
+}
+
 // PaginatedResponse represents a paginated API response
 type PaginatedResponse struct {
 	Items      interface{}        `json:"items"`
@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	apierrors "ContractAnalysis/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestErrorResponseTimeouts(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	expired, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	deadlineErr := fmt.Errorf("failed to get signals: %w", context.DeadlineExceeded)
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		err      *apierrors.APIError
+		wantCode int
+		wantKey  string
+	}{
+		{"database error", context.Background(), apierrors.NewDatabaseError("Failed"), 501, apierrors.KeyDatabase},
+		{"database error caused by a deadline", context.Background(), apierrors.NewDatabaseError("Failed").WithCause(deadlineErr), 503, apierrors.KeyServiceUnavailable},
+		{"service error caused by a deadline", context.Background(), apierrors.NewServiceError("Failed").WithCause(deadlineErr), 503, apierrors.KeyServiceUnavailable},
+		{"other cause", context.Background(), apierrors.NewDatabaseError("Failed").WithCause(context.Canceled), 501, apierrors.KeyDatabase},
+		{"request deadline passed", expired, apierrors.NewInternalServerError("Failed"), 503, apierrors.KeyServiceUnavailable},
+		{"client error after the deadline", expired, apierrors.NewNotFoundError("Signal not found").WithCause(deadlineErr), 404, apierrors.KeyNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/", nil).WithContext(tt.ctx)
+
+			ErrorResponse(c, tt.err)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantCode)
+			}
+			var resp struct {
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if resp.Error.Code != tt.wantKey {
+				t.Errorf("error.code = %q, want %q", resp.Error.Code, tt.wantKey)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"context"
+	"time"
+)
+
+// RetryPolicy configures Retry
+type RetryPolicy struct {
+	MaxAttempts       int           // Total attempts including the first, values below 1 mean a single attempt
+	Delay             time.Duration // Wait before the first retry
+	BackoffMultiplier float64       // Factor the wait grows by after each retry, values below 1 keep it constant
+}
+
+// NoRetry makes a single attempt
+var NoRetry = RetryPolicy{MaxAttempts: 1}
+
+// Retry calls fn until it succeeds, fails with an error retryable rejects, the attempts run
+// out or ctx is done, waiting between attempts with exponential backoff
+// fn receives the 1-based attempt number; a nil retryable retries every error
+// It returns the number of attempts made and the last error, or ctx's error if ctx was
+// done while waiting for a retry
+func Retry(ctx context.Context, policy RetryPolicy, retryable func(error) bool, fn func(attempt int) error) (int, error) {
+	maxAttempts := max(policy.MaxAttempts, 1)
+	delay := policy.Delay
+
+	for attempt := 1; ; attempt++ {
+		err := fn(attempt)
+		if err == nil {
+			return attempt, nil
+		}
+		if attempt >= maxAttempts || (retryable != nil && !retryable(err)) {
+			return attempt, err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return attempt, ctx.Err()
+		case <-timer.C:
+		}
+
+		if policy.BackoffMultiplier > 1 {
+			delay = time.Duration(float64(delay) * policy.BackoffMultiplier)
+		}
+	}
+}
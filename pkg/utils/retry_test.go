@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+var (
+	errTransient = errors.New("transient")
+	errPermanent = errors.New("permanent")
+)
+
+func TestRetry(t *testing.T) {
+	onlyTransient := func(err error) bool { return errors.Is(err, errTransient) }
+
+	tests := []struct {
+		name         string
+		policy       RetryPolicy
+		retryable    func(error) bool
+		errs         []error // Result of each attempt, nil once exhausted
+		wantAttempts int
+		wantErr      error
+	}{
+		{"first attempt succeeds", RetryPolicy{MaxAttempts: 3}, nil, nil, 1, nil},
+		{"succeeds on a retry", RetryPolicy{MaxAttempts: 3}, nil, []error{errTransient, errTransient}, 3, nil},
+		{"attempts run out", RetryPolicy{MaxAttempts: 3}, nil, []error{errTransient, errTransient, errTransient, errTransient}, 3, errTransient},
+		{"no retry", NoRetry, nil, []error{errTransient}, 1, errTransient},
+		{"zero attempts means one", RetryPolicy{}, nil, []error{errTransient}, 1, errTransient},
+		{"stops on a non-retryable error", RetryPolicy{MaxAttempts: 5}, onlyTransient, []error{errTransient, errPermanent, errTransient}, 2, errPermanent},
+		{"nil retryable retries everything", RetryPolicy{MaxAttempts: 2}, nil, []error{errPermanent}, 2, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var seen []int
+			attempts, err := Retry(context.Background(), tt.policy, tt.retryable, func(attempt int) error {
+				seen = append(seen, attempt)
+				if attempt <= len(tt.errs) {
+					return tt.errs[attempt-1]
+				}
+				return nil
+			})
+
+			if attempts != tt.wantAttempts || !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Errorf("Retry = %d, %v, want %d, %v", attempts, err, tt.wantAttempts, tt.wantErr)
+			}
+			for i, attempt := range seen {
+				if attempt != i+1 {
+					t.Errorf("attempt numbers = %v, want 1-based and consecutive", seen)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestRetryBackoffGrows(t *testing.T) {
+	const delay = 20 * time.Millisecond
+	policy := RetryPolicy{MaxAttempts: 4, Delay: delay, BackoffMultiplier: 2}
+
+	var calls []time.Time
+	_, err := Retry(context.Background(), policy, nil, func(attempt int) error {
+		calls = append(calls, time.Now())
+		return errTransient
+	})
+	if !errors.Is(err, errTransient) {
+		t.Fatalf("Retry = %v, want the last error", err)
+	}
+	if len(calls) != 4 {
+		t.Fatalf("made %d attempts, want 4", len(calls))
+	}
+
+	// Timers never fire early, so each wait is at least the backoff delay
+	for i, want := range []time.Duration{delay, 2 * delay, 4 * delay} {
+		if gap := calls[i+1].Sub(calls[i]); gap < want {
+			t.Errorf("wait before attempt %d = %s, want at least %s", i+2, gap, want)
+		}
+	}
+}
+
+func TestRetryStopsWhenContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	policy := RetryPolicy{MaxAttempts: 5, Delay: time.Hour}
+
+	start := time.Now()
+	attempts, err := Retry(ctx, policy, nil, func(attempt int) error {
+		// Cancelled while Retry waits an hour for the next attempt
+		time.AfterFunc(10*time.Millisecond, cancel)
+		return errTransient
+	})
+
+	if attempts != 1 || !errors.Is(err, context.Canceled) {
+		t.Errorf("Retry = %d, %v, want 1, context.Canceled", attempts, err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Retry returned after %s, want it to stop waiting on cancellation", elapsed)
+	}
+}
@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultSymbolCacheTTL is how long the set of valid symbols is reused before refreshing
+const DefaultSymbolCacheTTL = 1 * time.Hour
+
+var (
+	// ErrInvalidSymbol is returned for symbols that are not well-formed
+	ErrInvalidSymbol = errors.New("invalid symbol")
+
+	// ErrUnknownSymbol is returned for well-formed symbols not listed on the exchange
+	ErrUnknownSymbol = errors.New("unknown symbol")
+)
+
+// symbolPattern matches USDT-M (BTCUSDT) and COIN-M (BTCUSD_PERP, BTCUSD_250627) symbols
+var symbolPattern = regexp.MustCompile(`^[A-Z0-9]{2,20}(_[A-Z0-9]{1,10})?$`)
+
+// NormalizeSymbol trims surrounding whitespace and uppercases a symbol
+func NormalizeSymbol(symbol string) string {
+	return strings.ToUpper(strings.TrimSpace(symbol))
+}
+
+// SymbolLister lists the symbols currently listed on the exchange
+type SymbolLister func(ctx context.Context) ([]string, error)
+
+// SymbolValidator normalizes symbols and checks them against a cached set of exchange symbols
+type SymbolValidator struct {
+	list SymbolLister
+	ttl  time.Duration
+
+	mu        sync.RWMutex
+	symbols   map[string]struct{}
+	fetchedAt time.Time
+}
+
+// NewSymbolValidator creates a symbol validator whose symbol set is refreshed after ttl
+func NewSymbolValidator(list SymbolLister, ttl time.Duration) *SymbolValidator {
+	return &SymbolValidator{
+		list: list,
+		ttl:  ttl,
+	}
+}
+
+// Normalize normalizes a symbol and verifies it is listed on the exchange
+// If the symbol set cannot be loaded the check is skipped, so an exchange outage
+// doesn't reject every request; the last loaded set is used while it is stale
+func (v *SymbolValidator) Normalize(ctx context.Context, symbol string) (string, error) {
+	symbol = NormalizeSymbol(symbol)
+	if !symbolPattern.MatchString(symbol) {
+		return "", fmt.Errorf("%w %q", ErrInvalidSymbol, symbol)
+	}
+
+	symbols := v.validSymbols(ctx)
+	if symbols == nil {
+		return symbol, nil
+	}
+
+	if _, ok := symbols[symbol]; !ok {
+		return "", fmt.Errorf("%w %q: not listed on the exchange", ErrUnknownSymbol, symbol)
+	}
+
+	return symbol, nil
+}
+
+// validSymbols returns the cached symbol set, refreshing it once the TTL has passed
+// Returns nil if no symbol set has ever been loaded
+func (v *SymbolValidator) validSymbols(ctx context.Context) map[string]struct{} {
+	v.mu.RLock()
+	symbols := v.symbols
+	fresh := symbols != nil && time.Since(v.fetchedAt) < v.ttl
+	v.mu.RUnlock()
+	if fresh {
+		return symbols
+	}
+
+	listed, err := v.list(ctx)
+	if err != nil || len(listed) == 0 {
+		return symbols
+	}
+
+	refreshed := make(map[string]struct{}, len(listed))
+	for _, s := range listed {
+		refreshed[s] = struct{}{}
+	}
+
+	v.mu.Lock()
+	v.symbols = refreshed
+	v.fetchedAt = time.Now()
+	v.mu.Unlock()
+
+	return refreshed
+}
@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"encoding/json"
+	"regexp"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// TimezoneQuery is the query parameter that selects the timezone timestamps are rendered in
+	TimezoneQuery = "tz"
+
+	// TimezoneLocal selects the configured application timezone
+	TimezoneLocal = "local"
+
+	// displayLocationKey stores the requested *time.Location in the gin context
+	displayLocationKey = "display_location"
+)
+
+// utcTimestamp matches the UTC timestamp format used throughout API responses
+var utcTimestamp = regexp.MustCompile(`^[0-9]{4}-[0-9]{2}-[0-9]{2}T[0-9]{2}:[0-9]{2}:[0-9]{2}Z$`)
+
+// SetDisplayLocation records the timezone timestamps of the current response are rendered in
+func SetDisplayLocation(c *gin.Context, loc *time.Location) {
+	c.Set(displayLocationKey, loc)
+}
+
+// DisplayLocation returns the timezone requested for the current response, or nil for UTC
+func DisplayLocation(c *gin.Context) *time.Location {
+	if value, exists := c.Get(displayLocationKey); exists {
+		if loc, ok := value.(*time.Location); ok {
+			return loc
+		}
+	}
+	return nil
+}
+
+// LocalizeTimestamps rewrites an encoded JSON document so that UTC timestamp strings
+// (e.g. "2024-01-02T15:04:05Z") are rendered in loc with their offset (RFC 3339)
+// Stored times stay in UTC; only the rendering changes
+func LocalizeTimestamps(raw []byte, loc *time.Location) ([]byte, error) {
+	return rewriteStringValues(raw, func(value string) ([]byte, bool) {
+		if !utcTimestamp.MatchString(value) {
+			return nil, false
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, false
+		}
+		encoded, err := json.Marshal(t.In(loc).Format(time.RFC3339))
+		if err != nil {
+			return nil, false
+		}
+		return encoded, true
+	})
+}
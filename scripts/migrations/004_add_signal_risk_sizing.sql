@@ -0,0 +1,6 @@
+-- Migration: 004_add_signal_risk_sizing.sql
+-- Description: Add risk/reward ratio and suggested position size to signals
+
+ALTER TABLE signals
+    ADD COLUMN risk_reward_ratio DECIMAL(10,4) DEFAULT 0 COMMENT 'Reward distance / stop distance',
+    ADD COLUMN position_size_pct DECIMAL(10,4) DEFAULT 0 COMMENT 'Suggested position notional as % of account equity';
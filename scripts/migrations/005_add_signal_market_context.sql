@@ -0,0 +1,6 @@
+-- Migration: 005_add_signal_market_context.sql
+-- Description: Capture open interest and funding rate on signals at generation time
+
+ALTER TABLE signals
+    ADD COLUMN open_interest DECIMAL(20,8) DEFAULT 0 COMMENT 'Open interest (USDT) at signal time',
+    ADD COLUMN funding_rate DECIMAL(10,8) DEFAULT 0 COMMENT 'Funding rate at signal time';
@@ -0,0 +1,7 @@
+-- Migration: 006_add_trading_pair_liquidity_tier.sql
+-- Description: Classify trading pairs by liquidity (24h volume and open interest)
+
+ALTER TABLE trading_pairs
+    ADD COLUMN liquidity_tier VARCHAR(10) NOT NULL DEFAULT '' COMMENT 'Liquidity tier: HIGH, MEDIUM, LOW (empty = unclassified)',
+    ADD COLUMN tier_updated_at TIMESTAMP NULL DEFAULT NULL COMMENT 'When the liquidity tier was last refreshed',
+    ADD INDEX idx_liquidity_tier (liquidity_tier);
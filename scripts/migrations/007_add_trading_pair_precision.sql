@@ -0,0 +1,6 @@
+-- Migration: 007_add_trading_pair_precision.sql
+-- Description: Store exchange price precision per symbol for rounding SL/TP levels
+
+ALTER TABLE trading_pairs
+    ADD COLUMN tick_size DECIMAL(20,10) DEFAULT 0 COMMENT 'Minimum price increment from exchangeInfo PRICE_FILTER',
+    ADD COLUMN price_precision INT DEFAULT 0 COMMENT 'Price precision (decimal places) from exchangeInfo';
@@ -0,0 +1,22 @@
+-- Migration: 008_add_paper_equity.sql
+-- Description: Store simulated account equity snapshots built from closed signals
+
+CREATE TABLE IF NOT EXISTS paper_equity (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    strategy_name VARCHAR(50) NOT NULL COMMENT 'Strategy of the equity curve, ALL for the combined portfolio',
+    signal_id VARCHAR(36) NOT NULL COMMENT 'Closed signal applied to the balance',
+    symbol VARCHAR(50) NOT NULL COMMENT 'Trading pair symbol',
+    signal_type VARCHAR(10) NOT NULL COMMENT 'LONG or SHORT',
+
+    return_pct DECIMAL(10,4) NOT NULL COMMENT 'Price return of the trade at its real exit',
+    position_size_pct DECIMAL(10,4) NOT NULL COMMENT 'Position notional as % of the balance before the trade',
+    pnl DECIMAL(20,8) NOT NULL COMMENT 'Realized profit/loss applied to the balance',
+    balance DECIMAL(20,8) NOT NULL COMMENT 'Running balance after the trade',
+
+    closed_at TIMESTAMP NOT NULL COMMENT 'When the signal was closed',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE KEY uk_strategy_signal (strategy_name, signal_id),
+    INDEX idx_strategy_closed (strategy_name, closed_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
+  COMMENT='Paper trading equity curve snapshots';
@@ -0,0 +1,5 @@
+-- Migration: 009_add_statistics_percentiles.sql
+-- Description: Store percentile distributions of closed-signal returns in strategy_statistics
+
+ALTER TABLE strategy_statistics
+    ADD COLUMN percentiles JSON DEFAULT NULL COMMENT 'Percentiles of final return, max favorable and max adverse moves';
@@ -0,0 +1,9 @@
+-- Migration: 010_add_time_to_target.sql
+-- Description: Record how long signals took to reach their profit target / stop loss
+
+ALTER TABLE signal_outcomes
+    ADD COLUMN hours_to_profit_target INT DEFAULT NULL COMMENT 'Hours until the profit target was first reached (NULL = never)',
+    ADD COLUMN hours_to_stop_loss INT DEFAULT NULL COMMENT 'Hours until the stop loss was first reached (NULL = never)';
+
+ALTER TABLE strategy_statistics
+    ADD COLUMN avg_hours_to_target DECIMAL(10,2) DEFAULT NULL COMMENT 'Average hours to profit target among signals that reached it';
@@ -0,0 +1,6 @@
+-- Migration: 011_add_signal_invalidation.sql
+-- Description: Record when and why a signal was invalidated during confirmation
+
+ALTER TABLE signals
+    ADD COLUMN invalidated_at TIMESTAMP NULL DEFAULT NULL COMMENT 'When the signal was invalidated',
+    ADD COLUMN invalidation_reason VARCHAR(255) DEFAULT '' COMMENT 'Why the signal was invalidated';
@@ -0,0 +1,5 @@
+-- Migration: 012_add_market_data_taker_ratio.sql
+-- Description: Capture the taker buy/sell volume ratio (aggressive order flow) on market data
+
+ALTER TABLE market_data
+    ADD COLUMN taker_buy_sell_ratio DECIMAL(10,4) DEFAULT 0 COMMENT 'Taker buy volume / taker sell volume (0 = unavailable)';
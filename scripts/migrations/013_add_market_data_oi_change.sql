@@ -0,0 +1,5 @@
+-- Migration: 013_add_market_data_oi_change.sql
+-- Description: Store the open interest change since the previous data point of the symbol
+
+ALTER TABLE market_data
+    ADD COLUMN open_interest_change_pct DECIMAL(12,4) NULL COMMENT 'Open interest change % since the previous data point (NULL = no previous point)';
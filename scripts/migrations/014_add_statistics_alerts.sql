@@ -0,0 +1,22 @@
+-- Migration: 014_add_statistics_alerts.sql
+-- Description: Store significant statistics changes detected by the statistics monitor
+
+CREATE TABLE IF NOT EXISTS statistics_alerts (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    strategy_name VARCHAR(50) NOT NULL COMMENT 'Strategy of the monitored statistics',
+    symbol VARCHAR(50) NULL COMMENT 'Trading pair symbol, NULL for overall statistics',
+    period_label VARCHAR(20) NOT NULL COMMENT 'Statistics period: 24h, 7d, 30d, all',
+
+    metric_name VARCHAR(50) NOT NULL COMMENT 'Metric that changed, e.g. Win Rate',
+    previous_value VARCHAR(50) NOT NULL COMMENT 'Formatted value in the previous calculation',
+    current_value VARCHAR(50) NOT NULL COMMENT 'Formatted value in the current calculation',
+    change_value DECIMAL(12,4) NOT NULL COMMENT 'Size of the change, see change_type',
+    change_type VARCHAR(20) NOT NULL COMMENT 'percentage or percentage_points',
+
+    previous_calculated_at TIMESTAMP NOT NULL COMMENT 'When the previous statistics were calculated',
+    current_calculated_at TIMESTAMP NOT NULL COMMENT 'When the current statistics were calculated',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    INDEX idx_strategy_period_created (strategy_name, period_label, created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
+  COMMENT='Significant statistics changes detected by the statistics monitor';
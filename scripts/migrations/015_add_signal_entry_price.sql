@@ -0,0 +1,5 @@
+-- Migration: 015_add_signal_entry_price.sql
+-- Description: Store the price captured when a signal is confirmed, used as the kline tracking entry price when configured
+
+ALTER TABLE signals
+    ADD COLUMN entry_price DECIMAL(20,8) DEFAULT 0 COMMENT 'Price when the signal was confirmed (0 = not confirmed yet)';
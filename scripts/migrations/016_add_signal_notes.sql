@@ -0,0 +1,15 @@
+-- Migration: 016_add_signal_notes.sql
+-- Description: Store free-text review notes attached to signals
+
+CREATE TABLE IF NOT EXISTS signal_notes (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    signal_id VARCHAR(36) NOT NULL COMMENT 'Signal the note is attached to',
+    author VARCHAR(50) NOT NULL COMMENT 'Who wrote the note, anonymous when not given',
+    text TEXT NOT NULL COMMENT 'Note text, at most 2000 characters',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    INDEX idx_signal_created (signal_id, created_at),
+
+    FOREIGN KEY (signal_id) REFERENCES signals(signal_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
+  COMMENT='Review notes attached to signals';
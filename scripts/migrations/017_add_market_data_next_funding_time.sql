@@ -0,0 +1,5 @@
+-- Migration: 017_add_market_data_next_funding_time.sql
+-- Description: Store the next funding settlement time reported with the funding rate
+
+ALTER TABLE market_data
+    ADD COLUMN next_funding_time TIMESTAMP NULL COMMENT 'Next funding settlement time from premiumIndex (NULL = unknown)';
@@ -0,0 +1,9 @@
+-- Migration: 018_add_optimal_exit.sql
+-- Description: Record when exiting a closed signal would have maximized profit, from its kline tracking
+
+ALTER TABLE signal_outcomes
+    ADD COLUMN hours_to_optimal_exit INT DEFAULT NULL COMMENT 'Hours until the kline with the largest favorable move closed (NULL = no kline tracking)',
+    ADD COLUMN optimal_exit_change_pct DECIMAL(10,4) DEFAULT NULL COMMENT 'Largest favorable move of any tracked kline';
+
+ALTER TABLE strategy_statistics
+    ADD COLUMN avg_optimal_exit_hours DECIMAL(10,2) DEFAULT NULL COMMENT 'Average hours to the optimal exit among closed signals with kline tracking';
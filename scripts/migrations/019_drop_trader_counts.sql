@@ -0,0 +1,11 @@
+-- Migration: 019_drop_trader_counts.sql
+-- Description: Drop the long/short trader count columns
+-- Binance only publishes long/short ratios, not trader counts, so these were never populated
+
+ALTER TABLE market_data
+    DROP COLUMN long_trader_count,
+    DROP COLUMN short_trader_count;
+
+ALTER TABLE signals
+    DROP COLUMN long_trader_count,
+    DROP COLUMN short_trader_count;
@@ -0,0 +1,10 @@
+-- Migration: 020_add_volume_weighted_statistics.sql
+-- Description: Record the 24h quote volume on signals and add equal- and volume-weighted return metrics to statistics
+
+ALTER TABLE signals
+    ADD COLUMN volume_24h DECIMAL(20,2) DEFAULT 0 COMMENT '24h quote volume (USDT) at signal time (0 = not recorded)';
+
+ALTER TABLE strategy_statistics
+    ADD COLUMN avg_return_pct DECIMAL(10,4) DEFAULT NULL COMMENT 'Mean final return of closed signals',
+    ADD COLUMN volume_weighted_win_rate DECIMAL(10,4) DEFAULT NULL COMMENT 'Win rate weighted by 24h quote volume at signal time',
+    ADD COLUMN volume_weighted_avg_return_pct DECIMAL(10,4) DEFAULT NULL COMMENT 'Mean final return weighted by 24h quote volume at signal time';
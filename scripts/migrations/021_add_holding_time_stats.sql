@@ -0,0 +1,7 @@
+-- Migration: 021_add_holding_time_stats.sql
+-- Description: Record the shortest and longest holding times and a holding time histogram of closed signals in strategy_statistics
+
+ALTER TABLE strategy_statistics
+    ADD COLUMN min_holding_hours DECIMAL(10,2) DEFAULT NULL COMMENT 'Shortest holding time in hours among closed signals',
+    ADD COLUMN max_holding_hours DECIMAL(10,2) DEFAULT NULL COMMENT 'Longest holding time in hours among closed signals',
+    ADD COLUMN holding_time_histogram JSON DEFAULT NULL COMMENT 'Closed-signal counts per holding time bucket (statistics.holding_histogram)';
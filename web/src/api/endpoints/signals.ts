@@ -1,6 +1,6 @@
 import apiClient from '../client';
 import type { ApiResponse, PaginatedData } from '@/types/common';
-import type { Signal, SignalTracking, SignalKlineTracking } from '@/types/signal';
+import type { Signal, SignalContext, SignalDetail, SignalTracking, SignalKlineTracking, SignalNote } from '@/types/signal';
 
 export interface SignalFilters {
   page?: number;
@@ -29,6 +29,21 @@ export const signalsApi = {
     return apiClient.get(`/signals/${signalId}`);
   },
 
+  // 获取信号及其完整追踪时间线
+  getSignalFull: async (signalId: string): Promise<ApiResponse<SignalDetail>> => {
+    return apiClient.get(`/signals/${signalId}/full`);
+  },
+
+  // 获取信号生成上下文
+  getSignalContext: async (signalId: string): Promise<ApiResponse<SignalContext>> => {
+    return apiClient.get(`/signals/${signalId}/context`);
+  },
+
+  // 获取信号生成时的策略配置快照
+  getSignalConfig: async (signalId: string): Promise<ApiResponse<Record<string, any>>> => {
+    return apiClient.get(`/signals/${signalId}/config`);
+  },
+
   // 获取信号追踪记录
   getSignalTracking: async (signalId: string): Promise<ApiResponse<SignalTracking[]>> => {
     return apiClient.get(`/signals/${signalId}/tracking`);
@@ -38,4 +53,14 @@ export const signalsApi = {
   getSignalKlines: async (signalId: string): Promise<ApiResponse<SignalKlineTracking[]>> => {
     return apiClient.get(`/signals/${signalId}/klines`);
   },
+
+  // 获取信号复盘备注
+  getSignalNotes: async (signalId: string): Promise<ApiResponse<SignalNote[]>> => {
+    return apiClient.get(`/signals/${signalId}/notes`);
+  },
+
+  // 添加信号复盘备注
+  addSignalNote: async (signalId: string, text: string, author?: string): Promise<ApiResponse<SignalNote>> => {
+    return apiClient.post(`/signals/${signalId}/notes`, { text, author });
+  },
 };
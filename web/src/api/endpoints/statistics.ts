@@ -1,13 +1,18 @@
 import apiClient from '../client';
 import type { ApiResponse } from '@/types/common';
-import type { Statistics, OverviewStatistics, StrategyComparisonResponse } from '@/types/statistics';
+import type { Statistics, OverviewStatistics, StatisticsSummary, StrategyComparisonResponse } from '@/types/statistics';
 
 export interface StatisticsFilters {
-  period?: '24h' | '7d' | '30d' | 'all';
+  period?: string;  // 统计周期，如 24h、7d、90d、all
   strategy?: string;
   symbol?: string;
 }
 
+export interface StatisticsSummaryParams {
+  period?: string;  // 统计周期，默认 24h
+  symbol_limit?: number;  // 最佳/最差交易对数量，默认 5
+}
+
 export interface StatisticsHistoryFilters {
   start_time: string;  // ISO 8601 format
   end_time: string;
@@ -17,7 +22,7 @@ export interface StatisticsHistoryFilters {
 
 export interface StrategyCompareParams {
   strategies: string[];
-  period: '24h' | '7d' | '30d' | 'all';
+  period: string;  // 统计周期，如 24h、7d、90d、all
   symbols?: string[];
 }
 
@@ -27,6 +32,11 @@ export const statisticsApi = {
     return apiClient.get('/statistics/overview');
   },
 
+  // 获取指定周期的统计汇总
+  getSummary: async (params?: StatisticsSummaryParams): Promise<ApiResponse<StatisticsSummary>> => {
+    return apiClient.get('/statistics/summary', { params });
+  },
+
   // 获取策略统计
   getStrategies: async (filters?: StatisticsFilters): Promise<ApiResponse<Statistics[]>> => {
     return apiClient.get('/statistics/strategies', { params: filters });
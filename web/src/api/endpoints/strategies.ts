@@ -1,6 +1,8 @@
 import client from '../client';
-import type { Strategy } from '@/types/strategy';
+import type { Strategy, StrategySchema } from '@/types/strategy';
 
 export const strategiesApi = {
   getStrategies: () => client.get<{ data: Strategy[] }>('/strategies'),
+  getStrategySchema: (key: string) =>
+    client.get<{ data: StrategySchema }>(`/strategies/${key}/schema`),
 };
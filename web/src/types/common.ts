@@ -4,12 +4,26 @@ export interface ApiResponse<T = unknown> {
   message: string;
   data?: T;
   error?: {
+    code: ApiErrorCode;
     type: string;
-    details: string;
+    details: string[] | null;
   };
   timestamp: number;
 }
 
+// 稳定的错误码(错误响应的 error.code),前端应根据它而非 message 做分支判断
+export type ApiErrorCode =
+  | 'BAD_REQUEST'
+  | 'UNAUTHORIZED'
+  | 'FORBIDDEN'
+  | 'NOT_FOUND'
+  | 'VALIDATION_ERROR'
+  | 'RATE_LIMITED'
+  | 'INTERNAL_ERROR'
+  | 'DATABASE_ERROR'
+  | 'SERVICE_ERROR'
+  | 'SERVICE_UNAVAILABLE';
+
 // 分页响应
 export interface PaginationMeta {
   page: number;
@@ -12,11 +12,10 @@ export interface Signal {
   short_account_ratio: string;
   long_position_ratio: string;
   short_position_ratio: string;
-  long_trader_count: number;
-  short_trader_count: number;
   status: SignalStatus;
   is_confirmed: boolean;
   confirmed_at: string; // Made mandatory based on typical usage when present
+  entry_price?: string; // Price at confirmation
   reason?: string;
   strategy_context?: Record<string, any>;
   created_at: string;
@@ -30,6 +29,7 @@ export interface Signal {
   top_trader_long_short_ratio?: string; // 大户多空持仓比
   open_interest?: string; // 持仓量
   open_interest_change_24h?: string; // 持仓量24h变化
+  volume_24h?: string; // 信号生成时的24h成交额（USDT），未记录时为 "0"
 
   // --- Cost & Sentiment ---
   funding_rate?: string; // 资金费率
@@ -45,6 +45,35 @@ export interface Signal {
   volume_24h?: string; // 24h成交量
 }
 
+// 信号生成时的完整上下文
+export interface SignalContext {
+  signal_id: string;
+  symbol: string;
+  type: SignalType;
+  strategy_name: string;
+  generated_at: string;
+  reason: string;
+  market_data: {
+    price: string;
+    long_account_ratio: string;
+    short_account_ratio: string;
+    long_position_ratio: string;
+    short_position_ratio: string;
+    open_interest: string;
+    funding_rate: string;
+    volume_24h: string;
+  };
+  trade_levels: {
+    stop_loss_price: string;
+    target_price_1: string;
+    target_price_2: string;
+    tick_size: string;
+    risk_reward_ratio: string;
+    position_size_pct: string;
+  };
+  strategy_config: Record<string, any>;
+}
+
 export interface SignalTracking {
   id: number;
   signal_id: string;
@@ -78,3 +107,39 @@ export interface SignalKlineTracking {
   is_profitable_at_high: boolean;
   is_profitable_at_close: boolean;
 }
+
+export interface SignalNote {
+  id: number;
+  signal_id: string;
+  author: string;
+  text: string;
+  created_at: string;
+}
+
+export interface SignalOutcome {
+  id: number;
+  signal_id: string;
+  outcome: string;
+  max_favorable_move_pct: string;
+  max_adverse_move_pct: string;
+  final_price_change_pct: string;
+  hours_to_peak?: number;
+  hours_to_trough?: number;
+  total_tracking_hours: number;
+  hours_to_profit_target?: number;
+  hours_to_stop_loss?: number;
+  hours_to_optimal_exit?: number;
+  optimal_exit_change_pct?: string;
+  profit_target_hit: boolean;
+  stop_loss_hit: boolean;
+  closed_at: string;
+  created_at: string;
+}
+
+export interface SignalDetail {
+  signal: Signal;
+  tracking: SignalTracking[];
+  klines: SignalKlineTracking[];
+  outcome?: SignalOutcome;
+  notes: SignalNote[];
+}
@@ -20,6 +20,12 @@ export interface Statistics {
   avg_profit_pct?: string;
   avg_loss_pct?: string;
   avg_holding_hours?: string;
+  min_holding_hours?: string;
+  max_holding_hours?: string;
+  avg_return_pct?: string;
+  // 按信号生成时交易对24h成交额加权，未记录成交额的信号不计入
+  volume_weighted_win_rate?: string;
+  volume_weighted_avg_return_pct?: string;
   best_signal_pct?: string;
   worst_signal_pct?: string;
   profit_factor?: string;
@@ -40,9 +46,21 @@ export interface Statistics {
   avg_max_potential_profit_pct?: string;
   avg_max_potential_loss_pct?: string;
 
+  // 平均最佳离场小时数（对比 tracking_hours 判断追踪期是否过长/过短）
+  avg_optimal_exit_hours?: string;
+
+  // 持仓时长分布，最后一个区间没有上限
+  holding_time_histogram?: HoldingTimeBucket[];
+
   calculated_at: string;
 }
 
+export interface HoldingTimeBucket {
+  min_hours: number;
+  max_hours?: number;
+  count: number;
+}
+
 export type StatisticsResponse = Statistics;
 
 export interface StrategyPerformance24h {
@@ -73,6 +91,25 @@ export interface OverviewStatistics {
   status_distribution?: SignalStatusDistribution;
 }
 
+export interface SymbolPerformance {
+  symbol: string;
+  signal_count: number;
+  avg_return_pct: string;
+}
+
+export interface StatisticsSummary {
+  period: string;
+  total_signals: number;
+  active_signals: number;
+  overall_win_rate?: string;
+  avg_return_pct?: string;
+  status_distribution?: SignalStatusDistribution;
+  strategy_breakdown: StrategyPerformance24h[];
+  strategies: StatisticsResponse[];
+  top_symbols: SymbolPerformance[];  // 平均收益从高到低
+  worst_symbols: SymbolPerformance[];  // 平均收益从低到高
+}
+
 export interface ComparisonMetrics {
   win_rates: Record<string, string>;
   avg_returns: Record<string, string>;
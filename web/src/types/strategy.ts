@@ -4,3 +4,20 @@ export interface Strategy {
   enabled: boolean;
   description: string;
 }
+
+export interface StrategyParameterSchema {
+  name: string;
+  type: 'bool' | 'int' | 'float' | 'string';
+  value: boolean | number | string;
+  min?: number;
+  max?: number;
+  enum?: string[];
+  description: string;
+}
+
+export interface StrategySchema {
+  key: string;
+  name: string;
+  enabled: boolean;
+  parameters: StrategyParameterSchema[];
+}